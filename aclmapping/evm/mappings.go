@@ -96,7 +96,7 @@ func TransactionDependencyGenerator(_ aclkeeper.Keeper, evmKeeper evmkeeper.Keep
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			ResourceType:       sdkacltypes.ResourceType_KV_EVM_RECEIPT,
-			IdentifierTemplate: hex.EncodeToString(evmtypes.ReceiptKey(tx.Hash())),
+			IdentifierTemplate: hex.EncodeToString(evmtypes.ReceiptKey(evmMsg.Hash())),
 		},
 		{
 			AccessType:         sdkacltypes.AccessType_READ,
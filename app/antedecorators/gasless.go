@@ -95,6 +95,11 @@ func IsTxGasless(tx sdk.Tx, ctx sdk.Context, evmKeeper *evmkeeper.Keeper, oracle
 			}
 			// ddos prevention
 			return len(tx.GetMsgs()) == 1, nil
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			isGassLess, err := oraclePrevoteIsGassLess(m, ctx, oracleKeeper)
+			if err != nil || !isGassLess {
+				return false, err
+			}
 		case *oracletypes.MsgAggregateExchangeRateVote:
 			isGassLess, err := oracleVoteIsGassLess(m, ctx, oracleKeeper)
 			if err != nil || !isGassLess {
@@ -149,3 +154,43 @@ func oracleVoteIsGassLess(msg *oracletypes.MsgAggregateExchangeRateVote, ctx sdk
 
 	return false, err
 }
+
+// oraclePrevoteIsGassLess checks the prevote msg's info is valid
+func oraclePrevoteIsGassLess(msg *oracletypes.MsgAggregateExchangeRatePrevote, ctx sdk.Context, keeper oraclekeeper.Keeper) (bool, error) {
+	// validate feeder address
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return false, err
+	}
+
+	// validate validator address
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return false, err
+	}
+
+	// validate the feeder is allowed to send tx
+	err = keeper.ValidateFeeder(ctx, feederAddr, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	// check the address has no prevote on the current vote period (spamming protection, avoid more than one prevote)
+	prevote, err := keeper.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err == nil {
+		votePeriod := keeper.GetParams(ctx).VotePeriod
+		if prevote.SubmitBlock/votePeriod == uint64(ctx.BlockHeight())/votePeriod {
+			return false, nil
+		}
+
+		// the prevote belongs to a previous vote period, allow it
+		return true, nil
+	}
+
+	// if the error is there are no prevotes by valAddress means the first prevote, allow them
+	if errors.Is(err, oracletypes.ErrNoAggregatePrevote) {
+		return true, nil
+	}
+
+	return false, err
+}
@@ -125,6 +125,9 @@ import (
 	"github.com/kiichain/kiichain/x/evm/querier"
 	"github.com/kiichain/kiichain/x/evm/replay"
 	evmtypes "github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/ibchooks"
+	ibchookskeeper "github.com/kiichain/kiichain/x/ibchooks/keeper"
+	ibchookstypes "github.com/kiichain/kiichain/x/ibchooks/types"
 	"github.com/kiichain/kiichain/x/mint"
 	mintclient "github.com/kiichain/kiichain/x/mint/client/cli"
 	mintkeeper "github.com/kiichain/kiichain/x/mint/keeper"
@@ -133,6 +136,9 @@ import (
 
 	oraclekeeper "github.com/kiichain/kiichain/x/oracle/keeper"
 	oracletypes "github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/kiichain/kiichain/x/packetforward"
+	packetforwardkeeper "github.com/kiichain/kiichain/x/packetforward/keeper"
+	packetforwardtypes "github.com/kiichain/kiichain/x/packetforward/types"
 
 	tokenfactorymodule "github.com/kiichain/kiichain/x/tokenfactory"
 	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
@@ -217,7 +223,7 @@ var (
 		acltypes.ModuleName:            nil,
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
@@ -330,6 +336,8 @@ type App struct {
 	WasmKeeper          wasm.Keeper
 	EvmKeeper           evmkeeper.Keeper
 	OracleKeeper        oraclekeeper.Keeper
+	IBCHooksKeeper      ibchookskeeper.Keeper
+	PacketForwardKeeper packetforwardkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -412,6 +420,8 @@ func New(
 		epochmoduletypes.StoreKey,
 		tokenfactorytypes.StoreKey,
 		oracletypes.StoreKey,
+		ibchookstypes.StoreKey,
+		packetforwardtypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientStoreKey)
@@ -461,14 +471,14 @@ func New(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter())
-	app.MintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
-		app.AccountKeeper, app.BankKeeper, app.EpochKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EpochKeeper, authtypes.FeeCollectorName,
+	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
 	)
@@ -492,12 +502,22 @@ func New(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// Create the IBC hooks keeper, the EVM and wasm keepers are only used once
+	// packets are processed so they can be referenced before they are set
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
+		keys[ibchookstypes.StoreKey],
+		&app.EvmKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		&app.WasmKeeper,
+		app.BankKeeper,
+	)
+
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		ibchooks.NewICS4Middleware(app.IBCKeeper.ChannelKeeper, app.IBCHooksKeeper),
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -505,7 +525,24 @@ func New(
 		scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	// Create the packet forward keeper, forwarded packets are acknowledged
+	// directly on the channel keeper
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		scopedTransferKeeper,
+		app.BankKeeper,
+	)
+
+	// Create the transfer stack, the packet forward middleware is the outermost
+	// so that forwarded packets reach the hooks as plain transfers
+	var transferIBCModule ibcporttypes.IBCModule
+	transferIBCModule = transfer.NewIBCModule(app.TransferKeeper)
+	transferIBCModule = ibchooks.NewIBCMiddleware(transferIBCModule, app.IBCHooksKeeper)
+	transferIBCModule = packetforward.NewIBCMiddleware(transferIBCModule, app.PacketForwardKeeper)
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -540,6 +577,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
+		&app.EvmKeeper,
 		tokenFactoryConfig,
 	)
 
@@ -550,6 +588,7 @@ func New(
 		app.GetSubspace(oracletypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.StakingKeeper,
 		distrtypes.ModuleName,
 	)
@@ -707,6 +746,7 @@ func New(
 			app.WasmKeeper,
 			stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
 			stakingkeeper.Querier{Keeper: app.StakingKeeper},
+			app.StakingKeeper,
 			app.GovKeeper,
 			app.DistrKeeper,
 			app.TransferKeeper,
@@ -715,6 +755,8 @@ func New(
 			app.IBCKeeper.ChannelKeeper,
 			app.AccountKeeper,
 			app.OracleKeeper,
+			tokenfactorykeeper.NewMsgServerImpl(app.TokenFactoryKeeper),
+			app.TokenFactoryKeeper,
 		); err != nil {
 			panic(err)
 		}
@@ -1010,6 +1052,15 @@ func (app *App) SetStoreUpgradeHandlers() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	if upgradeInfo.Name == "v6.0.0" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{ibchookstypes.StoreKey, packetforwardtypes.StoreKey},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 // AppName returns the name of the App
@@ -1780,8 +1831,30 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 		}
 		return ctx.WithIsEVM(true)
 	}
+	var bloomIndex *evmrpc.BloomIndex
+	if app.evmRPCConfig.BloomIndexEnabled && (app.evmRPCConfig.HTTPEnabled || app.evmRPCConfig.WSEnabled) {
+		var err error
+		bloomIndex, err = evmrpc.OpenBloomIndex(DefaultNodeHome, &app.EvmKeeper, ctxProvider, app.Logger())
+		if err != nil {
+			panic(err)
+		}
+		bloomIndex.Start()
+	}
+	var blobStore *evmrpc.BlobStore
+	if app.evmRPCConfig.BlobStoreEnabled && (app.evmRPCConfig.HTTPEnabled || app.evmRPCConfig.WSEnabled) {
+		var err error
+		blobStore, err = evmrpc.OpenBlobStore(DefaultNodeHome, clientCtx.Client, &app.EvmKeeper, ctxProvider, app.encodingConfig.TxConfig.TxDecoder(), app.evmRPCConfig.BlobRetention, app.Logger())
+		if err != nil {
+			panic(err)
+		}
+		blobStore.Start()
+	}
+	rateLimiter, err := evmrpc.NewRateLimiterFromConfig(app.evmRPCConfig)
+	if err != nil {
+		panic(err)
+	}
 	if app.evmRPCConfig.HTTPEnabled {
-		evmHTTPServer, err := evmrpc.NewEVMHTTPServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, ctxProvider, app.encodingConfig.TxConfig, DefaultNodeHome)
+		evmHTTPServer, err := evmrpc.NewEVMHTTPServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, ctxProvider, app.encodingConfig.TxConfig, DefaultNodeHome, bloomIndex, blobStore, rateLimiter)
 		if err != nil {
 			panic(err)
 		}
@@ -1791,7 +1864,7 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	}
 
 	if app.evmRPCConfig.WSEnabled {
-		evmWSServer, err := evmrpc.NewEVMWebSocketServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, ctxProvider, app.encodingConfig.TxConfig, DefaultNodeHome)
+		evmWSServer, err := evmrpc.NewEVMWebSocketServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, ctxProvider, app.encodingConfig.TxConfig, DefaultNodeHome, bloomIndex, blobStore, rateLimiter)
 		if err != nil {
 			panic(err)
 		}
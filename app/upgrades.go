@@ -16,6 +16,8 @@ import (
 // in a missing value in a log statement for which the fix is not released
 var upgradesList = []string{
 	"v4.0.0",
+	"v5.0.0",
+	"v6.0.0",
 }
 
 // if there is an override list, use that instead, for integration tests
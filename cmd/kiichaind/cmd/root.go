@@ -534,6 +534,63 @@ max_blocks_for_log = {{ .EVM.MaxBlocksForLog }}
 # max number of concurrent NewHead subscriptions
 max_subscriptions_new_head = {{ .EVM.MaxSubscriptionsNewHead }}
 
+# controls whether to build a bloombits index to speed up log queries over large block ranges.
+# Only meant for archive nodes since the index is built from the historical state.
+bloom_index_enabled = {{ .EVM.BloomIndexEnabled }}
+
+# controls whether to accept blob transactions and store their sidecars to serve eth_getBlobSidecars.
+# Blocks don't carry sidecars, so a node only knows the sidecars of the blob transactions sent to it.
+blob_store_enabled = {{ .EVM.BlobStoreEnabled }}
+
+# how long blob sidecars are kept before being pruned
+blob_retention = "{{ .EVM.BlobRetention }}"
+
+# hex-encoded 32-byte secret; if set, every request must carry a JWT signed with it
+jwt_secret = "{{ .EVM.JwtSecret }}"
+
+# static API keys, sent in the X-Api-Key header or the api_key query param.
+# Requests without an API key are rate limited per IP.
+api_keys = [{{ range $i, $key := .EVM.APIKeys }}{{ if $i }}, {{ end }}"{{ $key }}"{{ end }}]
+
+# max number of requests per second for each IP, 0 means no limit
+ip_requests_per_second = {{ .EVM.IPRequestsPerSecond }}
+
+# max number of compute units per second for each IP, 0 means no limit
+ip_compute_units_per_second = {{ .EVM.IPComputeUnitsPerSecond }}
+
+# max number of requests per second for each API key, 0 means no limit
+api_key_requests_per_second = {{ .EVM.APIKeyRequestsPerSecond }}
+
+# max number of compute units per second for each API key, 0 means no limit
+api_key_compute_units_per_second = {{ .EVM.APIKeyComputeUnitsPerSecond }}
+
+# compute units charged per method as "<method>=<compute units>", a trailing * matches a method prefix.
+# Methods that aren't listed cost 1 compute unit, except for the built-in costs of eth_call,
+# eth_estimateGas, eth_getLogs, debug_trace* and trace_* methods.
+method_compute_units = [{{ range $i, $cost := .EVM.MethodComputeUnits }}{{ if $i }}, {{ end }}"{{ $cost }}"{{ end }}]
+
+# controls whether to serve the ERC-4337 bundler methods (eth_sendUserOperation etc.) over HTTP
+bundler_enabled = {{ .EVM.BundlerEnabled }}
+
+# address that signs bundle transactions and collects their fees; its key must be in the
+# test keyring of the node
+bundler_address = "{{ .EVM.BundlerAddress }}"
+
+# ERC-4337 EntryPoint contracts UserOperations are accepted for
+bundler_entry_points = [{{ range $i, $ep := .EVM.BundlerEntryPoints }}{{ if $i }}, {{ end }}"{{ $ep }}"{{ end }}]
+
+# how often pooled UserOperations are bundled
+bundler_interval = "{{ .EVM.BundlerInterval }}"
+
+# max number of UserOperations in a bundle
+bundler_max_ops_per_bundle = {{ .EVM.BundlerMaxOpsPerBundle }}
+
+# number of recent blocks the gas price oracle samples tips from
+gas_price_oracle_blocks = {{ .EVM.GasPriceOracleBlocks }}
+
+# controls whether the EIP-1767 GraphQL endpoint is served at /graphql on the HTTP server
+graphql_enabled = {{ .EVM.GraphQLEnabled }}
+
 [eth_replay]
 eth_replay_enabled = {{ .ETHReplay.Enabled }}
 eth_rpc = "{{ .ETHReplay.EthRPC }}"
@@ -150,9 +150,7 @@ func (t *AssociationAPI) GetCosmosTx(ctx context.Context, ethHash common.Hash) (
 		for _, msg := range decoded.GetMsgs() {
 			switch m := msg.(type) {
 			case *types.MsgEVMTransaction:
-				ethtx, _ := m.AsTransaction()
-				hash := ethtx.Hash()
-				if hash == ethHash {
+				if m.Hash() == ethHash {
 					return fmt.Sprintf("%X", tmTx.Hash()), nil
 				}
 			}
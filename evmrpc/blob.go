@@ -0,0 +1,78 @@
+package evmrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+type BlobAPI struct {
+	tmClient       rpcclient.Client
+	blobStore      *BlobStore
+	connectionType ConnectionType
+}
+
+func NewBlobAPI(tmClient rpcclient.Client, blobStore *BlobStore, connectionType ConnectionType) *BlobAPI {
+	return &BlobAPI{tmClient: tmClient, blobStore: blobStore, connectionType: connectionType}
+}
+
+// BlobSidecar is a single blob of a blob transaction along with its KZG commitment and proof
+type BlobSidecar struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Index            hexutil.Uint64 `json:"index"`
+	Blob             hexutil.Bytes  `json:"blob"`
+	KZGCommitment    hexutil.Bytes  `json:"kzgCommitment"`
+	KZGProof         hexutil.Bytes  `json:"kzgProof"`
+	VersionedHash    common.Hash    `json:"versionedHash"`
+}
+
+// GetBlobSidecars returns the blobs of the blob transactions of a block, in transaction order.
+// Blobs are only available on nodes with the blob store enabled and only for the retention period.
+func (a *BlobAPI) GetBlobSidecars(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []*BlobSidecar, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_getBlobSidecars", a.connectionType, startTime, returnErr == nil)
+	if a.blobStore == nil {
+		return nil, errBlobStoreNotActive
+	}
+	blockNumber, err := GetBlockNumberByNrOrHash(ctx, a.tmClient, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := blockByNumberWithRetry(ctx, a.tmClient, blockNumber, 1)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := a.blobStore.GetBlobSidecars(block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	blockHash := common.HexToHash(block.BlockID.Hash.String())
+	result = []*BlobSidecar{}
+	for _, s := range stored {
+		for i := range s.Sidecar.Blobs {
+			versionedHash := sha256.Sum256(s.Sidecar.Commitments[i][:])
+			versionedHash[0] = params.BlobTxHashVersion
+			result = append(result, &BlobSidecar{
+				BlockNumber:      hexutil.Uint64(block.Block.Height),
+				BlockHash:        blockHash,
+				TransactionHash:  s.TxHash,
+				TransactionIndex: hexutil.Uint64(s.TxIndex),
+				Index:            hexutil.Uint64(i),
+				Blob:             s.Sidecar.Blobs[i][:],
+				KZGCommitment:    s.Sidecar.Commitments[i][:],
+				KZGProof:         s.Sidecar.Proofs[i][:],
+				VersionedHash:    versionedHash,
+			})
+		}
+	}
+	return result, nil
+}
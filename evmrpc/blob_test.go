@@ -0,0 +1,254 @@
+package evmrpc_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+	"github.com/kiichain/kiichain/evmrpc"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/kiichain/kiichain/x/evm/config"
+	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type blobClient struct {
+	MockClient
+	blocks    map[int64]*coretypes.ResultBlock
+	broadcast []tmtypes.Tx
+}
+
+func (c *blobClient) Block(_ context.Context, h *int64) (*coretypes.ResultBlock, error) {
+	return c.blocks[*h], nil
+}
+
+func (c *blobClient) BroadcastTx(_ context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	c.broadcast = append(c.broadcast, tx)
+	return &coretypes.ResultBroadcastTx{Code: 0}, nil
+}
+
+func buildBlobTx(t *testing.T) (tmtypes.Tx, *ethtypes.Transaction) {
+	var blob kzg4844.Blob
+	blob[0] = 1
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.Nil(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.Nil(t, err)
+	vhash := sha256.Sum256(commitment[:])
+	vhash[0] = params.BlobTxHashVersion
+
+	chainID := big.NewInt(config.DefaultChainID)
+	mnemonic := "fish mention unlock february marble dove vintage sand hub ordinary fade found inject room embark supply fabric improve spike stem give current similar glimpse"
+	derivedPriv, _ := hd.Secp256k1.Derive()(mnemonic, "", "")
+	privKey := hd.Secp256k1.Generate()(derivedPriv)
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	signer := ethtypes.MakeSigner(types.DefaultChainConfig().EthereumConfig(chainID), big.NewInt(1), uint64(Ctx.BlockTime().Unix()))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Gas:        21000,
+		GasFeeCap:  uint256.NewInt(1000000000000),
+		GasTipCap:  uint256.NewInt(1000000000000),
+		BlobFeeCap: uint256.NewInt(1),
+		To:         common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		BlobHashes: []common.Hash{vhash},
+		Sidecar: &ethtypes.BlobTxSidecar{
+			Blobs:       []kzg4844.Blob{blob},
+			Commitments: []kzg4844.Commitment{commitment},
+			Proofs:      []kzg4844.Proof{proof},
+		},
+	}), signer, key)
+	require.Nil(t, err)
+	// blocks don't carry the sidecars
+	typedTx, err := ethtx.NewBlobTx(tx.WithoutBlobTxSidecar())
+	require.Nil(t, err)
+	msg, err := types.NewMsgEVMTransaction(typedTx)
+	require.Nil(t, err)
+	builder := TxConfig.NewTxBuilder()
+	require.Nil(t, builder.SetMsgs(msg))
+	bz, err := Encoder(builder.GetTx())
+	require.Nil(t, err)
+	return bz, tx
+}
+
+func TestBlobStore(t *testing.T) {
+	blobTxBz, blobTx := buildBlobTx(t)
+	fromKiiAddr, _ := testkeeper.MockAddressPair()
+	toKiiAddr, _ := testkeeper.MockAddressPair()
+	bankTx := TxConfig.NewTxBuilder()
+	require.Nil(t, bankTx.SetMsgs(banktypes.NewMsgSend(fromKiiAddr, toKiiAddr, sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(10))))))
+	nonEvmBz, err := Encoder(bankTx.GetTx())
+	require.Nil(t, err)
+	start := time.Unix(1700000000, 0)
+	client := &blobClient{blocks: map[int64]*coretypes.ResultBlock{}}
+	for height := int64(1); height <= 3; height++ {
+		header := mockBlockHeader(height)
+		header.Time = start.Add(time.Duration(height) * time.Hour)
+		txs := tmtypes.Txs{}
+		if height == 2 {
+			txs = tmtypes.Txs{nonEvmBz, blobTxBz}
+		}
+		client.blocks[height] = &coretypes.ResultBlock{Block: &tmtypes.Block{Header: header, Data: tmtypes.Data{Txs: txs}}}
+	}
+	ctx, _ := Ctx.CacheContext()
+	EVMKeeper.MockReceipt(ctx, blobTx.Hash(), &types.Receipt{TxType: ethtypes.BlobTxType, TransactionIndex: 1, BlockNumber: 2})
+	latest := int64(1)
+	ctxProvider := func(int64) sdk.Context { return ctx.WithBlockHeight(latest) }
+
+	db := dbm.NewMemDB()
+	store, err := evmrpc.NewBlobStore(db, client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 2*time.Hour, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Nil(t, store.AddPendingSidecar(blobTx.Hash(), blobTx.BlobTxSidecar(), start))
+	// a new store starts from the latest block
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	latest = 3
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+
+	sidecars, err := store.GetBlobSidecars(2)
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, blobTx.Hash(), sidecars[0].TxHash)
+	// the cosmos transaction doesn't count towards the EVM transaction index
+	require.Equal(t, uint64(0), sidecars[0].TxIndex)
+	require.Equal(t, blobTx.BlobTxSidecar().Commitments, sidecars[0].Sidecar.Commitments)
+	sidecars, err = store.GetBlobSidecars(3)
+	require.Nil(t, err)
+	require.Empty(t, sidecars)
+	_, err = store.GetBlobSidecars(4)
+	require.NotNil(t, err)
+
+	// the stored heights are persisted
+	reopened, err := evmrpc.NewBlobStore(db, client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 2*time.Hour, log.NewNopLogger())
+	require.Nil(t, err)
+	sidecars, err = reopened.GetBlobSidecars(2)
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+
+	// blocks 1 and 2 are older than the retention period
+	require.Nil(t, store.Prune(start.Add(4*time.Hour+time.Minute)))
+	_, err = store.GetBlobSidecars(2)
+	require.NotNil(t, err)
+	_, err = store.GetBlobSidecars(3)
+	require.Nil(t, err)
+
+	_, err = evmrpc.NewBlobStore(dbm.NewMemDB(), client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 0, log.NewNopLogger())
+	require.NotNil(t, err)
+
+	// pending sidecars of transactions that aren't included in time are dropped
+	store, err = evmrpc.NewBlobStore(dbm.NewMemDB(), client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 2*time.Hour, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Nil(t, store.AddPendingSidecar(blobTx.Hash(), blobTx.BlobTxSidecar(), start))
+	require.Nil(t, store.Prune(start.Add(evmrpc.PendingBlobSidecarTTL+time.Minute)))
+	latest = 1
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	latest = 3
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	sidecars, err = store.GetBlobSidecars(2)
+	require.Nil(t, err)
+	require.Empty(t, sidecars)
+
+	// eth_getBlobSidecars
+	store, err = evmrpc.NewBlobStore(dbm.NewMemDB(), client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 2*time.Hour, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Nil(t, store.AddPendingSidecar(blobTx.Hash(), blobTx.BlobTxSidecar(), start))
+	latest = 1
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	latest = 3
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	api := evmrpc.NewBlobAPI(client, store, evmrpc.ConnectionTypeHTTP)
+	res, err := api.GetBlobSidecars(context.Background(), rpc.BlockNumberOrHashWithNumber(2))
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, hexutil.Uint64(2), res[0].BlockNumber)
+	require.Equal(t, blobTx.Hash(), res[0].TransactionHash)
+	require.Equal(t, hexutil.Uint64(0), res[0].TransactionIndex)
+	require.Equal(t, hexutil.Uint64(0), res[0].Index)
+	require.Equal(t, blobTx.BlobHashes()[0], res[0].VersionedHash)
+	require.Equal(t, hexutil.Bytes(blobTx.BlobTxSidecar().Blobs[0][:]), res[0].Blob)
+
+	_, err = evmrpc.NewBlobAPI(client, nil, evmrpc.ConnectionTypeHTTP).GetBlobSidecars(context.Background(), rpc.BlockNumberOrHashWithNumber(2))
+	require.NotNil(t, err)
+}
+
+func TestSendBlobTransaction(t *testing.T) {
+	_, blobTx := buildBlobTx(t)
+	ctx, _ := Ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(2)
+	EVMKeeper.MockReceipt(ctx, blobTx.Hash(), &types.Receipt{TxType: ethtypes.BlobTxType, TransactionIndex: 0, BlockNumber: 2})
+	ctxProvider := func(int64) sdk.Context { return ctx }
+	client := &blobClient{blocks: map[int64]*coretypes.ResultBlock{}}
+	store, err := evmrpc.NewBlobStore(dbm.NewMemDB(), client, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), 2*time.Hour, log.NewNopLogger())
+	require.Nil(t, err)
+	input, err := blobTx.MarshalBinary()
+	require.Nil(t, err)
+
+	_, err = evmrpc.NewSendAPI(client, TxConfig, &evmrpc.SendConfig{}, EVMKeeper, ctxProvider, t.TempDir(), &SConfig, nil, evmrpc.ConnectionTypeHTTP).SendRawTransaction(context.Background(), input)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "blob store is not enabled")
+
+	api := evmrpc.NewSendAPI(client, TxConfig, &evmrpc.SendConfig{}, EVMKeeper, ctxProvider, t.TempDir(), &SConfig, store, evmrpc.ConnectionTypeHTTP)
+	withoutSidecar, err := blobTx.WithoutBlobTxSidecar().MarshalBinary()
+	require.Nil(t, err)
+	_, err = api.SendRawTransaction(context.Background(), withoutSidecar)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "missing sidecar")
+	require.Empty(t, client.broadcast)
+
+	hash, err := api.SendRawTransaction(context.Background(), input)
+	require.Nil(t, err)
+	require.Equal(t, blobTx.Hash(), hash)
+	// the sidecar is kept by the node and stripped from the broadcast transaction
+	require.Len(t, client.broadcast, 1)
+	broadcastTx := getEthTx(t, client.broadcast[0])
+	require.Equal(t, blobTx.Hash(), broadcastTx.Hash())
+	require.Nil(t, broadcastTx.BlobTxSidecar())
+
+	client.blocks[1] = &coretypes.ResultBlock{Block: &tmtypes.Block{Header: mockBlockHeader(1)}}
+	client.blocks[2] = &coretypes.ResultBlock{Block: &tmtypes.Block{Header: mockBlockHeader(2), Data: tmtypes.Data{Txs: client.broadcast}}}
+	ctx = ctx.WithBlockHeight(1)
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	ctx = ctx.WithBlockHeight(2)
+	require.Nil(t, store.StoreCommittedBlocks(context.Background()))
+	sidecars, err := store.GetBlobSidecars(2)
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, blobTx.BlobTxSidecar().Blobs, sidecars[0].Sidecar.Blobs)
+}
+
+func getEthTx(t *testing.T, bz tmtypes.Tx) *ethtypes.Transaction {
+	sdkTx, err := TxConfig.TxDecoder()(bz)
+	require.Nil(t, err)
+	etx, _ := sdkTx.GetMsgs()[0].(*types.MsgEVMTransaction).AsTransaction()
+	return etx
+}
+
+func TestBlobRPCs(t *testing.T) {
+	resObj := sendRequestGood(t, "blobBaseFee")
+	require.Equal(t, "0x1", resObj["result"])
+
+	resObj = sendRequestGood(t, "getBlobSidecars", "0x8")
+	require.Equal(t, "blob store is not enabled on this node", resObj["error"].(map[string]interface{})["message"])
+
+	resObj = sendRequestGood(t, "getBlockByNumber", "0x8", false)
+	block := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x0", block["blobGasUsed"])
+	require.Equal(t, "0x0", block["excessBlobGas"])
+}
@@ -0,0 +1,363 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// DefaultBlobRetention is how long blob sidecars are kept, which matches the 4096 epochs
+	// Ethereum consensus clients keep them for
+	DefaultBlobRetention = 4096 * 32 * 12 * time.Second
+
+	// BlobStoreInterval is how often the blob store checks for new blocks to store sidecars of
+	BlobStoreInterval = 10 * time.Second
+
+	// PendingBlobSidecarTTL is how long the sidecar of a blob transaction sent to this node is kept
+	// while the transaction waits to be included in a block
+	PendingBlobSidecarTTL = time.Hour
+
+	blobStoreDBName = "evm_blobs"
+)
+
+var (
+	blobSidecarPrefix     = []byte{0x01}
+	blobBlockTimePrefix   = []byte{0x02}
+	blobStoredHeightKey   = []byte{0x03}
+	blobFirstHeightKey    = []byte{0x04}
+	blobPendingPrefix     = []byte{0x05}
+	errBlobStoreNotActive = errors.New("blob store is not enabled on this node")
+)
+
+// StoredBlobSidecar is the sidecar of a blob transaction included in a block
+type StoredBlobSidecar struct {
+	TxHash common.Hash
+	// TxIndex is the index of the transaction among the EVM transactions of the block
+	TxIndex uint64
+	Sidecar *ethtypes.BlobTxSidecar
+}
+
+// pendingBlobSidecar is the sidecar of a blob transaction sent to this node that isn't in a block yet
+type pendingBlobSidecar struct {
+	Time    uint64
+	Sidecar *ethtypes.BlobTxSidecar
+}
+
+// BlobStore keeps the KZG-verified sidecars of the blob transactions of recent blocks in a
+// local database. Blocks only carry the blob hashes, so the store only knows the sidecars of the
+// blob transactions sent to this node. Sidecars aren't part of the EVM state, so they are pruned
+// once older than the retention period.
+type BlobStore struct {
+	db          dbm.DB
+	tmClient    rpcclient.Client
+	k           *keeper.Keeper
+	ctxProvider func(int64) sdk.Context
+	txDecoder   sdk.TxDecoder
+	retention   time.Duration
+	logger      log.Logger
+
+	storedHeight atomic.Int64
+	firstHeight  atomic.Int64
+}
+
+func NewBlobStore(db dbm.DB, tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, retention time.Duration, logger log.Logger) (*BlobStore, error) {
+	if retention <= 0 {
+		return nil, errors.New("blob retention must be positive")
+	}
+	b := &BlobStore{db: db, tmClient: tmClient, k: k, ctxProvider: ctxProvider, txDecoder: txDecoder, retention: retention, logger: logger}
+	if err := loadBlobHeight(db, blobStoredHeightKey, &b.storedHeight); err != nil {
+		return nil, err
+	}
+	if err := loadBlobHeight(db, blobFirstHeightKey, &b.firstHeight); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func loadBlobHeight(db dbm.DB, key []byte, height *atomic.Int64) error {
+	bz, err := db.Get(key)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		height.Store(int64(binary.BigEndian.Uint64(bz)))
+	}
+	return nil
+}
+
+// OpenBlobStore opens the blob store kept in the data directory of the node
+func OpenBlobStore(homeDir string, tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, retention time.Duration, logger log.Logger) (*BlobStore, error) {
+	db, err := dbm.NewGoLevelDB(blobStoreDBName, filepath.Join(homeDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	return NewBlobStore(db, tmClient, k, ctxProvider, txDecoder, retention, logger)
+}
+
+// Start stores the sidecars of new blocks and prunes expired ones in the background
+func (b *BlobStore) Start() {
+	go func() {
+		ticker := time.NewTicker(BlobStoreInterval)
+		defer ticker.Stop()
+		for {
+			if err := b.StoreCommittedBlocks(context.Background()); err != nil {
+				b.logger.Error(fmt.Sprintf("failed to store blob sidecars: %s", err))
+			}
+			if err := b.Prune(time.Now()); err != nil {
+				b.logger.Error(fmt.Sprintf("failed to prune blob sidecars: %s", err))
+			}
+			<-ticker.C
+		}
+	}()
+}
+
+// StoreCommittedBlocks stores the sidecars of every committed block that isn't stored yet.
+// A new store starts from the latest committed block since older sidecars are unknown.
+func (b *BlobStore) StoreCommittedBlocks(ctx context.Context) error {
+	latest := b.ctxProvider(LatestCtxHeight).BlockHeight()
+	if b.storedHeight.Load() == 0 {
+		b.storedHeight.Store(latest - 1)
+		b.firstHeight.Store(latest)
+	}
+	for height := b.storedHeight.Load() + 1; height <= latest; height++ {
+		if err := b.storeBlock(ctx, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BlobStore) storeBlock(ctx context.Context, height int64) error {
+	block, err := blockByNumber(ctx, b.tmClient, &height)
+	if err != nil {
+		return err
+	}
+	sdkCtx := b.ctxProvider(LatestCtxHeight)
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	hasReceipt := func(h common.Hash) bool {
+		_, err := b.k.GetReceipt(sdkCtx, h)
+		return err == nil
+	}
+	for _, tx := range block.Block.Txs {
+		etx := getEthTxForTxBz(tx, b.txDecoder)
+		if etx == nil || etx.Type() != ethtypes.BlobTxType {
+			continue
+		}
+		pendingBz, err := b.db.Get(blobPendingKey(etx.Hash()))
+		if err != nil {
+			return err
+		}
+		// the transaction was sent to another node
+		if pendingBz == nil {
+			continue
+		}
+		// only keep the sidecars of transactions that made it into the block
+		receipt, err := b.k.GetReceipt(sdkCtx, etx.Hash())
+		if err != nil {
+			continue
+		}
+		evmTxIndex, found := GetEvmTxIndex(block.Block.Txs, receipt.TransactionIndex, b.txDecoder, hasReceipt)
+		if !found {
+			continue
+		}
+		pending := &pendingBlobSidecar{}
+		if err := rlp.DecodeBytes(pendingBz, pending); err != nil {
+			return err
+		}
+		txIndex := uint64(evmTxIndex)
+		bz, err := rlp.EncodeToBytes(&StoredBlobSidecar{TxHash: etx.Hash(), TxIndex: txIndex, Sidecar: pending.Sidecar})
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(blobSidecarKey(height, txIndex), bz); err != nil {
+			return err
+		}
+		if err := batch.Delete(blobPendingKey(etx.Hash())); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(blobHeightKey(blobBlockTimePrefix, height), uint64ToBytes(uint64(block.Block.Time.Unix()))); err != nil {
+		return err
+	}
+	if err := batch.Set(blobStoredHeightKey, uint64ToBytes(uint64(height))); err != nil {
+		return err
+	}
+	if b.firstHeight.Load() == height {
+		if err := batch.Set(blobFirstHeightKey, uint64ToBytes(uint64(height))); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	b.storedHeight.Store(height)
+	return nil
+}
+
+// AddPendingSidecar keeps the KZG-verified sidecar of a blob transaction sent to this node until
+// the transaction is included in a block
+func (b *BlobStore) AddPendingSidecar(txHash common.Hash, sidecar *ethtypes.BlobTxSidecar, now time.Time) error {
+	if b == nil {
+		return errBlobStoreNotActive
+	}
+	bz, err := rlp.EncodeToBytes(&pendingBlobSidecar{Time: uint64(now.Unix()), Sidecar: sidecar})
+	if err != nil {
+		return err
+	}
+	return b.db.SetSync(blobPendingKey(txHash), bz)
+}
+
+// RemovePendingSidecar drops the sidecar of a blob transaction that won't be included in a block
+func (b *BlobStore) RemovePendingSidecar(txHash common.Hash) error {
+	return b.db.DeleteSync(blobPendingKey(txHash))
+}
+
+// Prune deletes the sidecars of the blocks older than the retention period, and the pending
+// sidecars of the transactions that weren't included in time
+func (b *BlobStore) Prune(now time.Time) error {
+	if err := b.prunePending(now); err != nil {
+		return err
+	}
+	cutoff := now.Add(-b.retention).Unix()
+	iter, err := b.db.Iterator(blobHeightKey(blobBlockTimePrefix, b.firstHeight.Load()), blobHeightKey(blobBlockTimePrefix, b.storedHeight.Load()+1))
+	if err != nil {
+		return err
+	}
+	var expired []int64
+	for ; iter.Valid(); iter.Next() {
+		if int64(binary.BigEndian.Uint64(iter.Value())) >= cutoff {
+			break
+		}
+		expired = append(expired, int64(binary.BigEndian.Uint64(iter.Key()[len(blobBlockTimePrefix):])))
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for _, height := range expired {
+		sidecars, err := b.db.Iterator(blobHeightKey(blobSidecarPrefix, height), blobHeightKey(blobSidecarPrefix, height+1))
+		if err != nil {
+			return err
+		}
+		for ; sidecars.Valid(); sidecars.Next() {
+			if err := batch.Delete(sidecars.Key()); err != nil {
+				_ = sidecars.Close()
+				return err
+			}
+		}
+		if err := sidecars.Close(); err != nil {
+			return err
+		}
+		if err := batch.Delete(blobHeightKey(blobBlockTimePrefix, height)); err != nil {
+			return err
+		}
+	}
+	firstHeight := expired[len(expired)-1] + 1
+	if err := batch.Set(blobFirstHeightKey, uint64ToBytes(uint64(firstHeight))); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	b.firstHeight.Store(firstHeight)
+	return nil
+}
+
+func (b *BlobStore) prunePending(now time.Time) error {
+	cutoff := uint64(now.Add(-PendingBlobSidecarTTL).Unix())
+	iter, err := b.db.Iterator(blobPendingPrefix, sdk.PrefixEndBytes(blobPendingPrefix))
+	if err != nil {
+		return err
+	}
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pending := &pendingBlobSidecar{}
+		if err := rlp.DecodeBytes(iter.Value(), pending); err != nil {
+			_ = iter.Close()
+			return err
+		}
+		if pending.Time < cutoff {
+			expired = append(expired, iter.Key())
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for _, key := range expired {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// GetBlobSidecars returns the sidecars of the blob transactions of the block at height, in
+// transaction order
+func (b *BlobStore) GetBlobSidecars(height int64) ([]*StoredBlobSidecar, error) {
+	if b == nil {
+		return nil, errBlobStoreNotActive
+	}
+	if height < b.firstHeight.Load() {
+		return nil, fmt.Errorf("blob sidecars of height %d were pruned or never stored", height)
+	}
+	if height > b.storedHeight.Load() {
+		return nil, fmt.Errorf("blob sidecars of height %d aren't stored yet", height)
+	}
+	iter, err := b.db.Iterator(blobHeightKey(blobSidecarPrefix, height), blobHeightKey(blobSidecarPrefix, height+1))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	res := []*StoredBlobSidecar{}
+	for ; iter.Valid(); iter.Next() {
+		sidecar := &StoredBlobSidecar{}
+		if err := rlp.DecodeBytes(iter.Value(), sidecar); err != nil {
+			return nil, err
+		}
+		res = append(res, sidecar)
+	}
+	return res, nil
+}
+
+func blobHeightKey(prefix []byte, height int64) []byte {
+	return append(append([]byte{}, prefix...), uint64ToBytes(uint64(height))...)
+}
+
+func blobSidecarKey(height int64, txIndex uint64) []byte {
+	return append(blobHeightKey(blobSidecarPrefix, height), uint64ToBytes(txIndex)...)
+}
+
+func blobPendingKey(txHash common.Hash) []byte {
+	return append(append([]byte{}, blobPendingPrefix...), txHash[:]...)
+}
+
+func uint64ToBytes(v uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return bz
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -176,7 +177,7 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 				if !a.includeShellReceipts && receipt.TxType == ShellEVMTxType {
 					return
 				}
-				encodedReceipt, err := encodeReceipt(receipt, a.txConfig.TxDecoder(), block, func(h common.Hash) bool {
+				encodedReceipt, err := encodeReceipt(receipt, a.txConfig.TxDecoder(), block, blockBlobBaseFee(a.keeper, a.ctxProvider(height)), func(h common.Hash) bool {
 					_, err := a.keeper.GetReceipt(a.ctxProvider(height), h)
 					return err == nil
 				})
@@ -221,6 +222,7 @@ func EncodeTmBlock(
 	resultHash := common.HexToHash(block.Block.LastResultsHash.String())
 	miner := common.HexToAddress(block.Block.ProposerAddress.String())
 	baseFeePerGas := k.GetDynamicBaseFeePerGas(ctx).TruncateInt().BigInt()
+	blobGasUsed, excessBlobGas := k.GetBlockBlobGas(ctx)
 	var blockGasUsed int64
 	chainConfig := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
 	transactions := []interface{}{}
@@ -237,8 +239,8 @@ func EncodeTmBlock(
 				if m.IsAssociateTx() {
 					continue
 				}
-				ethtx, _ := m.AsTransaction()
-				hash := ethtx.Hash()
+				etx, txData := m.AsTransaction()
+				hash := m.Hash()
 				if !fullTx {
 					transactions = append(transactions, hash)
 				} else {
@@ -249,8 +251,12 @@ func EncodeTmBlock(
 					if !includeSyntheticTxs && receipt.TxType == ShellEVMTxType {
 						continue
 					}
-					newTx := ethapi.NewRPCTransaction(ethtx, blockhash, number.Uint64(), uint64(blockTime.Second()), uint64(receipt.TransactionIndex), baseFeePerGas, chainConfig)
-					transactions = append(transactions, newTx)
+					newTx := ethapi.NewRPCTransaction(etx, blockhash, number.Uint64(), uint64(blockTime.Second()), uint64(receipt.TransactionIndex), baseFeePerGas, chainConfig)
+					if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+						transactions = append(transactions, newSetCodeRPCTransaction(newTx, sctx))
+					} else {
+						transactions = append(transactions, newTx)
+					}
 				}
 			case *wasmtypes.MsgExecuteContract:
 				if !includeSyntheticTxs {
@@ -305,6 +311,8 @@ func EncodeTmBlock(
 		"uncles":           []common.Hash{}, // inapplicable to Kii
 		"transactions":     transactions,
 		"baseFeePerGas":    (*hexutil.Big)(baseFeePerGas),
+		"blobGasUsed":      hexutil.Uint64(blobGasUsed),
+		"excessBlobGas":    hexutil.Uint64(excessBlobGas),
 	}
 	if fullTx {
 		result["totalDifficulty"] = (*hexutil.Big)(big.NewInt(0)) // inapplicable to Kii
@@ -0,0 +1,246 @@
+package evmrpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// BloomBitsSectionSize is the number of heights covered by a bloombits section
+	BloomBitsSectionSize = 4096
+
+	// BloomIndexInterval is how often the index checks for new sections to index
+	BloomIndexInterval = 10 * time.Second
+
+	bloomIndexDBName = "evm_bloombits"
+)
+
+var (
+	bloomBitsPrefix      = []byte{0x01}
+	bloomBitsSectionsKey = []byte{0x02}
+)
+
+// BloomIndex is a geth-style bloombits index of the block blooms. Heights are grouped into
+// sections of sectionSize heights and, for each section, the blooms are transposed into one
+// bit vector per bloom bit where each bit of the vector is set if the bloom of the matching
+// height has that bloom bit set. Range filters then only need to load the few vectors of the
+// bits they reference instead of the bloom of every height.
+type BloomIndex struct {
+	db          dbm.DB
+	k           *keeper.Keeper
+	ctxProvider func(int64) sdk.Context
+	sectionSize uint64
+	logger      log.Logger
+
+	sections atomic.Uint64
+}
+
+func NewBloomIndex(db dbm.DB, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, sectionSize uint64, logger log.Logger) (*BloomIndex, error) {
+	if sectionSize == 0 || sectionSize%8 != 0 {
+		return nil, errors.New("bloombits section size must be a positive multiple of 8")
+	}
+	b := &BloomIndex{db: db, k: k, ctxProvider: ctxProvider, sectionSize: sectionSize, logger: logger}
+	bz, err := db.Get(bloomBitsSectionsKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz != nil {
+		b.sections.Store(binary.BigEndian.Uint64(bz))
+	}
+	return b, nil
+}
+
+// OpenBloomIndex opens the bloombits index stored in the data directory of the node
+func OpenBloomIndex(homeDir string, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, logger log.Logger) (*BloomIndex, error) {
+	db, err := dbm.NewGoLevelDB(bloomIndexDBName, filepath.Join(homeDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	return NewBloomIndex(db, k, ctxProvider, BloomBitsSectionSize, logger)
+}
+
+// Start indexes new sections in the background as their heights get committed
+func (b *BloomIndex) Start() {
+	go func() {
+		ticker := time.NewTicker(BloomIndexInterval)
+		defer ticker.Stop()
+		for {
+			if err := b.IndexCommittedSections(); err != nil {
+				b.logger.Error(fmt.Sprintf("failed to index bloombits: %s", err))
+			}
+			<-ticker.C
+		}
+	}()
+}
+
+// Sections returns the number of fully indexed sections
+func (b *BloomIndex) Sections() uint64 {
+	return b.sections.Load()
+}
+
+// IndexedHeight returns the first height that isn't indexed yet
+func (b *BloomIndex) IndexedHeight() int64 {
+	return int64(b.Sections() * b.sectionSize)
+}
+
+// IndexCommittedSections indexes every section whose heights are all committed
+func (b *BloomIndex) IndexCommittedSections() error {
+	latest := b.ctxProvider(LatestCtxHeight).BlockHeight()
+	for {
+		section := b.Sections()
+		if int64((section+1)*b.sectionSize) > latest {
+			return nil
+		}
+		if err := b.indexSection(section); err != nil {
+			return err
+		}
+	}
+}
+
+func (b *BloomIndex) indexSection(section uint64) error {
+	gen, err := bloombits.NewGenerator(uint(b.sectionSize))
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < b.sectionSize; i++ {
+		height := int64(section*b.sectionSize + i)
+		var bloom ethtypes.Bloom
+		// no block bloom on genesis height
+		if height > 0 {
+			ctx := b.ctxProvider(height)
+			// the context of pruned heights falls back to the latest one
+			if ctx.BlockHeight() != height {
+				return fmt.Errorf("state of height %d is not available, section %d can't be indexed", height, section)
+			}
+			bloom = b.k.GetBlockBloom(ctx)
+		}
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return err
+		}
+	}
+
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return err
+		}
+		compressed := bitutil.CompressBytes(bits)
+		// vectors without any bit set aren't stored
+		if len(compressed) == 0 {
+			continue
+		}
+		if err := batch.Set(bloomBitsKey(section, bit), compressed); err != nil {
+			return err
+		}
+	}
+	sectionsBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(sectionsBz, section+1)
+	if err := batch.Set(bloomBitsSectionsKey, sectionsBz); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	b.sections.Store(section + 1)
+	return nil
+}
+
+// FindBlocksByBloom returns the heights between begin and end whose bloom matches the filters,
+// only looking at the indexed heights. It also returns the first height after begin that
+// wasn't looked at, which is end + 1 if the whole range is indexed.
+func (b *BloomIndex) FindBlocksByBloom(begin, end int64, filters [][]bloomIndexes) (res []int64, next int64, err error) {
+	indexedHeight := b.IndexedHeight()
+	if begin >= indexedHeight {
+		return nil, begin, nil
+	}
+	if end >= indexedHeight {
+		end = indexedHeight - 1
+	}
+	for section := uint64(begin) / b.sectionSize; section <= uint64(end)/b.sectionSize; section++ {
+		matches, err := b.matchSection(section, filters)
+		if err != nil {
+			return nil, 0, err
+		}
+		sectionStart := int64(section * b.sectionSize)
+		for i := uint64(0); i < b.sectionSize; i++ {
+			height := sectionStart + int64(i)
+			if height < begin || height > end || height == 0 {
+				continue
+			}
+			if matches[i/8]&(1<<(7-i%8)) != 0 {
+				res = append(res, height)
+			}
+		}
+	}
+	return res, end + 1, nil
+}
+
+// matchSection returns the bit vector of the heights of the section matching the filters,
+// with the same AND/OR/AND semantics as MatchFilters
+func (b *BloomIndex) matchSection(section uint64, filters [][]bloomIndexes) ([]byte, error) {
+	vectorSize := int(b.sectionSize / 8)
+	vectors := map[uint][]byte{}
+	getVector := func(bit uint) ([]byte, error) {
+		if vector, ok := vectors[bit]; ok {
+			return vector, nil
+		}
+		bz, err := b.db.Get(bloomBitsKey(section, bit))
+		if err != nil {
+			return nil, err
+		}
+		// bz is nil if no height of the section has the bit set
+		vector, err := bitutil.DecompressBytes(bz, vectorSize)
+		if err != nil {
+			return nil, err
+		}
+		vectors[bit] = vector
+		return vector, nil
+	}
+	allSet := func() []byte {
+		vector := make([]byte, vectorSize)
+		for i := range vector {
+			vector[i] = 0xff
+		}
+		return vector
+	}
+
+	res := allSet()
+	for _, filter := range filters {
+		filterRes := make([]byte, vectorSize)
+		for _, idxs := range filter {
+			idxsRes := allSet()
+			for _, bit := range idxs {
+				vector, err := getVector(bit)
+				if err != nil {
+					return nil, err
+				}
+				bitutil.ANDBytes(idxsRes, idxsRes, vector)
+			}
+			bitutil.ORBytes(filterRes, filterRes, idxsRes)
+		}
+		bitutil.ANDBytes(res, res, filterRes)
+	}
+	return res, nil
+}
+
+func bloomBitsKey(section uint64, bit uint) []byte {
+	key := make([]byte, len(bloomBitsPrefix)+8+2)
+	copy(key, bloomBitsPrefix)
+	binary.BigEndian.PutUint64(key[len(bloomBitsPrefix):], section)
+	binary.BigEndian.PutUint16(key[len(bloomBitsPrefix)+8:], uint16(bit))
+	return key
+}
@@ -0,0 +1,84 @@
+package evmrpc_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBloomIndex(t *testing.T) {
+	addr := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	otherAddr := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	latest := int64(20)
+	ctxs := map[int64]sdk.Context{}
+	for height := int64(1); height <= latest; height++ {
+		ctx, _ := Ctx.CacheContext()
+		ctx = ctx.WithBlockHeight(height)
+		bloom := ethtypes.Bloom{}
+		if height == 5 || height == 13 || height == 19 {
+			bloom.Add(addr.Bytes())
+		} else {
+			bloom.Add(otherAddr.Bytes())
+		}
+		EVMKeeper.SetBlockBloom(ctx, []ethtypes.Bloom{bloom})
+		ctxs[height] = ctx
+	}
+	ctxProvider := func(height int64) sdk.Context {
+		if height == evmrpc.LatestCtxHeight {
+			return ctxs[latest]
+		}
+		return ctxs[height]
+	}
+	db := dbm.NewMemDB()
+	index, err := evmrpc.NewBloomIndex(db, EVMKeeper, ctxProvider, 8, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Equal(t, int64(0), index.IndexedHeight())
+
+	// heights 16 to 20 don't form a full section yet
+	require.Nil(t, index.IndexCommittedSections())
+	require.Equal(t, uint64(2), index.Sections())
+	require.Equal(t, int64(16), index.IndexedHeight())
+
+	res, next, err := index.FindBlocksByBloom(1, 20, evmrpc.EncodeFilters([]common.Address{addr}, nil))
+	require.Nil(t, err)
+	require.Equal(t, []int64{5, 13}, res)
+	require.Equal(t, int64(16), next)
+
+	res, next, err = index.FindBlocksByBloom(6, 12, evmrpc.EncodeFilters([]common.Address{addr}, nil))
+	require.Nil(t, err)
+	require.Empty(t, res)
+	require.Equal(t, int64(13), next)
+
+	res, _, err = index.FindBlocksByBloom(1, 15, evmrpc.EncodeFilters([]common.Address{addr, otherAddr}, nil))
+	require.Nil(t, err)
+	require.Len(t, res, 15)
+
+	res, next, err = index.FindBlocksByBloom(17, 20, evmrpc.EncodeFilters([]common.Address{addr}, nil))
+	require.Nil(t, err)
+	require.Empty(t, res)
+	require.Equal(t, int64(17), next)
+
+	// the indexed sections are persisted
+	reopened, err := evmrpc.NewBloomIndex(db, EVMKeeper, ctxProvider, 8, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), reopened.Sections())
+
+	// sections with pruned heights aren't indexed
+	latest = 24
+	ctxs[latest] = ctxs[20].WithBlockHeight(latest)
+	ctxs[18] = ctxs[latest]
+	require.NotNil(t, reopened.IndexCommittedSections())
+	require.Equal(t, uint64(2), reopened.Sections())
+	reopened, err = evmrpc.NewBloomIndex(db, EVMKeeper, ctxProvider, 8, log.NewNopLogger())
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), reopened.Sections())
+
+	_, err = evmrpc.NewBloomIndex(dbm.NewMemDB(), EVMKeeper, ctxProvider, 10, log.NewNopLogger())
+	require.NotNil(t, err)
+}
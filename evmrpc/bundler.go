@@ -0,0 +1,323 @@
+package evmrpc
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/kiichain/kiichain/x/evm/artifacts/entrypoint"
+)
+
+// BundlerAPI exposes the ERC-4337 bundler methods, which let account-abstraction users send
+// UserOperations to the node without a separate bundler service
+type BundlerAPI struct {
+	bundler        *Bundler
+	connectionType ConnectionType
+}
+
+func NewBundlerAPI(bundler *Bundler, connectionType ConnectionType) *BundlerAPI {
+	return &BundlerAPI{bundler: bundler, connectionType: connectionType}
+}
+
+// UserOperationGasEstimate is the gas a UserOperation needs, as returned by eth_estimateUserOperationGas
+type UserOperationGasEstimate struct {
+	PreVerificationGas            hexutil.Uint64  `json:"preVerificationGas"`
+	VerificationGasLimit          hexutil.Uint64  `json:"verificationGasLimit"`
+	CallGasLimit                  hexutil.Uint64  `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Uint64 `json:"paymasterVerificationGasLimit,omitempty"`
+}
+
+// UserOperationResult is a UserOperation along with where it was included, which is null while
+// it is pending
+type UserOperationResult struct {
+	UserOperation   *UserOperation  `json:"userOperation"`
+	EntryPoint      common.Address  `json:"entryPoint"`
+	TransactionHash *common.Hash    `json:"transactionHash"`
+	BlockHash       *common.Hash    `json:"blockHash"`
+	BlockNumber     *hexutil.Uint64 `json:"blockNumber"`
+}
+
+func (a *BundlerAPI) SendUserOperation(ctx context.Context, op UserOperation, entryPoint common.Address) (result common.Hash, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("eth_sendUserOperation", a.connectionType, startTime, returnErr)
+	return a.bundler.AddUserOp(ctx, &op, entryPoint)
+}
+
+// EstimateUserOperationGas estimates the gas limits of a UserOperation. Missing gas and fee
+// fields are treated as zero and the signature doesn't have to be valid if the sender is already
+// deployed, since validation is then estimated by calling the account directly. A sender that
+// isn't deployed yet can only be estimated through handleOps, which requires a valid signature,
+// and then gets the gas of the whole operation as both verification and call gas limit.
+func (a *BundlerAPI) EstimateUserOperationGas(ctx context.Context, op UserOperation, entryPoint common.Address) (result *UserOperationGasEstimate, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("eth_estimateUserOperationGas", a.connectionType, startTime, returnErr)
+	b := a.bundler
+	if !b.supportsEntryPoint(entryPoint) {
+		return nil, &SendError{code: UserOpInvalidFieldsErrorCode, msg: "unsupported entry point " + entryPoint.Hex()}
+	}
+	zero := (*hexutil.Big)(new(big.Int))
+	for _, field := range []**hexutil.Big{&op.CallGasLimit, &op.VerificationGasLimit, &op.MaxFeePerGas, &op.MaxPriorityFeePerGas} {
+		if *field == nil {
+			*field = zero
+		}
+	}
+	if op.Paymaster != nil {
+		for _, field := range []**hexutil.Big{&op.PaymasterVerificationGasLimit, &op.PaymasterPostOpGasLimit} {
+			if *field == nil {
+				*field = zero
+			}
+		}
+	}
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(op.MinPreVerificationGas()))
+	if err := op.Validate(); err != nil {
+		return nil, &SendError{code: UserOpInvalidFieldsErrorCode, msg: err.Error()}
+	}
+	if err := b.checkDeployments(&op, entryPoint); err != nil {
+		return nil, err
+	}
+	result = &UserOperationGasEstimate{PreVerificationGas: hexutil.Uint64(op.PreVerificationGas.ToInt().Uint64())}
+	if op.Factory != nil {
+		total, err := a.estimateHandleOp(ctx, op, entryPoint)
+		if err != nil {
+			return nil, err
+		}
+		result.VerificationGasLimit, result.CallGasLimit = total, total
+		if op.Paymaster != nil {
+			result.PaymasterVerificationGasLimit = &total
+		}
+		return result, nil
+	}
+	hash := op.Hash(entryPoint, b.chainID())
+	validateData, err := entrypoint.GetParsedAccountABI().Pack("validateUserOp", op.Pack(), hash, new(big.Int))
+	if err != nil {
+		return nil, err
+	}
+	if result.VerificationGasLimit, err = a.estimateCall(ctx, entryPoint, op.Sender, validateData); err != nil {
+		return nil, err
+	}
+	if len(op.CallData) > 0 {
+		callGas, err := a.estimateCall(ctx, entryPoint, op.Sender, op.CallData)
+		if err != nil {
+			return nil, err
+		}
+		// the EntryPoint calls the account directly, so the intrinsic gas of a transaction isn't needed
+		intrinsicGas, err := core.IntrinsicGas(op.CallData, nil, false, true, true, true)
+		if err != nil {
+			return nil, err
+		}
+		result.CallGasLimit = callGas - hexutil.Uint64(intrinsicGas)
+	}
+	if op.Paymaster != nil {
+		paymasterData, err := entrypoint.GetParsedPaymasterABI().Pack("validatePaymasterUserOp", op.Pack(), hash, new(big.Int))
+		if err != nil {
+			return nil, err
+		}
+		paymasterGas, err := a.estimateCall(ctx, entryPoint, *op.Paymaster, paymasterData)
+		if err != nil {
+			return nil, err
+		}
+		result.PaymasterVerificationGasLimit = &paymasterGas
+	}
+	return result, nil
+}
+
+func (a *BundlerAPI) estimateCall(ctx context.Context, from common.Address, to common.Address, data []byte) (hexutil.Uint64, error) {
+	input := hexutil.Bytes(data)
+	return a.bundler.simulationAPI.EstimateGas(ctx, ethapi.TransactionArgs{From: &from, To: &to, Input: &input}, nil, nil)
+}
+
+// estimateHandleOp estimates handleOps with the UserOperation, giving it a quarter of the gas cap
+// for each of its limits and no fees so that it doesn't need a deposit
+func (a *BundlerAPI) estimateHandleOp(ctx context.Context, op UserOperation, entryPoint common.Address) (hexutil.Uint64, error) {
+	limit := (*hexutil.Big)(new(big.Int).SetUint64(a.bundler.simulationAPI.backend.RPCGasCap() / 4))
+	op.VerificationGasLimit, op.CallGasLimit = limit, limit
+	if op.Paymaster != nil {
+		op.PaymasterVerificationGasLimit, op.PaymasterPostOpGasLimit = limit, limit
+	}
+	zero := (*hexutil.Big)(new(big.Int))
+	op.MaxFeePerGas, op.MaxPriorityFeePerGas = zero, zero
+	failure, err := a.bundler.simulateHandleOps(ctx, entryPoint, []*UserOperation{&op})
+	if err != nil {
+		return 0, err
+	}
+	if failure != nil {
+		return 0, userOpError(failure.reason)
+	}
+	args, err := a.bundler.handleOpsArgs(entryPoint, []*UserOperation{&op})
+	if err != nil {
+		return 0, err
+	}
+	return a.bundler.simulationAPI.EstimateGas(ctx, args, nil, nil)
+}
+
+func (a *BundlerAPI) GetUserOperationByHash(ctx context.Context, hash common.Hash) (result *UserOperationResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_getUserOperationByHash", a.connectionType, startTime, returnErr == nil)
+	pooled, txHash := a.bundler.Lookup(hash)
+	if pooled == nil {
+		return nil, nil
+	}
+	result = &UserOperationResult{UserOperation: pooled.UserOp, EntryPoint: pooled.EntryPoint}
+	if txHash == (common.Hash{}) {
+		return result, nil
+	}
+	sendAPI := a.bundler.sendAPI
+	receipt, err := sendAPI.keeper.GetReceipt(sendAPI.ctxProvider(LatestCtxHeight), txHash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return result, nil
+		}
+		return nil, err
+	}
+	height := int64(receipt.BlockNumber)
+	block, err := blockByNumberWithRetry(ctx, sendAPI.tmClient, &height, 1)
+	if err != nil {
+		return nil, err
+	}
+	blockHash := common.HexToHash(block.BlockID.Hash.String())
+	blockNumber := hexutil.Uint64(receipt.BlockNumber)
+	result.TransactionHash, result.BlockHash, result.BlockNumber = &txHash, &blockHash, &blockNumber
+	return result, nil
+}
+
+// GetUserOperationReceipt returns the outcome of a UserOperation once its bundle is included,
+// along with the logs it emitted and the receipt of the bundle transaction
+func (a *BundlerAPI) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_getUserOperationReceipt", a.connectionType, startTime, returnErr == nil)
+	pooled, txHash := a.bundler.Lookup(hash)
+	if pooled == nil || txHash == (common.Hash{}) {
+		return nil, nil
+	}
+	sendAPI := a.bundler.sendAPI
+	sdkCtx := sendAPI.ctxProvider(LatestCtxHeight)
+	receipt, err := sendAPI.keeper.GetReceipt(sdkCtx, txHash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	height := int64(receipt.BlockNumber)
+	block, err := blockByNumberWithRetry(ctx, sendAPI.tmClient, &height, 1)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := encodeReceipt(receipt, sendAPI.txConfig.TxDecoder(), block, blockBlobBaseFee(sendAPI.keeper, sendAPI.ctxProvider(height)), func(h common.Hash) bool {
+		_, err := sendAPI.keeper.GetReceipt(sdkCtx, h)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logs, _ := fields["logs"].([]*ethtypes.Log)
+	for _, event := range findUserOpEvents(logs, pooled.EntryPoint) {
+		if event.userOpHash != hash {
+			continue
+		}
+		opLogs := userOpLogs(logs, event.logPos, pooled.EntryPoint)
+		result = map[string]interface{}{
+			"userOpHash":    hash,
+			"entryPoint":    pooled.EntryPoint,
+			"sender":        event.sender,
+			"nonce":         (*hexutil.Big)(event.nonce),
+			"paymaster":     event.paymaster,
+			"actualGasCost": (*hexutil.Big)(event.actualGasCost),
+			"actualGasUsed": (*hexutil.Big)(event.actualGasUsed),
+			"success":       event.success,
+			"logs":          opLogs,
+			"receipt":       fields,
+		}
+		if !event.success {
+			result["reason"] = userOpRevertReason(opLogs, hash, pooled.EntryPoint)
+		}
+		return result, nil
+	}
+	return nil, nil
+}
+
+func (a *BundlerAPI) SupportedEntryPoints(_ context.Context) (result []common.Address, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_supportedEntryPoints", a.connectionType, startTime, true)
+	return a.bundler.config.EntryPoints, nil
+}
+
+// userOpEvent is a decoded UserOperationEvent, emitted by the EntryPoint for every UserOperation
+// it executed
+type userOpEvent struct {
+	logPos        int
+	userOpHash    common.Hash
+	sender        common.Address
+	paymaster     common.Address
+	nonce         *big.Int
+	success       bool
+	actualGasCost *big.Int
+	actualGasUsed *big.Int
+}
+
+func findUserOpEvents(logs []*ethtypes.Log, entryPoint common.Address) []*userOpEvent {
+	abiEvent := entrypoint.GetParsedABI().Events["UserOperationEvent"]
+	res := []*userOpEvent{}
+	for i, l := range logs {
+		if l.Address != entryPoint || len(l.Topics) != 4 || l.Topics[0] != abiEvent.ID {
+			continue
+		}
+		values, err := abiEvent.Inputs.Unpack(l.Data)
+		if err != nil || len(values) != 4 {
+			continue
+		}
+		nonce, _ := values[0].(*big.Int)
+		success, _ := values[1].(bool)
+		actualGasCost, _ := values[2].(*big.Int)
+		actualGasUsed, _ := values[3].(*big.Int)
+		res = append(res, &userOpEvent{
+			logPos:        i,
+			userOpHash:    l.Topics[1],
+			sender:        common.BytesToAddress(l.Topics[2].Bytes()),
+			paymaster:     common.BytesToAddress(l.Topics[3].Bytes()),
+			nonce:         nonce,
+			success:       success,
+			actualGasCost: actualGasCost,
+			actualGasUsed: actualGasUsed,
+		})
+	}
+	return res
+}
+
+// userOpLogs returns the logs emitted while executing a UserOperation, which are the ones between
+// its UserOperationEvent and the previous one, or the BeforeExecution event for the first operation
+func userOpLogs(logs []*ethtypes.Log, eventPos int, entryPoint common.Address) []*ethtypes.Log {
+	epABI := entrypoint.GetParsedABI()
+	start := eventPos
+	for start > 0 {
+		l := logs[start-1]
+		if l.Address == entryPoint && len(l.Topics) > 0 && (l.Topics[0] == epABI.Events["UserOperationEvent"].ID || l.Topics[0] == epABI.Events["BeforeExecution"].ID) {
+			break
+		}
+		start--
+	}
+	return logs[start:eventPos]
+}
+
+// userOpRevertReason returns the revert data of the UserOperation's call, if it reverted
+func userOpRevertReason(logs []*ethtypes.Log, hash common.Hash, entryPoint common.Address) hexutil.Bytes {
+	abiEvent := entrypoint.GetParsedABI().Events["UserOperationRevertReason"]
+	for _, l := range logs {
+		if l.Address != entryPoint || len(l.Topics) != 3 || l.Topics[0] != abiEvent.ID || l.Topics[1] != hash {
+			continue
+		}
+		values, err := abiEvent.Inputs.Unpack(l.Data)
+		if err != nil || len(values) != 2 {
+			continue
+		}
+		reason, _ := values[1].([]byte)
+		return reason
+	}
+	return nil
+}
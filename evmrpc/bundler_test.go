@@ -0,0 +1,195 @@
+package evmrpc_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/kiichain/kiichain/x/evm/artifacts/entrypoint"
+	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestBundler(t *testing.T, ctx sdk.Context) (*evmrpc.Bundler, common.Address) {
+	homeDir := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, homeDir, nil)
+	require.Nil(t, err)
+	mnemonic := "fish mention unlock february marble dove vintage sand hub ordinary fade found inject room embark supply fabric improve spike stem give current similar glimpse"
+	info, err := kb.NewAccount("bundler", mnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Nil(t, err)
+	pubKey, err := crypto.DecompressPubkey(info.GetPubKey().Bytes())
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(*pubKey)
+
+	ctxProvider := func(int64) sdk.Context { return ctx }
+	sendAPI := evmrpc.NewSendAPI(&MockClient{}, TxConfig, &evmrpc.SendConfig{}, EVMKeeper, ctxProvider, homeDir, &SConfig, nil, evmrpc.ConnectionTypeHTTP)
+	simulationAPI := evmrpc.NewSimulationAPI(ctxProvider, EVMKeeper, Decoder, &MockClient{}, &SConfig, evmrpc.ConnectionTypeHTTP)
+	config := &evmrpc.BundlerConfig{Address: common.HexToAddress("0x1234"), EntryPoints: []common.Address{entrypoint.V07Address}, Interval: 1, MaxBundleOps: 10}
+	_, err = evmrpc.NewBundler(log.NewNopLogger(), config, sendAPI, simulationAPI)
+	require.NotNil(t, err)
+	config.Address = address
+	bundler, err := evmrpc.NewBundler(log.NewNopLogger(), config, sendAPI, simulationAPI)
+	require.Nil(t, err)
+	return bundler, address
+}
+
+func testUserOp(sender common.Address) evmrpc.UserOperation {
+	op := evmrpc.UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(0)),
+		CallData:             []byte{1, 2, 3},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(1000000000000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1000000000)),
+		Signature:            make([]byte, 65),
+	}
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(op.MinPreVerificationGas()))
+	return op
+}
+
+func requireUserOpErrorCode(t *testing.T, err error, code int) {
+	require.NotNil(t, err)
+	rpcErr, ok := err.(rpc.Error)
+	require.True(t, ok)
+	require.Equal(t, code, rpcErr.ErrorCode())
+}
+
+func TestUserOperationPack(t *testing.T) {
+	op := testUserOp(common.HexToAddress("0x100"))
+	paymaster := common.HexToAddress("0x200")
+	op.Paymaster = &paymaster
+	op.PaymasterVerificationGasLimit = (*hexutil.Big)(big.NewInt(3))
+	op.PaymasterPostOpGasLimit = (*hexutil.Big)(big.NewInt(4))
+	op.PaymasterData = []byte{5}
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(op.MinPreVerificationGas()))
+	packed := op.Pack()
+	require.Equal(t, big.NewInt(100000), new(big.Int).SetBytes(packed.AccountGasLimits[:16]))
+	require.Equal(t, big.NewInt(100000), new(big.Int).SetBytes(packed.AccountGasLimits[16:]))
+	require.Equal(t, big.NewInt(1000000000), new(big.Int).SetBytes(packed.GasFees[:16]))
+	require.Equal(t, big.NewInt(1000000000000), new(big.Int).SetBytes(packed.GasFees[16:]))
+	require.Len(t, packed.PaymasterAndData, 20+32+1)
+	require.Equal(t, paymaster.Bytes(), packed.PaymasterAndData[:20])
+	require.Equal(t, byte(3), packed.PaymasterAndData[35])
+	require.Equal(t, byte(4), packed.PaymasterAndData[51])
+	require.Empty(t, packed.InitCode)
+	require.Nil(t, op.Validate())
+
+	// the hash commits to the entry point and chain, but not to the signature
+	hash := op.Hash(entrypoint.V07Address, big.NewInt(1))
+	require.NotEqual(t, hash, op.Hash(common.HexToAddress("0x1"), big.NewInt(1)))
+	require.NotEqual(t, hash, op.Hash(entrypoint.V07Address, big.NewInt(2)))
+	op.Signature = []byte{1}
+	require.Equal(t, hash, op.Hash(entrypoint.V07Address, big.NewInt(1)))
+
+	op.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(1000000000001))
+	require.NotNil(t, op.Validate())
+	op = testUserOp(common.HexToAddress("0x100"))
+	op.PreVerificationGas = (*hexutil.Big)(big.NewInt(1))
+	require.NotNil(t, op.Validate())
+	op = testUserOp(common.HexToAddress("0x100"))
+	op.CallGasLimit = nil
+	require.NotNil(t, op.Validate())
+}
+
+func TestBundler(t *testing.T) {
+	// simulations run against height 1, which is the version committed during setup
+	ctx := Ctx.WithBlockHeight(1)
+	bundler, bundlerAddress := newTestBundler(t, ctx)
+	api := evmrpc.NewBundlerAPI(bundler, evmrpc.ConnectionTypeHTTP)
+	sender := common.HexToAddress("0x4337")
+
+	entryPoints, err := api.SupportedEntryPoints(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []common.Address{entrypoint.V07Address}, entryPoints)
+
+	_, err = api.SendUserOperation(context.Background(), testUserOp(sender), common.HexToAddress("0x1"))
+	requireUserOpErrorCode(t, err, evmrpc.UserOpInvalidFieldsErrorCode)
+	// the entry point and the sender have to be deployed
+	_, err = api.SendUserOperation(context.Background(), testUserOp(sender), entrypoint.V07Address)
+	requireUserOpErrorCode(t, err, evmrpc.UserOpInvalidFieldsErrorCode)
+	EVMKeeper.SetCode(ctx, entrypoint.V07Address, []byte{0x00})
+	_, err = api.SendUserOperation(context.Background(), testUserOp(sender), entrypoint.V07Address)
+	requireUserOpErrorCode(t, err, evmrpc.UserOpInvalidFieldsErrorCode)
+	EVMKeeper.SetCode(ctx, sender, []byte{0x00})
+	lowFee := testUserOp(sender)
+	lowFee.MaxFeePerGas = (*hexutil.Big)(big.NewInt(1))
+	lowFee.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(1))
+	_, err = api.SendUserOperation(context.Background(), lowFee, entrypoint.V07Address)
+	requireUserOpErrorCode(t, err, evmrpc.UserOpInvalidFieldsErrorCode)
+
+	estimate, err := api.EstimateUserOperationGas(context.Background(), evmrpc.UserOperation{Sender: sender, Nonce: (*hexutil.Big)(big.NewInt(0)), CallData: []byte{1}}, entrypoint.V07Address)
+	require.Nil(t, err)
+	require.Greater(t, uint64(estimate.PreVerificationGas), uint64(0))
+	require.GreaterOrEqual(t, uint64(estimate.VerificationGasLimit), uint64(21000))
+	require.Less(t, uint64(estimate.CallGasLimit), uint64(21000))
+	require.Nil(t, estimate.PaymasterVerificationGasLimit)
+
+	op := testUserOp(sender)
+	hash, err := api.SendUserOperation(context.Background(), op, entrypoint.V07Address)
+	require.Nil(t, err)
+	require.Equal(t, op.Hash(entrypoint.V07Address, EVMKeeper.ChainID(ctx)), hash)
+	_, err = api.SendUserOperation(context.Background(), op, entrypoint.V07Address)
+	require.NotNil(t, err)
+	result, err := api.GetUserOperationByHash(context.Background(), hash)
+	require.Nil(t, err)
+	require.Equal(t, entrypoint.V07Address, result.EntryPoint)
+	require.Equal(t, sender, result.UserOperation.Sender)
+	require.Nil(t, result.TransactionHash)
+	result, err = api.GetUserOperationByHash(context.Background(), common.Hash{1})
+	require.Nil(t, err)
+	require.Nil(t, result)
+
+	// bundling moves the operation out of the pool
+	require.Nil(t, bundler.Bundle(context.Background()))
+	require.Nil(t, bundler.Pool().Get(hash))
+	pooled, txHash := bundler.Lookup(hash)
+	require.NotNil(t, pooled)
+	require.NotEqual(t, common.Hash{}, txHash)
+	receipt, err := api.GetUserOperationReceipt(context.Background(), hash)
+	require.Nil(t, err)
+	require.Nil(t, receipt)
+
+	event := entrypoint.GetParsedABI().Events["UserOperationEvent"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(0), true, big.NewInt(21000000), big.NewInt(21000))
+	require.Nil(t, err)
+	require.Nil(t, EVMKeeper.MockReceipt(ctx, txHash, &types.Receipt{
+		TxType:      2,
+		TxHashHex:   txHash.Hex(),
+		BlockNumber: MockHeight,
+		From:        bundlerAddress.Hex(),
+		To:          entrypoint.V07Address.Hex(),
+		Status:      1,
+		Logs: []*types.Log{
+			{Address: entrypoint.V07Address.Hex(), Topics: []string{entrypoint.GetParsedABI().Events["BeforeExecution"].ID.Hex()}},
+			{Address: sender.Hex(), Topics: []string{common.Hash{2}.Hex()}, Index: 1},
+			{Address: entrypoint.V07Address.Hex(), Topics: []string{event.ID.Hex(), hash.Hex(), common.BytesToHash(sender.Bytes()).Hex(), common.Hash{}.Hex()}, Data: data, Index: 2},
+		},
+	}))
+	receipt, err = api.GetUserOperationReceipt(context.Background(), hash)
+	require.Nil(t, err)
+	require.Equal(t, hash, receipt["userOpHash"])
+	require.Equal(t, sender, receipt["sender"])
+	require.Equal(t, true, receipt["success"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(21000000)), receipt["actualGasCost"])
+	require.Len(t, receipt["logs"], 1)
+	require.NotNil(t, receipt["receipt"])
+	result, err = api.GetUserOperationByHash(context.Background(), hash)
+	require.Nil(t, err)
+	require.Equal(t, txHash, *result.TransactionHash)
+	require.Equal(t, hexutil.Uint64(MockHeight), *result.BlockNumber)
+
+	// the next bundle can go out once the previous one is included
+	require.Nil(t, bundler.Bundle(context.Background()))
+	pooled, _ = bundler.Lookup(hash)
+	require.NotNil(t, pooled)
+}
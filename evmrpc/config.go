@@ -1,10 +1,15 @@
 package evmrpc
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain/x/evm/artifacts/entrypoint"
 	"github.com/spf13/cast"
 )
 
@@ -83,6 +88,61 @@ type Config struct {
 	// max number of concurrent NewHead subscriptions
 	MaxSubscriptionsNewHead uint64 `mapstructure:"max_subscriptions_new_head"`
 
+	// controls whether to build a bloombits index to speed up log queries over large block ranges.
+	// Only meant for archive nodes since the index is built from the historical state.
+	BloomIndexEnabled bool `mapstructure:"bloom_index_enabled"`
+
+	// controls whether to accept blob transactions and store their sidecars to serve eth_getBlobSidecars.
+	// Blocks don't carry sidecars, so a node only knows the sidecars of the blob transactions sent to it.
+	BlobStoreEnabled bool `mapstructure:"blob_store_enabled"`
+
+	// how long blob sidecars are kept before being pruned
+	BlobRetention time.Duration `mapstructure:"blob_retention"`
+
+	// hex-encoded 32-byte secret; if set, every request must carry a JWT signed with it
+	JwtSecret string `mapstructure:"jwt_secret"`
+
+	// static API keys, sent in the X-Api-Key header or the api_key query param.
+	// Requests without an API key are rate limited per IP.
+	APIKeys []string `mapstructure:"api_keys"`
+
+	// max number of requests per second for each IP, 0 means no limit
+	IPRequestsPerSecond uint64 `mapstructure:"ip_requests_per_second"`
+
+	// max number of compute units per second for each IP, 0 means no limit
+	IPComputeUnitsPerSecond uint64 `mapstructure:"ip_compute_units_per_second"`
+
+	// max number of requests per second for each API key, 0 means no limit
+	APIKeyRequestsPerSecond uint64 `mapstructure:"api_key_requests_per_second"`
+
+	// max number of compute units per second for each API key, 0 means no limit
+	APIKeyComputeUnitsPerSecond uint64 `mapstructure:"api_key_compute_units_per_second"`
+
+	// compute units charged per method as "<method>=<compute units>", a trailing * matches a method prefix
+	MethodComputeUnits []string `mapstructure:"method_compute_units"`
+
+	// controls whether to serve the ERC-4337 bundler methods (eth_sendUserOperation etc.) over HTTP
+	BundlerEnabled bool `mapstructure:"bundler_enabled"`
+
+	// address that signs bundle transactions and collects their fees; its key must be in the
+	// test keyring of the node
+	BundlerAddress string `mapstructure:"bundler_address"`
+
+	// ERC-4337 EntryPoint contracts UserOperations are accepted for
+	BundlerEntryPoints []string `mapstructure:"bundler_entry_points"`
+
+	// how often pooled UserOperations are bundled
+	BundlerInterval time.Duration `mapstructure:"bundler_interval"`
+
+	// max number of UserOperations in a bundle
+	BundlerMaxOpsPerBundle int `mapstructure:"bundler_max_ops_per_bundle"`
+
+	// number of recent blocks the gas price oracle samples tips from
+	GasPriceOracleBlocks int64 `mapstructure:"gas_price_oracle_blocks"`
+
+	// controls whether the EIP-1767 GraphQL endpoint is served at /graphql on the HTTP server
+	GraphQLEnabled bool `mapstructure:"graphql_enabled"`
+
 	// test api enables certain override apis for integration test situations
 	EnableTestAPI bool `mapstructure:"enable_test_api"`
 }
@@ -108,6 +168,19 @@ var DefaultConfig = Config{
 	MaxLogNoBlock:           10000,
 	MaxBlocksForLog:         2000,
 	MaxSubscriptionsNewHead: 10000,
+	BloomIndexEnabled:       false,
+	BlobStoreEnabled:        false,
+	BlobRetention:           DefaultBlobRetention,
+	JwtSecret:               "",
+	APIKeys:                 make([]string, 0),
+	MethodComputeUnits:      make([]string, 0),
+	BundlerEnabled:          false,
+	BundlerAddress:          "",
+	BundlerEntryPoints:      []string{entrypoint.V07Address.Hex()},
+	BundlerInterval:         2 * time.Second,
+	BundlerMaxOpsPerBundle:  10,
+	GasPriceOracleBlocks:    20,
+	GraphQLEnabled:          false,
 	EnableTestAPI:           false,
 }
 
@@ -132,6 +205,23 @@ const (
 	flagMaxLogNoBlock           = "evm.max_log_no_block"
 	flagMaxBlocksForLog         = "evm.max_blocks_for_log"
 	flagMaxSubscriptionsNewHead = "evm.max_subscriptions_new_head"
+	flagBloomIndexEnabled       = "evm.bloom_index_enabled"
+	flagBlobStoreEnabled        = "evm.blob_store_enabled"
+	flagBlobRetention           = "evm.blob_retention"
+	flagJwtSecret               = "evm.jwt_secret"
+	flagAPIKeys                 = "evm.api_keys"
+	flagIPRequestsPerSecond     = "evm.ip_requests_per_second"
+	flagIPComputeUnitsPerSecond = "evm.ip_compute_units_per_second"
+	flagAPIKeyRequestsPerSecond = "evm.api_key_requests_per_second"
+	flagAPIKeyComputeUnits      = "evm.api_key_compute_units_per_second"
+	flagMethodComputeUnits      = "evm.method_compute_units"
+	flagBundlerEnabled          = "evm.bundler_enabled"
+	flagBundlerAddress          = "evm.bundler_address"
+	flagBundlerEntryPoints      = "evm.bundler_entry_points"
+	flagBundlerInterval         = "evm.bundler_interval"
+	flagBundlerMaxOpsPerBundle  = "evm.bundler_max_ops_per_bundle"
+	flagGasPriceOracleBlocks    = "evm.gas_price_oracle_blocks"
+	flagGraphQLEnabled          = "evm.graphql_enabled"
 	flagEnableTestAPI           = "evm.enable_test_api"
 )
 
@@ -238,6 +328,110 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagBloomIndexEnabled); v != nil {
+		if cfg.BloomIndexEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBlobStoreEnabled); v != nil {
+		if cfg.BlobStoreEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBlobRetention); v != nil {
+		if cfg.BlobRetention, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagJwtSecret); v != nil {
+		if cfg.JwtSecret, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.JwtSecret != "" {
+			if secret, err := hexutil.Decode(cfg.JwtSecret); err != nil || len(secret) != 32 {
+				return cfg, errors.New("jwt_secret must be a 0x-prefixed hex-encoded 32-byte secret")
+			}
+		}
+	}
+	if v := opts.Get(flagAPIKeys); v != nil {
+		if cfg.APIKeys, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagIPRequestsPerSecond); v != nil {
+		if cfg.IPRequestsPerSecond, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagIPComputeUnitsPerSecond); v != nil {
+		if cfg.IPComputeUnitsPerSecond, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAPIKeyRequestsPerSecond); v != nil {
+		if cfg.APIKeyRequestsPerSecond, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAPIKeyComputeUnits); v != nil {
+		if cfg.APIKeyComputeUnitsPerSecond, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMethodComputeUnits); v != nil {
+		if cfg.MethodComputeUnits, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+		if _, err = ParseMethodComputeUnits(cfg.MethodComputeUnits); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBundlerEnabled); v != nil {
+		if cfg.BundlerEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBundlerAddress); v != nil {
+		if cfg.BundlerAddress, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.BundlerAddress != "" && !common.IsHexAddress(cfg.BundlerAddress) {
+			return cfg, errors.New("bundler_address must be a hex address")
+		}
+	}
+	if v := opts.Get(flagBundlerEntryPoints); v != nil {
+		if cfg.BundlerEntryPoints, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+		for _, entryPoint := range cfg.BundlerEntryPoints {
+			if !common.IsHexAddress(entryPoint) {
+				return cfg, fmt.Errorf("invalid bundler entry point %s", entryPoint)
+			}
+		}
+	}
+	if v := opts.Get(flagBundlerInterval); v != nil {
+		if cfg.BundlerInterval, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBundlerMaxOpsPerBundle); v != nil {
+		if cfg.BundlerMaxOpsPerBundle, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleBlocks); v != nil {
+		if cfg.GasPriceOracleBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+		if cfg.GasPriceOracleBlocks < 1 {
+			return cfg, errors.New("gas_price_oracle_blocks must be at least 1")
+		}
+	}
+	if v := opts.Get(flagGraphQLEnabled); v != nil {
+		if cfg.GraphQLEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagEnableTestAPI); v != nil {
 		if cfg.EnableTestAPI, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
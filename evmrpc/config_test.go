@@ -29,6 +29,23 @@ type opts struct {
 	maxLogNoBlock           interface{}
 	maxBlocksForLog         interface{}
 	maxSubscriptionsNewHead interface{}
+	bloomIndexEnabled       interface{}
+	blobStoreEnabled        interface{}
+	blobRetention           interface{}
+	jwtSecret               interface{}
+	apiKeys                 interface{}
+	ipRequestsPerSecond     interface{}
+	ipComputeUnitsPerSecond interface{}
+	apiKeyRequestsPerSecond interface{}
+	apiKeyComputeUnits      interface{}
+	methodComputeUnits      interface{}
+	bundlerEnabled          interface{}
+	bundlerAddress          interface{}
+	bundlerEntryPoints      interface{}
+	bundlerInterval         interface{}
+	bundlerMaxOpsPerBundle  interface{}
+	gasPriceOracleBlocks    interface{}
+	graphQLEnabled          interface{}
 	enableTestAPI           interface{}
 }
 
@@ -93,6 +110,57 @@ func (o *opts) Get(k string) interface{} {
 	if k == "evm.max_subscriptions_new_head" {
 		return o.maxSubscriptionsNewHead
 	}
+	if k == "evm.bloom_index_enabled" {
+		return o.bloomIndexEnabled
+	}
+	if k == "evm.blob_store_enabled" {
+		return o.blobStoreEnabled
+	}
+	if k == "evm.blob_retention" {
+		return o.blobRetention
+	}
+	if k == "evm.jwt_secret" {
+		return o.jwtSecret
+	}
+	if k == "evm.api_keys" {
+		return o.apiKeys
+	}
+	if k == "evm.ip_requests_per_second" {
+		return o.ipRequestsPerSecond
+	}
+	if k == "evm.ip_compute_units_per_second" {
+		return o.ipComputeUnitsPerSecond
+	}
+	if k == "evm.api_key_requests_per_second" {
+		return o.apiKeyRequestsPerSecond
+	}
+	if k == "evm.api_key_compute_units_per_second" {
+		return o.apiKeyComputeUnits
+	}
+	if k == "evm.method_compute_units" {
+		return o.methodComputeUnits
+	}
+	if k == "evm.bundler_enabled" {
+		return o.bundlerEnabled
+	}
+	if k == "evm.bundler_address" {
+		return o.bundlerAddress
+	}
+	if k == "evm.bundler_entry_points" {
+		return o.bundlerEntryPoints
+	}
+	if k == "evm.bundler_interval" {
+		return o.bundlerInterval
+	}
+	if k == "evm.bundler_max_ops_per_bundle" {
+		return o.bundlerMaxOpsPerBundle
+	}
+	if k == "evm.gas_price_oracle_blocks" {
+		return o.gasPriceOracleBlocks
+	}
+	if k == "evm.graphql_enabled" {
+		return o.graphQLEnabled
+	}
 	if k == "evm.enable_test_api" {
 		return o.enableTestAPI
 	}
@@ -122,6 +190,23 @@ func TestReadConfig(t *testing.T) {
 		1000,
 		10000,
 		false,
+		false,
+		time.Duration(60),
+		"0x0000000000000000000000000000000000000000000000000000000000000001",
+		[]string{"key"},
+		10,
+		100,
+		100,
+		1000,
+		[]string{"eth_getLogs=50", "debug_trace*=100"},
+		false,
+		"0x1234567890123456789012345678901234567890",
+		[]string{"0x0000000071727De22E5E9d8BAf0edAc6f37da032"},
+		time.Duration(5),
+		10,
+		20,
+		false,
+		false,
 	}
 	_, err := evmrpc.ReadConfig(&goodOpts)
 	require.Nil(t, err)
@@ -193,4 +278,76 @@ func TestReadConfig(t *testing.T) {
 	badOpts.denyList = map[string]interface{}{}
 	_, err = evmrpc.ReadConfig(&badOpts)
 	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bloomIndexEnabled = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.blobStoreEnabled = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.blobRetention = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.jwtSecret = "0x01"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.apiKeys = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.ipRequestsPerSecond = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.ipComputeUnitsPerSecond = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.apiKeyRequestsPerSecond = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.apiKeyComputeUnits = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.methodComputeUnits = []string{"eth_getLogs"}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bundlerEnabled = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bundlerAddress = "0x01"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bundlerEntryPoints = []string{"bad"}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bundlerInterval = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bundlerMaxOpsPerBundle = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOracleBlocks = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOracleBlocks = 0
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.graphQLEnabled = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
 }
@@ -28,6 +28,7 @@ const (
 	UnknownSubscription FilterType = iota
 	LogsSubscription
 	BlocksSubscription
+	PendingTxsSubscription
 )
 
 type filter struct {
@@ -40,16 +41,21 @@ type filter struct {
 
 	// LogsSubscription
 	lastToHeight int64
+
+	// PendingTxsSubscription
+	fullTx         bool
+	pendingTxsSeen map[common.Hash]struct{}
 }
 
 type FilterAPI struct {
-	tmClient       rpcclient.Client
-	filtersMu      sync.Mutex
-	filters        map[ethrpc.ID]filter
-	filterConfig   *FilterConfig
-	logFetcher     *LogFetcher
-	connectionType ConnectionType
-	namespace      string
+	tmClient         rpcclient.Client
+	filtersMu        sync.Mutex
+	filters          map[ethrpc.ID]filter
+	filterConfig     *FilterConfig
+	logFetcher       *LogFetcher
+	pendingTxFetcher *PendingTxFetcher
+	connectionType   ConnectionType
+	namespace        string
 }
 
 type FilterConfig struct {
@@ -63,17 +69,18 @@ type EventItemDataWrapper struct {
 	Value json.RawMessage `json:"value"`
 }
 
-func NewFilterAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, filterConfig *FilterConfig, connectionType ConnectionType, namespace string) *FilterAPI {
-	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic(namespace)}
+func NewFilterAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, pendingTxFetcher *PendingTxFetcher, filterConfig *FilterConfig, bloomIndex *BloomIndex, connectionType ConnectionType, namespace string) *FilterAPI {
+	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic(namespace), bloomIndex: bloomIndex}
 	filters := make(map[ethrpc.ID]filter)
 	api := &FilterAPI{
-		namespace:      namespace,
-		tmClient:       tmClient,
-		filtersMu:      sync.Mutex{},
-		filters:        filters,
-		filterConfig:   filterConfig,
-		logFetcher:     logFetcher,
-		connectionType: connectionType,
+		namespace:        namespace,
+		tmClient:         tmClient,
+		filtersMu:        sync.Mutex{},
+		filters:          filters,
+		filterConfig:     filterConfig,
+		logFetcher:       logFetcher,
+		pendingTxFetcher: pendingTxFetcher,
+		connectionType:   connectionType,
 	}
 
 	go api.timeoutLoop(filterConfig.timeout)
@@ -131,6 +138,23 @@ func (a *FilterAPI) NewBlockFilter(
 	return curFilterID, nil
 }
 
+func (a *FilterAPI) NewPendingTransactionFilter(
+	_ context.Context,
+	fullTx *bool,
+) (id ethrpc.ID, err error) {
+	defer recordMetrics(fmt.Sprintf("%s_newPendingTransactionFilter", a.namespace), a.connectionType, time.Now(), err == nil)
+	a.filtersMu.Lock()
+	defer a.filtersMu.Unlock()
+	curFilterID := ethrpc.NewID()
+	a.filters[curFilterID] = filter{
+		typ:            PendingTxsSubscription,
+		deadline:       time.NewTimer(a.filterConfig.timeout),
+		fullTx:         fullTx != nil && *fullTx,
+		pendingTxsSeen: map[common.Hash]struct{}{},
+	}
+	return curFilterID, nil
+}
+
 func (a *FilterAPI) GetFilterChanges(
 	ctx context.Context,
 	filterID ethrpc.ID,
@@ -177,6 +201,24 @@ func (a *FilterAPI) GetFilterChanges(
 		updatedFilter.lastToHeight = lastToHeight + 1
 		a.filters[filterID] = updatedFilter
 		return logs, nil
+	case PendingTxsSubscription:
+		txs, err := a.pendingTxFetcher.GetPendingTxs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// return the txs not seen by a previous poll, and forget the ones that left the mempool
+		seen := make(map[common.Hash]struct{}, len(txs))
+		res := []interface{}{}
+		for _, tx := range txs {
+			seen[tx.Hash()] = struct{}{}
+			if _, ok := filter.pendingTxsSeen[tx.Hash()]; !ok {
+				res = append(res, a.pendingTxFetcher.EncodePendingTx(tx, filter.fullTx))
+			}
+		}
+		updatedFilter := a.filters[filterID]
+		updatedFilter.pendingTxsSeen = seen
+		a.filters[filterID] = updatedFilter
+		return res, nil
 	default:
 		return nil, errors.New("unknown filter type")
 	}
@@ -279,6 +321,7 @@ type LogFetcher struct {
 	ctxProvider              func(int64) sdk.Context
 	filterConfig             *FilterConfig
 	includeSyntheticReceipts bool
+	bloomIndex               *BloomIndex
 }
 
 func (f *LogFetcher) GetLogsByFilters(ctx context.Context, crit filters.FilterCriteria, lastToHeight int64) ([]*ethtypes.Log, int64, error) {
@@ -341,6 +384,16 @@ func (f *LogFetcher) GetLogsForBlock(ctx context.Context, block *coretypes.Resul
 }
 
 func (f *LogFetcher) FindBlockesByBloom(begin, end int64, filters [][]bloomIndexes) (res []int64) {
+	// heights covered by the bloombits index don't need their blooms to be loaded one by one
+	if f.bloomIndex != nil && len(filters) > 0 {
+		indexed, next, err := f.bloomIndex.FindBlocksByBloom(begin, end, filters)
+		if err != nil {
+			f.ctxProvider(LatestCtxHeight).Logger().Error(fmt.Sprintf("FindBlockesByBloom: falling back to block blooms: %s", err))
+		} else {
+			res = indexed
+			begin = next
+		}
+	}
 	//TODO: parallelize
 	for height := begin; height <= end; height++ {
 		if height == 0 {
@@ -337,6 +337,32 @@ func TestFilterBlockFilter(t *testing.T) {
 	}
 }
 
+func TestFilterPendingTransactionFilter(t *testing.T) {
+	t.Parallel()
+	resObj := sendRequestGood(t, "newPendingTransactionFilter")
+	filterId := resObj["result"].(string)
+	resObj = sendRequestGood(t, "getFilterChanges", filterId)
+	hashes := resObj["result"].([]interface{})
+	require.Equal(t, 1, len(hashes))
+	hash := hashes[0].(string)
+	require.Equal(t, 66, len(hash))
+	require.Equal(t, "0x", hash[:2])
+
+	// the tx is still pending but was already returned
+	resObj = sendRequestGood(t, "getFilterChanges", filterId)
+	require.Empty(t, resObj["result"].([]interface{}))
+
+	// full tx objects
+	resObj = sendRequestGood(t, "newPendingTransactionFilter", true)
+	filterId = resObj["result"].(string)
+	resObj = sendRequestGood(t, "getFilterChanges", filterId)
+	txs := resObj["result"].([]interface{})
+	require.Equal(t, 1, len(txs))
+	tx := txs[0].(map[string]interface{})
+	require.Equal(t, hash, tx["hash"].(string))
+	require.Equal(t, "0x2", tx["nonce"].(string))
+}
+
 func TestFilterExpiration(t *testing.T) {
 	t.Parallel()
 	filterCriteria := map[string]interface{}{
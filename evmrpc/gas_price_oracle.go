@@ -0,0 +1,182 @@
+package evmrpc
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	// number of the cheapest tips sampled from each block, same as go-ethereum's oracle
+	GasPriceOracleSampleSize = 3
+
+	// gas-weighted percentiles of the sampled tips that make up the fee tiers
+	SlowTipPercentile     = 25
+	StandardTipPercentile = 60
+	FastTipPercentile     = 90
+)
+
+type FeeSuggestion struct {
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+}
+
+type FeeSuggestions struct {
+	BlockNumber   hexutil.Uint64 `json:"blockNumber"`
+	BaseFeePerGas *hexutil.Big   `json:"baseFeePerGas"`
+	Slow          FeeSuggestion  `json:"slow"`
+	Standard      FeeSuggestion  `json:"standard"`
+	Fast          FeeSuggestion  `json:"fast"`
+}
+
+// GasPriceOracle suggests tips from the effective tips paid in recent blocks, like go-ethereum's
+// gas price oracle. Only EVM transactions that paid for gas are sampled, so gasless and oracle
+// transactions and synthetic receipts don't drag the suggestions down. The samples of each block
+// and the suggestions of the latest height are cached.
+type GasPriceOracle struct {
+	tmClient    rpcclient.Client
+	keeper      *keeper.Keeper
+	ctxProvider func(int64) sdk.Context
+	txDecoder   sdk.TxDecoder
+	blocks      int64
+
+	mtx          sync.Mutex
+	blockSamples map[int64][]GasAndReward
+	lastHeight   int64
+	suggestions  *FeeSuggestions
+}
+
+func NewGasPriceOracle(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, blocks int64) *GasPriceOracle {
+	return &GasPriceOracle{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, blocks: blocks, blockSamples: map[int64][]GasAndReward{}}
+}
+
+// Suggestions returns the fee tiers for the next block. The tips are zero if none of the sampled
+// blocks has a paying EVM transaction.
+func (o *GasPriceOracle) Suggestions(ctx context.Context) (*FeeSuggestions, error) {
+	sdkCtx := o.ctxProvider(LatestCtxHeight)
+	height := sdkCtx.BlockHeight()
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if o.suggestions != nil && o.lastHeight == height {
+		return o.suggestions, nil
+	}
+
+	samples := []GasAndReward{}
+	totalGasUsed := uint64(0)
+	oldest := height - o.blocks + 1
+	if oldest < 1 {
+		oldest = 1
+	}
+	for blockNum := oldest; blockNum <= height; blockNum++ {
+		blockSamples, err := o.sampleBlock(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		for _, sample := range blockSamples {
+			samples = append(samples, sample)
+			totalGasUsed += sample.GasUsed
+		}
+	}
+	// samples outside of the window won't be needed again
+	for blockNum := range o.blockSamples {
+		if blockNum < oldest {
+			delete(o.blockSamples, blockNum)
+		}
+	}
+	tips := []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0)}
+	for i, tip := range CalculatePercentiles([]float64{SlowTipPercentile, StandardTipPercentile, FastTipPercentile}, samples, totalGasUsed) {
+		tips[i] = tip.ToInt()
+	}
+
+	baseFee := o.keeper.GetDynamicBaseFeePerGas(sdkCtx).TruncateInt().BigInt()
+	o.suggestions = &FeeSuggestions{
+		BlockNumber:   hexutil.Uint64(height),
+		BaseFeePerGas: (*hexutil.Big)(baseFee),
+		Slow:          newFeeSuggestion(baseFee, tips[0]),
+		Standard:      newFeeSuggestion(baseFee, tips[1]),
+		Fast:          newFeeSuggestion(baseFee, tips[2]),
+	}
+	o.lastHeight = height
+	return o.suggestions, nil
+}
+
+// sampleBlock returns the cheapest effective tips paid in the block along with the gas used by
+// their transactions. Blocks whose state or body has been pruned have no samples.
+func (o *GasPriceOracle) sampleBlock(ctx context.Context, blockNum int64) ([]GasAndReward, error) {
+	if samples, ok := o.blockSamples[blockNum]; ok {
+		return samples, nil
+	}
+	sdkCtx := o.ctxProvider(blockNum)
+	if CheckVersion(sdkCtx, o.keeper) != nil {
+		return nil, nil
+	}
+	baseFee := safeGetBaseFee(o.keeper, sdkCtx)
+	if baseFee == nil {
+		return nil, nil
+	}
+	height := blockNum
+	block, err := blockByNumber(ctx, o.tmClient, &height)
+	if err != nil {
+		return nil, nil
+	}
+	samples := []GasAndReward{}
+	for _, txbz := range block.Block.Txs {
+		msg := getEvmMsgForTxBz(txbz, o.txDecoder)
+		if msg == nil {
+			// not evm tx
+			continue
+		}
+		// okay to get from latest since receipt is immutable
+		receipt, err := o.keeper.GetReceipt(o.ctxProvider(LatestCtxHeight), msg.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if receipt.TxType == ShellEVMTxType || receipt.EffectiveGasPrice == 0 {
+			// synthetic or gasless
+			continue
+		}
+		tip := new(big.Int).Sub(new(big.Int).SetUint64(receipt.EffectiveGasPrice), baseFee)
+		if tip.Sign() < 0 {
+			continue
+		}
+		samples = append(samples, GasAndReward{GasUsed: receipt.GasUsed, Reward: tip})
+	}
+	slices.SortStableFunc(samples, func(a, b GasAndReward) int {
+		return a.Reward.Cmp(b.Reward)
+	})
+	if len(samples) > GasPriceOracleSampleSize {
+		samples = samples[:GasPriceOracleSampleSize]
+	}
+	o.blockSamples[blockNum] = samples
+	return samples, nil
+}
+
+// newFeeSuggestion leaves room for the base fee to double before the suggested max fee stops
+// covering it, which is what wallets commonly do
+func newFeeSuggestion(baseFee *big.Int, tip *big.Int) FeeSuggestion {
+	maxFee := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	return FeeSuggestion{MaxPriorityFeePerGas: (*hexutil.Big)(tip), MaxFeePerGas: (*hexutil.Big)(maxFee)}
+}
+
+type FeeSuggestionAPI struct {
+	oracle         *GasPriceOracle
+	connectionType ConnectionType
+}
+
+func NewFeeSuggestionAPI(oracle *GasPriceOracle, connectionType ConnectionType) *FeeSuggestionAPI {
+	return &FeeSuggestionAPI{oracle: oracle, connectionType: connectionType}
+}
+
+// FeeSuggestions returns slow, standard and fast fee tiers for the next block
+func (a *FeeSuggestionAPI) FeeSuggestions(ctx context.Context) (result *FeeSuggestions, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("kii_feeSuggestions", a.connectionType, startTime, returnErr == nil)
+	return a.oracle.Suggestions(ctx)
+}
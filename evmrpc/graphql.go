@@ -0,0 +1,1340 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+)
+
+const (
+	GraphQLPath = "/graphql"
+
+	// GraphQLMaxDepth bounds the nesting of the fields of a query, which keeps queries like
+	// block { parent { transactions { block { parent ... } } } } from fanning out
+	GraphQLMaxDepth = 12
+
+	// GraphQLMaxParallelism bounds the fields of a query that are resolved concurrently
+	GraphQLMaxParallelism = 16
+
+	// GraphQLMaxQueryCost bounds the fields a query resolves through the node, queries resolving
+	// more are aborted
+	GraphQLMaxQueryCost = 10000
+)
+
+// graphQLSchema is the EIP-1767 schema, without the pending state, the sync state and the
+// fields that need the RLP encoding of blocks or receipts, which Kii doesn't have
+const graphQLSchema string = `
+    # Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal.
+    scalar Bytes32
+    # Address is a 20 byte Ethereum address, represented as 0x-prefixed hexadecimal.
+    scalar Address
+    # Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+    # An empty byte string is represented as '0x'. Byte strings must have an even number of hexadecimal nybbles.
+    scalar Bytes
+    # BigInt is a large integer. Input is accepted as either a JSON number or as a string.
+    # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+    # 0x-prefixed hexadecimal.
+    scalar BigInt
+    # Long is a 64 bit unsigned integer. Input is accepted as either a JSON number or as a string.
+    # Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+    # 0x-prefixed hexadecimal.
+    scalar Long
+
+    schema {
+        query: Query
+        mutation: Mutation
+    }
+
+    # Account is an Ethereum account at a particular block.
+    type Account {
+        # Address is the address owning the account.
+        address: Address!
+        # Balance is the balance of the account, in wei.
+        balance: BigInt!
+        # TransactionCount is the number of transactions sent from this account,
+        # or in the case of a contract, the number of contracts created. Otherwise
+        # known as the nonce.
+        transactionCount: Long!
+        # Code contains the smart contract code for this account, if the account
+        # is a (non-self-destructed) contract.
+        code: Bytes!
+        # Storage provides access to the storage of a contract account, indexed
+        # by its 32 byte slot identifier.
+        storage(slot: Bytes32!): Bytes32!
+    }
+
+    # Log is an Ethereum event log.
+    type Log {
+        # Index is the index of this log in the block.
+        index: Long!
+        # Account is the account which generated this log - this will always
+        # be a contract account.
+        account(block: Long): Account!
+        # Topics is a list of 0-4 indexed topics for the log.
+        topics: [Bytes32!]!
+        # Data is unindexed data for this log.
+        data: Bytes!
+        # Transaction is the transaction that generated this log entry.
+        transaction: Transaction!
+    }
+
+    # EIP-2718
+    type AccessTuple {
+        address: Address!
+        storageKeys : [Bytes32!]!
+    }
+
+    # Transaction is an Ethereum transaction.
+    type Transaction {
+        # Hash is the hash of this transaction.
+        hash: Bytes32!
+        # Nonce is the nonce of the account this transaction was generated with.
+        nonce: Long!
+        # Index is the index of this transaction in the parent block. This will
+        # be null if the transaction has not yet been mined.
+        index: Long
+        # From is the account that sent this transaction - this will always be
+        # an externally owned account.
+        from(block: Long): Account!
+        # To is the account the transaction was sent to. This is null for
+        # contract-creating transactions.
+        to(block: Long): Account
+        # Value is the value, in wei, sent along with this transaction.
+        value: BigInt!
+        # GasPrice is the price offered to miners for gas, in wei per unit.
+        gasPrice: BigInt!
+        # MaxFeePerGas is the maximum fee per gas offered to include a transaction, in wei.
+        maxFeePerGas: BigInt
+        # MaxPriorityFeePerGas is the maximum miner tip per gas offered to include a transaction, in wei.
+        maxPriorityFeePerGas: BigInt
+        # MaxFeePerBlobGas is the maximum blob gas fee cap per blob the sender is willing to pay for blob transaction, in wei.
+        maxFeePerBlobGas: BigInt
+        # Gas is the maximum amount of gas this transaction can consume.
+        gas: Long!
+        # InputData is the data supplied to the target of the transaction.
+        inputData: Bytes!
+        # Block is the block this transaction was mined in. This will be null if
+        # the transaction has not yet been mined.
+        block: Block
+
+        # Status is the return status of the transaction. This will be 1 if the
+        # transaction succeeded, or 0 if it failed (due to a revert, or due to
+        # running out of gas). If the transaction has not yet been mined, this
+        # field will be null.
+        status: Long
+        # GasUsed is the amount of gas that was used processing this transaction.
+        # If the transaction has not yet been mined, this field will be null.
+        gasUsed: Long
+        # CumulativeGasUsed is the total gas used in the block up to and including
+        # this transaction. If the transaction has not yet been mined, this field
+        # will be null.
+        cumulativeGasUsed: Long
+        # EffectiveGasPrice is actual value per gas deducted from the sender's
+        # account. If the transaction has not yet been mined, this field will be null.
+        effectiveGasPrice: BigInt
+        # BlobGasUsed is the amount of blob gas used by this transaction.
+        blobGasUsed: Long
+        # blobGasPrice is the actual value per blob gas deducted from the senders account.
+        blobGasPrice: BigInt
+        # CreatedContract is the account that was created by a contract creation
+        # transaction. If the transaction was not a contract creation transaction,
+        # or it has not yet been mined, this field will be null.
+        createdContract(block: Long): Account
+        # Logs is a list of log entries emitted by this transaction. If the
+        # transaction has not yet been mined, this field will be null.
+        logs: [Log!]
+        r: BigInt!
+        s: BigInt!
+        v: BigInt!
+        yParity: BigInt
+        # Envelope transaction support
+        type: Long
+        accessList: [AccessTuple!]
+        # BlobVersionedHashes is a set of hash outputs from the blobs in the transaction.
+        blobVersionedHashes: [Bytes32!]
+    }
+
+    # BlockFilterCriteria encapsulates log filter criteria for a filter applied
+    # to a single block.
+    input BlockFilterCriteria {
+        # Addresses is list of addresses that are of interest. If this list is
+        # empty, results will not be filtered by address.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics. Each event has a list
+        # of topics. Topics matches a prefix of that list. An empty element array matches any
+        # topic. Non-empty elements represent an alternative that matches any of the
+        # contained topics.
+        topics: [[Bytes32!]!]
+    }
+
+    # Block is an Ethereum block.
+    type Block {
+        # Number is the number of this block, starting at 0 for the genesis block.
+        number: Long!
+        # Hash is the block hash of this block.
+        hash: Bytes32!
+        # Parent is the parent block of this block.
+        parent: Block
+        # Nonce is the block nonce, an 8 byte sequence determined by the miner.
+        nonce: Bytes!
+        # TransactionsRoot is the keccak256 hash of the root of the trie of transactions in this block.
+        transactionsRoot: Bytes32!
+        # TransactionCount is the number of transactions in this block. if
+        # transactions are not available for this block, this field will be null.
+        transactionCount: Long
+        # StateRoot is the keccak256 hash of the state trie after this block was processed.
+        stateRoot: Bytes32!
+        # ReceiptsRoot is the keccak256 hash of the trie of transaction receipts in this block.
+        receiptsRoot: Bytes32!
+        # Miner is the account that mined this block.
+        miner(block: Long): Account!
+        # ExtraData is an arbitrary data field supplied by the miner.
+        extraData: Bytes!
+        # GasLimit is the maximum amount of gas that was available to transactions in this block.
+        gasLimit: Long!
+        # GasUsed is the amount of gas that was used executing transactions in this block.
+        gasUsed: Long!
+        # BaseFeePerGas is the fee per unit of gas burned by the protocol in this block.
+        baseFeePerGas: BigInt
+        # Timestamp is the unix timestamp at which this block was mined.
+        timestamp: Long!
+        # LogsBloom is a bloom filter that can be used to check if a block may
+        # contain log entries matching a filter.
+        logsBloom: Bytes!
+        # MixHash is the hash that was used as an input to the PoW process.
+        mixHash: Bytes32!
+        # Difficulty is a measure of the difficulty of mining this block.
+        difficulty: BigInt!
+        # TotalDifficulty is the sum of all difficulty values up to and including
+        # this block.
+        totalDifficulty: BigInt!
+        # OmmerCount is the number of ommers (AKA uncles) associated with this
+        # block. If ommers are unavailable, this field will be null.
+        ommerCount: Long
+        # Ommers is a list of ommer (AKA uncle) blocks associated with this block.
+        # If ommers are unavailable, this field will be null.
+        ommers: [Block]
+        # OmmerAt returns the ommer (AKA uncle) at the specified index. If ommers
+        # are unavailable, or the index is out of bounds, this field will be null.
+        ommerAt(index: Long!): Block
+        # OmmerHash is the keccak256 hash of all the ommers (AKA uncles)
+        # associated with this block.
+        ommerHash: Bytes32!
+        # Transactions is a list of transactions associated with this block. If
+        # transactions are unavailable for this block, this field will be null.
+        transactions: [Transaction!]
+        # TransactionAt returns the transaction at the specified index. If
+        # transactions are unavailable for this block, or if the index is out of
+        # bounds, this field will be null.
+        transactionAt(index: Long!): Transaction
+        # Logs returns a filtered set of logs from this block.
+        logs(filter: BlockFilterCriteria!): [Log!]!
+        # Account fetches an Ethereum account at the current block's state.
+        account(address: Address!): Account!
+        # Call executes a local call operation at the current block's state.
+        call(data: CallData!): CallResult
+        # EstimateGas estimates the amount of gas that will be required for
+        # successful execution of a transaction at the current block's state.
+        estimateGas(data: CallData!): Long!
+        # BlobGasUsed is the total amount of gas used by the transactions.
+        blobGasUsed: Long
+        # ExcessBlobGas is a running total of blob gas consumed in excess of the target, prior to the block.
+        excessBlobGas: Long
+    }
+
+    # CallData represents the data associated with a local contract call.
+    # All fields are optional.
+    input CallData {
+        # From is the address making the call.
+        from: Address
+        # To is the address the call is sent to.
+        to: Address
+        # Gas is the amount of gas sent with the call.
+        gas: Long
+        # GasPrice is the price, in wei, offered for each unit of gas.
+        gasPrice: BigInt
+        # MaxFeePerGas is the maximum fee per gas offered, in wei.
+        maxFeePerGas: BigInt
+        # MaxPriorityFeePerGas is the maximum miner tip per gas offered, in wei.
+        maxPriorityFeePerGas: BigInt
+        # Value is the value, in wei, sent along with the call.
+        value: BigInt
+        # Data is the data sent to the callee.
+        data: Bytes
+    }
+
+    # CallResult is the result of a local call operation.
+    type CallResult {
+        # Data is the return data of the called contract.
+        data: Bytes!
+        # GasUsed is the amount of gas used by the call, after any refunds.
+        gasUsed: Long!
+        # Status is the result of the call - 1 for success or 0 for failure.
+        status: Long!
+    }
+
+    # FilterCriteria encapsulates log filter criteria for searching log entries.
+    input FilterCriteria {
+        # FromBlock is the block at which to start searching, inclusive. Defaults
+        # to the latest block if not supplied.
+        fromBlock: Long
+        # ToBlock is the block at which to stop searching, inclusive. Defaults
+        # to the latest block if not supplied.
+        toBlock: Long
+        # Addresses is a list of addresses that are of interest. If this list is
+        # empty, results will not be filtered by address.
+        addresses: [Address!]
+        # Topics list restricts matches to particular event topics. Each event has a list
+        # of topics. Topics matches a prefix of that list. An empty element array matches any
+        # topic. Non-empty elements represent an alternative that matches any of the
+        # contained topics.
+        topics: [[Bytes32!]!]
+    }
+
+    type Query {
+        # Block fetches an Ethereum block by number or by hash. If neither is
+        # supplied, the most recent known block is returned.
+        block(number: Long, hash: Bytes32): Block
+        # Blocks returns all the blocks between two numbers, inclusive. If
+        # to is not supplied, it defaults to the most recent known block.
+        blocks(from: Long, to: Long): [Block!]!
+        # Transaction returns a transaction specified by its hash.
+        transaction(hash: Bytes32!): Transaction
+        # Logs returns log entries matching the provided filter.
+        logs(filter: FilterCriteria!): [Log!]!
+        # GasPrice returns the node's estimate of a gas price sufficient to
+        # ensure a transaction is mined in a timely fashion.
+        gasPrice: BigInt!
+        # MaxPriorityFeePerGas returns the node's estimate of a gas tip sufficient
+        # to ensure a transaction is mined in a timely fashion.
+        maxPriorityFeePerGas: BigInt!
+        # ChainID returns the current chain ID for transaction replay protection.
+        chainID: BigInt!
+    }
+
+    type Mutation {
+        # SendRawTransaction sends an RLP-encoded transaction to the network.
+        sendRawTransaction(data: Bytes!): Bytes32!
+    }
+`
+
+// graphQLLong is the Long scalar of the schema. It's only used for inputs since hexutil.Uint64
+// already encodes as a Long.
+type graphQLLong int64
+
+func (l graphQLLong) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *graphQLLong) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*l = graphQLLong(value)
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*l = graphQLLong(value)
+		return err
+	case int32:
+		*l = graphQLLong(input)
+	case int64:
+		*l = graphQLLong(input)
+	case float64:
+		*l = graphQLLong(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+type graphQLBlockNumberArgs struct {
+	Block *graphQLLong
+}
+
+// numberOrLatest returns the block the account fields are resolved at
+func (a graphQLBlockNumberArgs) numberOrLatest() rpc.BlockNumberOrHash {
+	if a.Block != nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(*a.Block))
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+}
+
+// graphQLResolver resolves the EIP-1767 schema with the same APIs that serve JSON-RPC, so both
+// return the same data
+type graphQLResolver struct {
+	blockAPI      *BlockAPI
+	txAPI         *TransactionAPI
+	stateAPI      *StateAPI
+	infoAPI       *InfoAPI
+	simulationAPI *SimulationAPI
+	sendAPI       *SendAPI
+	logFetcher    *LogFetcher
+	maxBlocks     int64
+}
+
+func (r *graphQLResolver) Block(ctx context.Context, args struct {
+	Number *graphQLLong
+	Hash   *common.Hash
+}) (*graphQLBlock, error) {
+	if args.Number != nil && args.Hash != nil {
+		return nil, errors.New("only one of number or hash must be specified")
+	}
+	numberOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if args.Number != nil {
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		numberOrHash = rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(*args.Number))
+	} else if args.Hash != nil {
+		numberOrHash = rpc.BlockNumberOrHashWithHash(*args.Hash, false)
+	}
+	block := &graphQLBlock{r: r, numberOrHash: numberOrHash}
+	if _, err := block.resolve(ctx); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *graphQLResolver) Blocks(ctx context.Context, args struct {
+	From *graphQLLong
+	To   *graphQLLong
+}) ([]*graphQLBlock, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := int64(*args.From)
+	to := r.infoAPI.ctxProvider(LatestCtxHeight).BlockHeight()
+	if args.To != nil {
+		to = int64(*args.To)
+	}
+	if to < from {
+		return nil, errors.New("invalid from and to block combination: from > to")
+	}
+	if r.maxBlocks > 0 && to-from >= r.maxBlocks {
+		return nil, fmt.Errorf("a maximum of %d blocks can be queried at once", r.maxBlocks)
+	}
+	res := []*graphQLBlock{}
+	for number := from; number <= to; number++ {
+		block := &graphQLBlock{r: r, numberOrHash: rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number))}
+		if _, err := block.resolve(ctx); err != nil {
+			return nil, err
+		}
+		res = append(res, block)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *graphQLResolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*graphQLTransaction, error) {
+	tx := &graphQLTransaction{r: r, hash: args.Hash}
+	res, err := tx.resolve(ctx)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+type graphQLFilterCriteria struct {
+	FromBlock *graphQLLong
+	ToBlock   *graphQLLong
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (r *graphQLResolver) Logs(ctx context.Context, args struct{ Filter graphQLFilterCriteria }) ([]*graphQLLog, error) {
+	crit := filters.FilterCriteria{}
+	if args.Filter.FromBlock != nil {
+		crit.FromBlock = big.NewInt(int64(*args.Filter.FromBlock))
+	}
+	if args.Filter.ToBlock != nil {
+		crit.ToBlock = big.NewInt(int64(*args.Filter.ToBlock))
+	}
+	if crit.FromBlock != nil && crit.ToBlock != nil && crit.FromBlock.Sign() >= 0 && crit.ToBlock.Sign() >= 0 {
+		if crit.FromBlock.Cmp(crit.ToBlock) > 0 {
+			return nil, errors.New("invalid from and to block combination: from > to")
+		}
+		if r.maxBlocks > 0 && new(big.Int).Sub(crit.ToBlock, crit.FromBlock).Int64() >= r.maxBlocks {
+			return nil, fmt.Errorf("a maximum of %d blocks can be queried at once", r.maxBlocks)
+		}
+	}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return r.getLogs(ctx, crit)
+}
+
+func (r *graphQLResolver) getLogs(ctx context.Context, crit filters.FilterCriteria) ([]*graphQLLog, error) {
+	logs, _, err := r.logFetcher.GetLogsByFilters(ctx, crit, 0)
+	if err != nil {
+		return nil, err
+	}
+	return r.newLogs(logs), nil
+}
+
+func (r *graphQLResolver) newLogs(logs []*ethtypes.Log) []*graphQLLog {
+	res := make([]*graphQLLog, 0, len(logs))
+	for _, log := range logs {
+		res = append(res, &graphQLLog{r: r, log: log})
+	}
+	return res
+}
+
+func (r *graphQLResolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	price, err := r.infoAPI.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *graphQLResolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	tip, err := r.infoAPI.MaxPriorityFeePerGas(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tip, nil
+}
+
+func (r *graphQLResolver) ChainID() hexutil.Big {
+	return *r.infoAPI.ChainId()
+}
+
+func (r *graphQLResolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.sendAPI.SendRawTransaction(ctx, args.Data)
+}
+
+type graphQLAccount struct {
+	r             *graphQLResolver
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+func (a *graphQLAccount) Address() common.Address {
+	return a.address
+}
+
+func (a *graphQLAccount) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.stateAPI.GetBalance(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *graphQLAccount) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	count, err := a.r.txAPI.GetTransactionCount(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return *count, nil
+}
+
+func (a *graphQLAccount) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.stateAPI.GetCode(ctx, a.address, a.blockNrOrHash)
+}
+
+func (a *graphQLAccount) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.stateAPI.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+type graphQLLog struct {
+	r   *graphQLResolver
+	log *ethtypes.Log
+}
+
+func (l *graphQLLog) Index() hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *graphQLLog) Account(args graphQLBlockNumberArgs) *graphQLAccount {
+	return &graphQLAccount{r: l.r, address: l.log.Address, blockNrOrHash: args.numberOrLatest()}
+}
+
+func (l *graphQLLog) Topics() []common.Hash {
+	return l.log.Topics
+}
+
+func (l *graphQLLog) Data() hexutil.Bytes {
+	return l.log.Data
+}
+
+func (l *graphQLLog) Transaction() *graphQLTransaction {
+	return &graphQLTransaction{r: l.r, hash: l.log.TxHash}
+}
+
+type graphQLAccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *graphQLAccessTuple) Address() common.Address {
+	return at.address
+}
+
+func (at *graphQLAccessTuple) StorageKeys() []common.Hash {
+	return at.storageKeys
+}
+
+// graphQLTransaction is resolved lazily from eth_getTransactionByHash and
+// eth_getTransactionReceipt, so that queries only pay for the parts they select
+type graphQLTransaction struct {
+	r    *graphQLResolver
+	hash common.Hash
+
+	mtx      sync.Mutex
+	tx       *RPCTransaction
+	receipt  map[string]interface{}
+	resolved bool
+}
+
+func (t *graphQLTransaction) resolve(ctx context.Context) (*RPCTransaction, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.tx != nil {
+		return t.tx, nil
+	}
+	tx, err := t.r.txAPI.GetTransactionByHash(ctx, t.hash)
+	if err != nil {
+		return nil, err
+	}
+	t.tx = tx
+	return tx, nil
+}
+
+func (t *graphQLTransaction) mustResolve(ctx context.Context) (*RPCTransaction, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", t.hash.Hex())
+	}
+	return tx, nil
+}
+
+// resolveReceipt returns nil if the transaction hasn't been included yet
+func (t *graphQLTransaction) resolveReceipt(ctx context.Context) (map[string]interface{}, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.resolved {
+		return t.receipt, nil
+	}
+	receipt, err := t.r.txAPI.GetTransactionReceipt(ctx, t.hash)
+	if err != nil {
+		return nil, err
+	}
+	t.receipt, t.resolved = receipt, true
+	return receipt, nil
+}
+
+func (t *graphQLTransaction) Hash() common.Hash {
+	return t.hash
+}
+
+func (t *graphQLTransaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Nonce, nil
+}
+
+func (t *graphQLTransaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.TransactionIndex, nil
+}
+
+func (t *graphQLTransaction) From(ctx context.Context, args graphQLBlockNumberArgs) (*graphQLAccount, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLAccount{r: t.r, address: tx.From, blockNrOrHash: args.numberOrLatest()}, nil
+}
+
+func (t *graphQLTransaction) To(ctx context.Context, args graphQLBlockNumberArgs) (*graphQLAccount, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil || tx.To == nil {
+		return nil, err
+	}
+	return &graphQLAccount{r: t.r, address: *tx.To, blockNrOrHash: args.numberOrLatest()}, nil
+}
+
+func (t *graphQLTransaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZeroValue(tx.Value), nil
+}
+
+func (t *graphQLTransaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZeroValue(tx.GasPrice), nil
+}
+
+func (t *graphQLTransaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasFeeCap, nil
+}
+
+func (t *graphQLTransaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasTipCap, nil
+}
+
+func (t *graphQLTransaction) MaxFeePerBlobGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MaxFeePerBlobGas, nil
+}
+
+func (t *graphQLTransaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Gas, nil
+}
+
+func (t *graphQLTransaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Input, nil
+}
+
+func (t *graphQLTransaction) Block(ctx context.Context) (*graphQLBlock, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil || tx.BlockNumber == nil {
+		return nil, err
+	}
+	return &graphQLBlock{r: t.r, numberOrHash: rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(tx.BlockNumber.ToInt().Int64()))}, nil
+}
+
+func (t *graphQLTransaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(receipt["status"].(hexutil.Uint))
+	return &status, nil
+}
+
+func (t *graphQLTransaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return t.receiptUint64(ctx, "gasUsed")
+}
+
+func (t *graphQLTransaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return t.receiptUint64(ctx, "cumulativeGasUsed")
+}
+
+func (t *graphQLTransaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	return t.receiptBig(ctx, "effectiveGasPrice")
+}
+
+func (t *graphQLTransaction) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return t.receiptUint64(ctx, "blobGasUsed")
+}
+
+func (t *graphQLTransaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	return t.receiptBig(ctx, "blobGasPrice")
+}
+
+func (t *graphQLTransaction) receiptUint64(ctx context.Context, field string) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	value, ok := receipt[field].(hexutil.Uint64)
+	if !ok {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+func (t *graphQLTransaction) receiptBig(ctx context.Context, field string) (*hexutil.Big, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	value, _ := receipt[field].(*hexutil.Big)
+	return value, nil
+}
+
+func (t *graphQLTransaction) CreatedContract(ctx context.Context, args graphQLBlockNumberArgs) (*graphQLAccount, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	address, ok := receipt["contractAddress"].(common.Address)
+	if !ok {
+		return nil, nil
+	}
+	return &graphQLAccount{r: t.r, address: address, blockNrOrHash: args.numberOrLatest()}, nil
+}
+
+func (t *graphQLTransaction) Logs(ctx context.Context) (*[]*graphQLLog, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := t.r.newLogs(receipt["logs"].([]*ethtypes.Log))
+	return &logs, nil
+}
+
+func (t *graphQLTransaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZeroValue(tx.R), nil
+}
+
+func (t *graphQLTransaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZeroValue(tx.S), nil
+}
+
+func (t *graphQLTransaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return bigOrZeroValue(tx.V), nil
+}
+
+func (t *graphQLTransaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil || tx.YParity == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(uint64(*tx.YParity))), nil
+}
+
+func (t *graphQLTransaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx.Type, nil
+}
+
+func (t *graphQLTransaction) AccessList(ctx context.Context) (*[]*graphQLAccessTuple, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil || tx.Accesses == nil {
+		return nil, err
+	}
+	res := make([]*graphQLAccessTuple, 0, len(*tx.Accesses))
+	for _, tuple := range *tx.Accesses {
+		res = append(res, &graphQLAccessTuple{address: tuple.Address, storageKeys: tuple.StorageKeys})
+	}
+	return &res, nil
+}
+
+func (t *graphQLTransaction) BlobVersionedHashes(ctx context.Context) (*[]common.Hash, error) {
+	tx, err := t.mustResolve(ctx)
+	if err != nil || tx.BlobVersionedHashes == nil {
+		return nil, err
+	}
+	return &tx.BlobVersionedHashes, nil
+}
+
+func bigOrZeroValue(b *hexutil.Big) hexutil.Big {
+	if b == nil {
+		return hexutil.Big{}
+	}
+	return *b
+}
+
+// graphQLBlock is resolved lazily from eth_getBlockByNumber or eth_getBlockByHash
+type graphQLBlock struct {
+	r            *graphQLResolver
+	numberOrHash rpc.BlockNumberOrHash
+
+	mtx    sync.Mutex
+	fields map[string]interface{}
+}
+
+func (b *graphQLBlock) resolve(ctx context.Context) (map[string]interface{}, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.fields != nil {
+		return b.fields, nil
+	}
+	var fields map[string]interface{}
+	var err error
+	if hash, ok := b.numberOrHash.Hash(); ok {
+		fields, err = b.r.blockAPI.GetBlockByHash(ctx, hash, false)
+	} else {
+		number, _ := b.numberOrHash.Number()
+		fields, err = b.r.blockAPI.GetBlockByNumber(ctx, number, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.fields = fields
+	return fields, nil
+}
+
+// state returns the block the account fields and calls of this block are resolved at
+func (b *graphQLBlock) state(ctx context.Context) (rpc.BlockNumberOrHash, error) {
+	number, err := b.Number(ctx)
+	if err != nil {
+		return rpc.BlockNumberOrHash{}, err
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number)), nil
+}
+
+func (b *graphQLBlock) Number(ctx context.Context) (hexutil.Uint64, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(fields["number"].(*hexutil.Big).ToInt().Uint64()), nil
+}
+
+func (b *graphQLBlock) Hash(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "hash")
+}
+
+func (b *graphQLBlock) Parent(ctx context.Context) (*graphQLBlock, error) {
+	number, err := b.Number(ctx)
+	if err != nil || number == 0 {
+		return nil, err
+	}
+	return &graphQLBlock{r: b.r, numberOrHash: rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number - 1))}, nil
+}
+
+func (b *graphQLBlock) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce := fields["nonce"].(ethtypes.BlockNonce)
+	return nonce[:], nil
+}
+
+func (b *graphQLBlock) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "transactionsRoot")
+}
+
+func (b *graphQLBlock) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	hashes, err := b.transactionHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(hashes))
+	return &count, nil
+}
+
+func (b *graphQLBlock) StateRoot(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "stateRoot")
+}
+
+func (b *graphQLBlock) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "receiptsRoot")
+}
+
+func (b *graphQLBlock) Miner(ctx context.Context, args graphQLBlockNumberArgs) (*graphQLAccount, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLAccount{r: b.r, address: fields["miner"].(common.Address), blockNrOrHash: args.numberOrLatest()}, nil
+}
+
+func (b *graphQLBlock) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fields["extraData"].(hexutil.Bytes), nil
+}
+
+func (b *graphQLBlock) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	return b.uint64Field(ctx, "gasLimit")
+}
+
+func (b *graphQLBlock) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	return b.uint64Field(ctx, "gasUsed")
+}
+
+func (b *graphQLBlock) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fields["baseFeePerGas"].(*hexutil.Big), nil
+}
+
+func (b *graphQLBlock) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	return b.uint64Field(ctx, "timestamp")
+}
+
+func (b *graphQLBlock) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bloom := fields["logsBloom"].(ethtypes.Bloom)
+	return bloom.Bytes(), nil
+}
+
+func (b *graphQLBlock) MixHash(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "mixHash")
+}
+
+// Difficulty is inapplicable to Kii
+func (b *graphQLBlock) Difficulty() hexutil.Big {
+	return hexutil.Big{}
+}
+
+// TotalDifficulty is inapplicable to Kii
+func (b *graphQLBlock) TotalDifficulty() hexutil.Big {
+	return hexutil.Big{}
+}
+
+// OmmerCount is inapplicable to Kii
+func (b *graphQLBlock) OmmerCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(0)
+	return &count
+}
+
+// Ommers is inapplicable to Kii
+func (b *graphQLBlock) Ommers() *[]*graphQLBlock {
+	return &[]*graphQLBlock{}
+}
+
+// OmmerAt is inapplicable to Kii
+func (b *graphQLBlock) OmmerAt(args struct{ Index graphQLLong }) *graphQLBlock {
+	return nil
+}
+
+func (b *graphQLBlock) OmmerHash(ctx context.Context) (common.Hash, error) {
+	return b.hashField(ctx, "sha3Uncles")
+}
+
+func (b *graphQLBlock) Transactions(ctx context.Context) (*[]*graphQLTransaction, error) {
+	hashes, err := b.transactionHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*graphQLTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		res = append(res, &graphQLTransaction{r: b.r, hash: hash})
+	}
+	return &res, nil
+}
+
+func (b *graphQLBlock) TransactionAt(ctx context.Context, args struct{ Index graphQLLong }) (*graphQLTransaction, error) {
+	hashes, err := b.transactionHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(hashes) {
+		return nil, nil
+	}
+	return &graphQLTransaction{r: b.r, hash: hashes[args.Index]}, nil
+}
+
+func (b *graphQLBlock) transactionHashes(ctx context.Context) ([]common.Hash, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hashes := []common.Hash{}
+	for _, tx := range fields["transactions"].([]interface{}) {
+		if hash, ok := tx.(common.Hash); ok {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes, nil
+}
+
+type graphQLBlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (b *graphQLBlock) Logs(ctx context.Context, args struct{ Filter graphQLBlockFilterCriteria }) ([]*graphQLLog, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	crit := filters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return b.r.getLogs(ctx, crit)
+}
+
+func (b *graphQLBlock) Account(ctx context.Context, args struct{ Address common.Address }) (*graphQLAccount, error) {
+	blockNrOrHash, err := b.state(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLAccount{r: b.r, address: args.Address, blockNrOrHash: blockNrOrHash}, nil
+}
+
+type graphQLCallResult struct {
+	data    hexutil.Bytes
+	gasUsed hexutil.Uint64
+	status  hexutil.Uint64
+}
+
+func (c *graphQLCallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *graphQLCallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *graphQLCallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+func (b *graphQLBlock) Call(ctx context.Context, args struct{ Data ethapi.TransactionArgs }) (*graphQLCallResult, error) {
+	blockNrOrHash, err := b.state(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := b.r.simulationAPI.doCall(ctx, args.Data, blockNrOrHash, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(1)
+	if result.Failed() {
+		status = 0
+	}
+	return &graphQLCallResult{data: result.ReturnData, gasUsed: hexutil.Uint64(result.UsedGas), status: status}, nil
+}
+
+func (b *graphQLBlock) EstimateGas(ctx context.Context, args struct{ Data ethapi.TransactionArgs }) (hexutil.Uint64, error) {
+	blockNrOrHash, err := b.state(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.simulationAPI.EstimateGas(ctx, args.Data, &blockNrOrHash, nil)
+}
+
+func (b *graphQLBlock) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return b.optionalUint64Field(ctx, "blobGasUsed")
+}
+
+func (b *graphQLBlock) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	return b.optionalUint64Field(ctx, "excessBlobGas")
+}
+
+func (b *graphQLBlock) hashField(ctx context.Context, field string) (common.Hash, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return fields[field].(common.Hash), nil
+}
+
+func (b *graphQLBlock) uint64Field(ctx context.Context, field string) (hexutil.Uint64, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return fields[field].(hexutil.Uint64), nil
+}
+
+// optionalUint64Field returns nil for fields the genesis block doesn't have
+func (b *graphQLBlock) optionalUint64Field(ctx context.Context, field string) (*hexutil.Uint64, error) {
+	fields, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := fields[field].(hexutil.Uint64)
+	if !ok {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+// graphQLQueryCost is the cost of a query, i.e. the number of its fields that are resolved
+// through the node rather than read from an already resolved value
+type graphQLQueryCost struct {
+	fields atomic.Uint64
+	cancel context.CancelFunc
+}
+
+type graphQLQueryCostKey struct{}
+
+func withGraphQLQueryCost(ctx context.Context, cost *graphQLQueryCost) context.Context {
+	return context.WithValue(ctx, graphQLQueryCostKey{}, cost)
+}
+
+func graphQLQueryCostFromContext(ctx context.Context) (*graphQLQueryCost, bool) {
+	cost, ok := ctx.Value(graphQLQueryCostKey{}).(*graphQLQueryCost)
+	return cost, ok
+}
+
+// Fields returns the number of fields resolved so far
+func (c *graphQLQueryCost) Fields() uint64 {
+	return c.fields.Load()
+}
+
+func (c *graphQLQueryCost) exceeded() bool {
+	return c.Fields() > GraphQLMaxQueryCost
+}
+
+// graphQLCostTracer counts the cost of queries and cancels the ones exceeding GraphQLMaxQueryCost
+type graphQLCostTracer struct{}
+
+func (graphQLCostTracer) TraceQuery(ctx context.Context, _ string, _ string, _ map[string]interface{}, _ map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
+	return ctx, func([]*gqlErrors.QueryError) {}
+}
+
+func (graphQLCostTracer) TraceField(ctx context.Context, _, _, _ string, trivial bool, _ map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+	if cost, ok := graphQLQueryCostFromContext(ctx); ok && !trivial {
+		cost.fields.Add(1)
+		if cost.exceeded() && cost.cancel != nil {
+			cost.cancel()
+		}
+	}
+	return ctx, func(*gqlErrors.QueryError) {}
+}
+
+type graphQLHandler struct {
+	schema *graphql.Schema
+}
+
+func NewGraphQLHandler(resolver *graphQLResolver) (http.Handler, error) {
+	schema, err := graphql.ParseSchema(graphQLSchema, resolver,
+		graphql.MaxDepth(GraphQLMaxDepth),
+		graphql.MaxParallelism(GraphQLMaxParallelism),
+		graphql.Tracer(graphQLCostTracer{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &graphQLHandler{schema: schema}, nil
+}
+
+// ServeHTTP answers GraphQL queries. Like JSON-RPC requests, queries are cut off shortly before
+// the write timeout of the server so that the client gets an error instead of a dropped
+// connection.
+func (h *graphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		recordMetrics("graphql", ConnectionTypeHTTP, startTime, false)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		responded sync.Once
+		timer     *time.Timer
+	)
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	// the cost is counted for the rate limiter if there is one
+	cost, ok := graphQLQueryCostFromContext(ctx)
+	if !ok {
+		cost = &graphQLQueryCost{}
+		ctx = withGraphQLQueryCost(ctx, cost)
+	}
+	cost.cancel = cancel
+	if timeout, ok := rpc.ContextRequestTimeout(ctx); ok {
+		timer = time.AfterFunc(timeout, func() {
+			responded.Do(func() {
+				cancel()
+				response := &graphql.Response{Errors: []*gqlErrors.QueryError{{Message: "request timed out"}}}
+				responseJSON, err := json.Marshal(response)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				// since the response is written close to the write timeout, it must not be
+				// compressed or chunked
+				w.Header().Set("Transfer-Encoding", "identity")
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Length", strconv.Itoa(len(responseJSON)))
+				_, _ = w.Write(responseJSON)
+				if flusher, ok := w.(http.Flusher); ok {
+					flusher.Flush()
+				}
+			})
+		})
+	}
+
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	if timer != nil {
+		timer.Stop()
+	}
+	if cost.exceeded() {
+		response = &graphql.Response{Errors: []*gqlErrors.QueryError{{Message: fmt.Sprintf("query resolves more than %d fields", GraphQLMaxQueryCost)}}}
+	}
+	recordMetrics("graphql", ConnectionTypeHTTP, startTime, len(response.Errors) == 0)
+	responded.Do(func() {
+		responseJSON, err := json.Marshal(response)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if len(response.Errors) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = w.Write(responseJSON)
+	})
+}
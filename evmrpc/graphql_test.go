@@ -0,0 +1,109 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func sendGraphQLRequest(t *testing.T, query string) map[string]interface{} {
+	res := graphQLRequest(t, TestPort, query)
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+	resObj := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(resBody, &resObj), string(resBody))
+	return resObj
+}
+
+func graphQLRequest(t *testing.T, port int, query string) *http.Response {
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	require.Nil(t, err)
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s:%d/graphql", TestAddr, port), strings.NewReader(string(body)))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	return res
+}
+
+func TestGraphQLBlock(t *testing.T) {
+	resObj := sendGraphQLRequest(t, `{ block(number: 8) { number hash parent { hash } miner { address } gasLimit gasUsed timestamp logsBloom transactionCount transactions { hash from { address } to { address } value gas inputData status } } }`)
+	require.Nil(t, resObj["errors"])
+	block := resObj["data"].(map[string]interface{})["block"].(map[string]interface{})
+	require.Equal(t, "0x8", block["number"])
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", block["hash"])
+	require.Equal(t, "0x0000000000000000000000000000000000000005", block["miner"].(map[string]interface{})["address"])
+	require.Equal(t, "0xbebc200", block["gasLimit"])
+	require.Equal(t, "0x5", block["gasUsed"])
+	require.Equal(t, "0x65254651", block["timestamp"])
+	require.Equal(t, "0x1", block["transactionCount"])
+	tx := block["transactions"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0xc1f0d26c419dea496540ab96a3331a9a79f084d7bc9662178dcd7c0bc407dc33", tx["hash"])
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", tx["from"].(map[string]interface{})["address"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", tx["to"].(map[string]interface{})["address"])
+	require.Equal(t, "0x3e8", tx["value"])
+	require.Equal(t, "0x3e8", tx["gas"])
+	require.Equal(t, "0x616263", tx["inputData"])
+
+	resObj = sendGraphQLRequest(t, `{ block(number: 8, hash: "0x0000000000000000000000000000000000000000000000000000000000000001") { number } }`)
+	require.NotNil(t, resObj["errors"])
+}
+
+func TestGraphQLTransaction(t *testing.T) {
+	resObj := sendGraphQLRequest(t, `{ transaction(hash: "0xc1f0d26c419dea496540ab96a3331a9a79f084d7bc9662178dcd7c0bc407dc33") { hash nonce index gasPrice block { number } } }`)
+	require.Nil(t, resObj["errors"])
+	tx := resObj["data"].(map[string]interface{})["transaction"].(map[string]interface{})
+	require.Equal(t, "0xc1f0d26c419dea496540ab96a3331a9a79f084d7bc9662178dcd7c0bc407dc33", tx["hash"])
+	require.Equal(t, "0x1", tx["nonce"])
+	require.Equal(t, "0x0", tx["index"])
+	require.Equal(t, "0xa", tx["gasPrice"])
+	require.Equal(t, "0x8", tx["block"].(map[string]interface{})["number"])
+}
+
+func TestGraphQLLogs(t *testing.T) {
+	resObj := sendGraphQLRequest(t, `{ logs(filter: { fromBlock: 2, toBlock: 2, addresses: ["0x1111111111111111111111111111111111111112"] }) { account { address } topics transaction { hash } } }`)
+	require.Nil(t, resObj["errors"])
+	logs := resObj["data"].(map[string]interface{})["logs"].([]interface{})
+	require.Len(t, logs, 2)
+	for _, log := range logs {
+		require.Equal(t, "0x1111111111111111111111111111111111111112", log.(map[string]interface{})["account"].(map[string]interface{})["address"])
+	}
+}
+
+func TestGraphQLQueryLimits(t *testing.T) {
+	resObj := sendGraphQLRequest(t, `{ blocks(from: 1, to: 5000) { number } }`)
+	require.Equal(t, "a maximum of 2000 blocks can be queried at once", resObj["errors"].([]interface{})[0].(map[string]interface{})["message"])
+	resObj = sendGraphQLRequest(t, `{ logs(filter: { fromBlock: 1, toBlock: 5000 }) { index } }`)
+	require.Equal(t, "a maximum of 2000 blocks can be queried at once", resObj["errors"].([]interface{})[0].(map[string]interface{})["message"])
+	resObj = sendGraphQLRequest(t, `{ blocks(from: 8, to: 1) { number } }`)
+	require.NotNil(t, resObj["errors"])
+
+	// nested queries are bounded by their depth and by the fields they resolve
+	resObj = sendGraphQLRequest(t, `{ block(number: 8) { parent { parent { parent { parent { parent { parent { parent { parent { parent { parent { parent { parent { number } } } } } } } } } } } } } }`)
+	require.Contains(t, resObj["errors"].([]interface{})[0].(map[string]interface{})["message"], "exceeds max depth")
+	resObj = sendGraphQLRequest(t, `{ blocks(from: 1, to: 1999) { number hash gasLimit gasUsed timestamp logsBloom } }`)
+	require.Equal(t, fmt.Sprintf("query resolves more than %d fields", evmrpc.GraphQLMaxQueryCost), resObj["errors"].([]interface{})[0].(map[string]interface{})["message"])
+}
+
+func TestGraphQLCall(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	resObj := sendGraphQLRequest(t, `{ block(number: 1) { call(data: { from: "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", to: "0x0000000000000000000000000000000000010203" }) { status gasUsed data } estimateGas(data: { from: "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", to: "0x0000000000000000000000000000000000010203" }) } }`)
+	require.Nil(t, resObj["errors"])
+	block := resObj["data"].(map[string]interface{})["block"].(map[string]interface{})
+	require.Equal(t, "0x1", block["call"].(map[string]interface{})["status"])
+	require.Equal(t, "0x5208", block["estimateGas"])
+	Ctx = Ctx.WithBlockHeight(8)
+}
+
+func TestGraphQLInfo(t *testing.T) {
+	resObj := sendGraphQLRequest(t, `{ chainID }`)
+	require.Nil(t, resObj["errors"])
+	require.Equal(t, "0x538", resObj["data"].(map[string]interface{})["chainID"])
+}
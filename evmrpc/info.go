@@ -25,10 +25,11 @@ type InfoAPI struct {
 	homeDir        string
 	connectionType ConnectionType
 	maxBlocks      int64
+	oracle         *GasPriceOracle
 }
 
-func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, homeDir string, maxBlocks int64, connectionType ConnectionType) *InfoAPI {
-	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, homeDir: homeDir, connectionType: connectionType, maxBlocks: maxBlocks}
+func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, homeDir string, maxBlocks int64, oracle *GasPriceOracle, connectionType ConnectionType) *InfoAPI {
+	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, homeDir: homeDir, connectionType: connectionType, maxBlocks: maxBlocks, oracle: oracle}
 }
 
 type FeeHistoryResult struct {
@@ -73,20 +74,13 @@ func (i *InfoAPI) Accounts() (result []common.Address, returnErr error) {
 func (i *InfoAPI) GasPrice(ctx context.Context) (result *hexutil.Big, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_GasPrice", i.connectionType, startTime, returnErr == nil)
-	// get fee history of the most recent block with 50% reward percentile
-	feeHist, err := i.FeeHistory(ctx, 1, rpc.LatestBlockNumber, []float64{0.5})
+	suggestions, err := i.oracle.Suggestions(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(feeHist.Reward) == 0 || len(feeHist.Reward[0]) == 0 {
-		// if there is no EVM tx in the most recent block, return the minimum fee param
-		baseFee := i.keeper.GetMinimumFeePerGas(i.ctxProvider(LatestCtxHeight)).TruncateInt().BigInt()
-		return (*hexutil.Big)(baseFee), nil
-	}
-	baseFee := i.keeper.GetDynamicBaseFeePerGas(i.ctxProvider(LatestCtxHeight)).TruncateInt().BigInt()
 	sum := new(big.Int).Add(
-		feeHist.Reward[0][0].ToInt(),
-		baseFee,
+		suggestions.Standard.MaxPriorityFeePerGas.ToInt(),
+		suggestions.BaseFeePerGas.ToInt(),
 	)
 	return (*hexutil.Big)(sum), nil
 }
@@ -157,7 +151,7 @@ func (i *InfoAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64
 			continue
 		}
 		result.GasUsedRatio = append(result.GasUsedRatio, GasUsedRatio)
-		baseFee := i.safeGetBaseFee(blockNum)
+		baseFee := safeGetBaseFee(i.keeper, sdkCtx)
 		if baseFee == nil {
 			// the block has been pruned
 			continue
@@ -181,24 +175,27 @@ func (i *InfoAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64
 func (i *InfoAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_maxPriorityFeePerGas", i.connectionType, startTime, true)
-	feeHist, err := i.FeeHistory(ctx, 1, rpc.LatestBlockNumber, []float64{0.5})
+	suggestions, err := i.oracle.Suggestions(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(feeHist.Reward) == 0 || len(feeHist.Reward[0]) == 0 {
-		// if there is no EVM tx in the most recent block, return 0
-		return (*hexutil.Big)(big.NewInt(0)), nil
-	}
-	return (*hexutil.Big)(feeHist.Reward[0][0].ToInt()), nil
+	return suggestions.Standard.MaxPriorityFeePerGas, nil
+}
+
+// BlobBaseFee returns the blob base fee of the next block
+func (i *InfoAPI) BlobBaseFee() *hexutil.Big {
+	startTime := time.Now()
+	defer recordMetrics("eth_blobBaseFee", i.connectionType, startTime, true)
+	return (*hexutil.Big)(i.keeper.GetBlobBaseFee(i.ctxProvider(LatestCtxHeight)))
 }
 
-func (i *InfoAPI) safeGetBaseFee(targetHeight int64) (res *big.Int) {
+func safeGetBaseFee(k *keeper.Keeper, ctx sdk.Context) (res *big.Int) {
 	defer func() {
 		if err := recover(); err != nil {
 			res = nil
 		}
 	}()
-	baseFee := i.keeper.GetDynamicBaseFeePerGas(i.ctxProvider(targetHeight))
+	baseFee := k.GetDynamicBaseFeePerGas(ctx)
 	res = baseFee.TruncateInt().BigInt()
 	return
 }
@@ -212,13 +209,13 @@ func (i *InfoAPI) getRewards(block *coretypes.ResultBlock, baseFee *big.Int, rew
 	GasAndRewards := []GasAndReward{}
 	totalEVMGasUsed := uint64(0)
 	for _, txbz := range block.Block.Txs {
-		ethtx := getEthTxForTxBz(txbz, i.txDecoder)
-		if ethtx == nil {
+		msg := getEvmMsgForTxBz(txbz, i.txDecoder)
+		if msg == nil {
 			// not evm tx
 			continue
 		}
 		// okay to get from latest since receipt is immutable
-		receipt, err := i.keeper.GetReceipt(i.ctxProvider(LatestCtxHeight), ethtx.Hash())
+		receipt, err := i.keeper.GetReceipt(i.ctxProvider(LatestCtxHeight), msg.Hash())
 		if err != nil {
 			return nil, err
 		}
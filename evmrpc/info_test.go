@@ -31,7 +31,7 @@ func TestChainID(t *testing.T) {
 
 func TestAccounts(t *testing.T) {
 	homeDir := t.TempDir()
-	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, homeDir, 1024, evmrpc.ConnectionTypeHTTP)
+	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, homeDir, 1024, nil, evmrpc.ConnectionTypeHTTP)
 	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
 	clientCtx, err := config.ReadFromClientConfig(clientCtx)
 	require.Nil(t, err)
@@ -170,3 +170,26 @@ func TestMaxPriorityFeePerGas(t *testing.T) {
 	resObj := sendRequestGood(t, "maxPriorityFeePerGas")
 	assert.Equal(t, "0x170cdc1e00", resObj["result"])
 }
+
+func TestFeeSuggestions(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	resObj := sendKiiRequestGood(t, "feeSuggestions")
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x1", result["blockNumber"])
+	require.Equal(t, "0x3b9aca00", result["baseFeePerGas"])
+	for _, tier := range []string{"slow", "standard", "fast"} {
+		suggestion := result[tier].(map[string]interface{})
+		require.Equal(t, "0x170cdc1e00", suggestion["maxPriorityFeePerGas"])
+		require.Equal(t, "0x178411b200", suggestion["maxFeePerGas"])
+	}
+
+	// the samples of block 1 are cached, so they still count once its state is no longer around
+	Ctx = Ctx.WithBlockHeight(8)
+	resObj = sendKiiRequestGood(t, "feeSuggestions")
+	result = resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x8", result["blockNumber"])
+	standard := result["standard"].(map[string]interface{})
+	require.Equal(t, "0x170cdc1e00", standard["maxPriorityFeePerGas"])
+	resObj = sendRequestGood(t, "gasPrice")
+	require.Equal(t, "0x174876e800", resObj["result"])
+}
@@ -0,0 +1,47 @@
+package evmrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/kiichain/kiichain/evmrpc/verify"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+type ProofAPI struct {
+	tmClient       rpcclient.Client
+	connectionType ConnectionType
+}
+
+func NewProofAPI(tmClient rpcclient.Client, connectionType ConnectionType) *ProofAPI {
+	return &ProofAPI{tmClient: tmClient, connectionType: connectionType}
+}
+
+// VerifyProofResult is the result of kii_verifyProof
+type VerifyProofResult struct {
+	Valid bool `json:"valid"`
+	// AppHash is the app hash of the header the proofs were verified against
+	AppHash hexutil.Bytes `json:"appHash"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// VerifyProof verifies the result of eth_getProof for storageKeys against the app hash of the
+// header right after the height of the proofs, as known by this node. It's a debugging helper:
+// clients that don't trust the node should run verify.VerifyProofWithHeader against a header
+// from a Tendermint light client instead.
+func (a *ProofAPI) VerifyProof(ctx context.Context, proof ProofResult, storageKeys []string) (result *VerifyProofResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("kii_verifyProof", a.connectionType, startTime, returnErr == nil)
+	height := int64(proof.Height) + 1
+	block, err := blockByNumber(ctx, a.tmClient, &height)
+	if err != nil {
+		return nil, err
+	}
+	result = &VerifyProofResult{Valid: true, AppHash: hexutil.Bytes(block.Block.Header.AppHash)}
+	if err := verify.VerifyProofWithHeader(&proof, storageKeys, &block.Block.Header); err != nil {
+		result.Valid = false
+		result.Error = err.Error()
+	}
+	return result, nil
+}
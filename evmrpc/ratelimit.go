@@ -0,0 +1,599 @@
+package evmrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/kiichain/kiichain/utils/metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// APIKeyHeader is the header clients put their API key in
+	APIKeyHeader = "X-Api-Key"
+	// APIKeyQueryParam can be used instead of the header by clients that can't set headers,
+	// like browser WebSocket clients
+	APIKeyQueryParam = "api_key"
+
+	RateLimitTierIP     = "ip"
+	RateLimitTierAPIKey = "api_key"
+
+	// RateLimitErrorCode is the JSON-RPC error code returned to throttled requests
+	RateLimitErrorCode = -32005
+
+	// compute units charged for methods without a configured cost
+	DefaultMethodComputeUnits = 1
+
+	rateLimitMaxBodySize   = 5 * 1024 * 1024
+	rateLimitIdleTimeout   = 10 * time.Minute
+	wsConnectMethod        = "ws_connect"
+	graphQLMethod          = "graphql"
+	throttledByRequests    = "requests"
+	throttledByComputeUnit = "compute_units"
+
+	wsProxyHandshakeTimeout = 10 * time.Second
+	// same as the read limit of the RPC server
+	wsProxyReadLimit = 32 * 1024 * 1024
+)
+
+// DefaultMethodComputeUnitCosts are the compute units charged for methods that are more expensive
+// to serve than a single state read. A trailing * matches every method with that prefix.
+var DefaultMethodComputeUnitCosts = map[string]uint64{
+	"eth_call":          5,
+	"eth_estimateGas":   5,
+	"eth_getLogs":       20,
+	"eth_getFilterLogs": 20,
+	"kii_getLogs":       20,
+	"kii_getFilterLogs": 20,
+	"debug_trace*":      50,
+	"trace_*":           50,
+}
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+type rateLimitError struct{}
+
+func (rateLimitError) Error() string  { return "rate limit exceeded" }
+func (rateLimitError) ErrorCode() int { return RateLimitErrorCode }
+
+type RateLimitConfig struct {
+	// static API keys accepted by the server. Requests without an API key are limited per IP.
+	APIKeys []string
+	// requests and compute units allowed per second for each IP, 0 means no limit
+	IPRequestsPerSecond     uint64
+	IPComputeUnitsPerSecond uint64
+	// requests and compute units allowed per second for each API key, 0 means no limit
+	APIKeyRequestsPerSecond     uint64
+	APIKeyComputeUnitsPerSecond uint64
+	// compute units charged per method, on top of DefaultMethodComputeUnitCosts
+	MethodComputeUnits map[string]uint64
+}
+
+// RateLimiter applies token-bucket limits on the requests and the compute units spent by each client,
+// where a client is either an API key or, for requests without any API key, an IP.
+type RateLimiter struct {
+	config  RateLimitConfig
+	apiKeys map[string]struct{}
+	costs   map[string]uint64
+	maxCost uint64
+
+	clientsMtx  sync.Mutex
+	clients     map[rateLimitClient]*clientLimiter
+	lastCleanup time.Time
+}
+
+type rateLimitClient struct {
+	tier string
+	id   string
+}
+
+type clientLimiter struct {
+	requests     *rate.Limiter
+	computeUnits *rate.Limiter
+	lastSeen     time.Time
+}
+
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	l := &RateLimiter{
+		config:  config,
+		apiKeys: make(map[string]struct{}, len(config.APIKeys)),
+		costs:   make(map[string]uint64, len(DefaultMethodComputeUnitCosts)+len(config.MethodComputeUnits)),
+		maxCost: DefaultMethodComputeUnits,
+		clients: make(map[rateLimitClient]*clientLimiter),
+	}
+	for _, key := range config.APIKeys {
+		l.apiKeys[key] = struct{}{}
+	}
+	for method, cost := range DefaultMethodComputeUnitCosts {
+		l.costs[method] = cost
+	}
+	for method, cost := range config.MethodComputeUnits {
+		l.costs[method] = cost
+	}
+	for _, cost := range l.costs {
+		if cost > l.maxCost {
+			l.maxCost = cost
+		}
+	}
+	return l
+}
+
+// NewRateLimiterFromConfig returns nil if neither API keys nor limits are configured. The
+// HTTP and WebSocket servers share the limiter so that clients get one budget across both.
+func NewRateLimiterFromConfig(config Config) (*RateLimiter, error) {
+	if len(config.APIKeys) == 0 && config.IPRequestsPerSecond == 0 && config.IPComputeUnitsPerSecond == 0 &&
+		config.APIKeyRequestsPerSecond == 0 && config.APIKeyComputeUnitsPerSecond == 0 {
+		return nil, nil
+	}
+	costs, err := ParseMethodComputeUnits(config.MethodComputeUnits)
+	if err != nil {
+		return nil, err
+	}
+	return NewRateLimiter(RateLimitConfig{
+		APIKeys:                     config.APIKeys,
+		IPRequestsPerSecond:         config.IPRequestsPerSecond,
+		IPComputeUnitsPerSecond:     config.IPComputeUnitsPerSecond,
+		APIKeyRequestsPerSecond:     config.APIKeyRequestsPerSecond,
+		APIKeyComputeUnitsPerSecond: config.APIKeyComputeUnitsPerSecond,
+		MethodComputeUnits:          costs,
+	}), nil
+}
+
+// ParseMethodComputeUnits parses entries of the form "<method>=<compute units>"
+func ParseMethodComputeUnits(entries []string) (map[string]uint64, error) {
+	res := make(map[string]uint64, len(entries))
+	for _, entry := range entries {
+		method, cost, found := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !found || method == "" {
+			return nil, fmt.Errorf("invalid method compute units %q, expected <method>=<compute units>", entry)
+		}
+		units, err := strconv.ParseUint(strings.TrimSpace(cost), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid method compute units %q: %w", entry, err)
+		}
+		res[method] = units
+	}
+	return res, nil
+}
+
+// MethodComputeUnits returns the compute units charged for a call to the method
+func (l *RateLimiter) MethodComputeUnits(method string) uint64 {
+	if cost, ok := l.costs[method]; ok {
+		return cost
+	}
+	// the longest matching prefix wins
+	var cost uint64 = DefaultMethodComputeUnits
+	matched := -1
+	for pattern, patternCost := range l.costs {
+		prefix, isWildcard := strings.CutSuffix(pattern, "*")
+		if isWildcard && strings.HasPrefix(method, prefix) && len(prefix) > matched {
+			cost = patternCost
+			matched = len(prefix)
+		}
+	}
+	return cost
+}
+
+func (l *RateLimiter) resolveClient(r *http.Request) (rateLimitClient, error) {
+	key := r.Header.Get(APIKeyHeader)
+	if key == "" {
+		key = r.URL.Query().Get(APIKeyQueryParam)
+	}
+	if key != "" {
+		if _, ok := l.apiKeys[key]; !ok {
+			return rateLimitClient{}, ErrInvalidAPIKey
+		}
+		return rateLimitClient{tier: RateLimitTierAPIKey, id: key}, nil
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return rateLimitClient{tier: RateLimitTierIP, id: host}, nil
+}
+
+// allow charges the client one request and the compute units of each of the method calls,
+// and returns the limit that was hit if the client can't afford them
+func (l *RateLimiter) allow(client rateLimitClient, methods []string) (bool, string) {
+	now := time.Now()
+	limiter := l.getClientLimiter(client, now)
+	var computeUnits uint64
+	for _, method := range methods {
+		computeUnits += l.MethodComputeUnits(method)
+	}
+	if computeUnits > math.MaxInt32 {
+		computeUnits = math.MaxInt32
+	}
+	reservation, ok := reserve(limiter.requests, now, len(methods))
+	if !ok {
+		return false, throttledByRequests
+	}
+	if _, ok := reserve(limiter.computeUnits, now, int(computeUnits)); !ok {
+		if reservation != nil {
+			reservation.CancelAt(now)
+		}
+		return false, throttledByComputeUnit
+	}
+	return true, ""
+}
+
+// charge takes compute units the client already spent from its budget, which it pays back before
+// its next calls are allowed
+func (l *RateLimiter) charge(client rateLimitClient, computeUnits uint64) {
+	now := time.Now()
+	limiter := l.getClientLimiter(client, now)
+	if limiter.computeUnits == nil {
+		return
+	}
+	// a reservation can't exceed the bucket size, so bigger costs are charged in several
+	for burst := uint64(limiter.computeUnits.Burst()); computeUnits > 0; {
+		n := computeUnits
+		if n > burst {
+			n = burst
+		}
+		limiter.computeUnits.ReserveN(now, int(n))
+		computeUnits -= n
+	}
+}
+
+func reserve(limiter *rate.Limiter, now time.Time, n int) (*rate.Reservation, bool) {
+	if limiter == nil {
+		return nil, true
+	}
+	reservation := limiter.ReserveN(now, n)
+	if !reservation.OK() {
+		return nil, false
+	}
+	if reservation.DelayFrom(now) > 0 {
+		reservation.CancelAt(now)
+		return nil, false
+	}
+	return reservation, true
+}
+
+func (l *RateLimiter) getClientLimiter(client rateLimitClient, now time.Time) *clientLimiter {
+	l.clientsMtx.Lock()
+	defer l.clientsMtx.Unlock()
+	if now.Sub(l.lastCleanup) > rateLimitIdleTimeout {
+		for c, limiter := range l.clients {
+			if now.Sub(limiter.lastSeen) > rateLimitIdleTimeout {
+				delete(l.clients, c)
+			}
+		}
+		l.lastCleanup = now
+	}
+	limiter, ok := l.clients[client]
+	if !ok {
+		requestsPerSecond, computeUnitsPerSecond := l.config.IPRequestsPerSecond, l.config.IPComputeUnitsPerSecond
+		if client.tier == RateLimitTierAPIKey {
+			requestsPerSecond, computeUnitsPerSecond = l.config.APIKeyRequestsPerSecond, l.config.APIKeyComputeUnitsPerSecond
+		}
+		limiter = &clientLimiter{
+			requests:     newTokenBucket(requestsPerSecond, 0),
+			computeUnits: newTokenBucket(computeUnitsPerSecond, l.maxCost),
+		}
+		l.clients[client] = limiter
+	}
+	limiter.lastSeen = now
+	return limiter
+}
+
+// newTokenBucket returns nil if there is no limit. The bucket can hold a second worth of tokens,
+// and at least minBurst tokens so that the most expensive method can always be called.
+func newTokenBucket(perSecond uint64, minBurst uint64) *rate.Limiter {
+	if perSecond == 0 {
+		return nil
+	}
+	burst := perSecond
+	if burst < minBurst {
+		burst = minBurst
+	}
+	if burst > math.MaxInt32 {
+		burst = math.MaxInt32
+	}
+	return rate.NewLimiter(rate.Limit(perSecond), int(burst))
+}
+
+type rateLimitHandler struct {
+	limiter *RateLimiter
+	methods func([]byte) []string
+	next    http.Handler
+}
+
+func newRateLimitHandler(limiter *RateLimiter, next http.Handler) http.Handler {
+	return &rateLimitHandler{limiter: limiter, methods: parseRequestMethods, next: next}
+}
+
+type graphQLRateLimitHandler struct {
+	limiter *RateLimiter
+	next    http.Handler
+}
+
+// newGraphQLRateLimitHandler charges a GraphQL query the compute units of the graphql method, which
+// can be configured like any JSON-RPC method, for every field it resolves through the node. The
+// first field is charged before the query runs and the others once it is done, so that a client
+// whose queries cost more than its budget is throttled afterwards.
+func newGraphQLRateLimitHandler(limiter *RateLimiter, next http.Handler) http.Handler {
+	return &graphQLRateLimitHandler{limiter: limiter, next: next}
+}
+
+// ServeHTTP implements http.Handler
+func (h *graphQLRateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client, err := h.limiter.resolveClient(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	// requests that aren't queries, like CORS preflights, aren't charged
+	if r.Method != http.MethodPost || r.Body == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	if allowed, reason := h.limiter.allow(client, []string{graphQLMethod}); !allowed {
+		metrics.IncrementRpcThrottledCounter(client.tier, string(ConnectionTypeHTTP), reason)
+		writeRateLimitError(w)
+		return
+	}
+	cost := &graphQLQueryCost{}
+	h.next.ServeHTTP(w, r.WithContext(withGraphQLQueryCost(r.Context(), cost)))
+	if fields := cost.Fields(); fields > 1 {
+		h.limiter.charge(client, (fields-1)*h.limiter.MethodComputeUnits(graphQLMethod))
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (h *rateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client, err := h.limiter.resolveClient(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	// requests that aren't JSON-RPC calls, like CORS preflights, aren't charged
+	if r.Method != http.MethodPost || r.Body == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, rateLimitMaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+	if allowed, reason := h.limiter.allow(client, h.methods(body)); !allowed {
+		metrics.IncrementRpcThrottledCounter(client.tier, string(ConnectionTypeHTTP), reason)
+		writeRateLimitError(w)
+		return
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+type wsRateLimitHandler struct {
+	limiter *RateLimiter
+	next    http.Handler
+}
+
+func newWSRateLimitHandler(limiter *RateLimiter, next http.Handler) http.Handler {
+	return &wsRateLimitHandler{limiter: limiter, next: next}
+}
+
+// ServeHTTP implements http.Handler. The connection is charged as a request, then every message
+// sent over it is charged like an HTTP request until it's closed. To see the messages, the
+// connection is proxied to the RPC server through an in-process connection.
+func (h *wsRateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client, err := h.limiter.resolveClient(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if allowed, reason := h.limiter.allow(client, []string{wsConnectMethod}); !allowed {
+		metrics.IncrementRpcThrottledCounter(client.tier, string(ConnectionTypeWS), reason)
+		writeRateLimitError(w)
+		return
+	}
+
+	// the RPC server handshake comes first so that it validates the origin of the request
+	serverConn, clientConn := net.Pipe()
+	listener := newPipeListener(&remoteAddrConn{Conn: serverConn, remoteAddr: wsRemoteAddr(r.RemoteAddr)})
+	defer listener.Close()
+	go func() {
+		_ = (&http.Server{Handler: h.next, ReadHeaderTimeout: wsProxyHandshakeTimeout}).Serve(listener)
+	}()
+	dialer := websocket.Dialer{
+		NetDial:          func(string, string) (net.Conn, error) { return clientConn, nil },
+		HandshakeTimeout: wsProxyHandshakeTimeout,
+	}
+	header := make(http.Header)
+	for _, key := range []string{"Origin", "User-Agent"} {
+		if value := r.Header.Get(key); value != "" {
+			header.Set(key, value)
+		}
+	}
+	upstream, resp, err := dialer.Dial("ws://"+r.Host+r.URL.RequestURI(), header)
+	if err != nil {
+		if resp != nil {
+			http.Error(w, http.StatusText(resp.StatusCode), resp.StatusCode)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	defer upstream.Close()
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(wsProxyReadLimit)
+
+	var writeMtx sync.Mutex
+	go func() {
+		// closing the client connection stops the proxy when the RPC server closes the connection
+		defer conn.Close()
+		for {
+			messageType, message, err := upstream.ReadMessage()
+			if err != nil {
+				return
+			}
+			writeMtx.Lock()
+			err = conn.WriteMessage(messageType, message)
+			writeMtx.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if allowed, reason := h.limiter.allow(client, parseRequestMethods(message)); !allowed {
+			metrics.IncrementRpcThrottledCounter(client.tier, string(ConnectionTypeWS), reason)
+			writeMtx.Lock()
+			err = conn.WriteMessage(websocket.TextMessage, rateLimitErrorResponse(message))
+			writeMtx.Unlock()
+		} else {
+			err = upstream.WriteMessage(messageType, message)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// pipeListener hands a single in-process connection to an http.Server
+type pipeListener struct {
+	conns  chan net.Conn
+	addr   net.Addr
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newPipeListener(conn net.Conn) *pipeListener {
+	conns := make(chan net.Conn, 1)
+	conns <- conn
+	return &pipeListener{conns: conns, addr: conn.LocalAddr(), closed: make(chan struct{})}
+}
+
+// Accept implements net.Listener
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close implements net.Listener
+func (l *pipeListener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+// Addr implements net.Listener
+func (l *pipeListener) Addr() net.Addr { return l.addr }
+
+// remoteAddrConn keeps the address of the proxied client, which the RPC server reports to the
+// handlers of the connection
+type remoteAddrConn struct {
+	net.Conn
+	remoteAddr net.Addr
+}
+
+// RemoteAddr implements net.Conn
+func (c *remoteAddrConn) RemoteAddr() net.Addr { return c.remoteAddr }
+
+type wsRemoteAddr string
+
+func (wsRemoteAddr) Network() string  { return "tcp" }
+func (a wsRemoteAddr) String() string { return string(a) }
+
+// parseRequestMethods returns the method of every call in a single or batch JSON-RPC request.
+// Malformed requests are charged as a single call and left to the RPC server to reject.
+func parseRequestMethods(body []byte) []string {
+	type call struct {
+		Method string `json:"method"`
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err == nil && len(calls) > 0 {
+			methods := make([]string, len(calls))
+			for i, c := range calls {
+				methods[i] = c.Method
+			}
+			return methods
+		}
+		return []string{""}
+	}
+	var c call
+	_ = json.Unmarshal(body, &c)
+	return []string{c.Method}
+}
+
+// rateLimitErrorResponse returns the rate limit error response of every call of a single or
+// batch JSON-RPC request
+func rateLimitErrorResponse(body []byte) []byte {
+	type call struct {
+		ID json.RawMessage `json:"id"`
+	}
+	errorResponse := func(id json.RawMessage) map[string]interface{} {
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      id,
+			"error": map[string]interface{}{
+				"code":    RateLimitErrorCode,
+				"message": rateLimitError{}.Error(),
+			},
+		}
+	}
+	var response interface{} = errorResponse(nil)
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err == nil && len(calls) > 0 {
+			responses := make([]map[string]interface{}, len(calls))
+			for i, c := range calls {
+				responses[i] = errorResponse(c.ID)
+			}
+			response = responses
+		}
+	} else {
+		var c call
+		if err := json.Unmarshal(body, &c); err == nil {
+			response = errorResponse(c.ID)
+		}
+	}
+	bz, _ := json.Marshal(response)
+	return bz
+}
+
+func writeRateLimitError(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    RateLimitErrorCode,
+			"message": rateLimitError{}.Error(),
+		},
+	})
+}
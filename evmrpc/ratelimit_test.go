@@ -0,0 +1,238 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	TestRateLimitWSPort      = 7780
+	TestRateLimitWSCallsPort = 7781
+	TestRateLimitSharedPort  = 7782
+	TestRateLimitSharedWS    = 7783
+	TestRateLimitGraphQLPort = 7784
+)
+
+func TestRateLimitMethodComputeUnits(t *testing.T) {
+	limiter := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{
+		MethodComputeUnits: map[string]uint64{"debug_traceBlockByNumber": 80, "eth_getLogs": 30},
+	})
+	require.Equal(t, uint64(50), limiter.MethodComputeUnits("debug_traceTransaction"))
+	require.Equal(t, uint64(80), limiter.MethodComputeUnits("debug_traceBlockByNumber"))
+	require.Equal(t, uint64(50), limiter.MethodComputeUnits("trace_block"))
+	require.Equal(t, uint64(30), limiter.MethodComputeUnits("eth_getLogs"))
+	require.Equal(t, uint64(evmrpc.DefaultMethodComputeUnits), limiter.MethodComputeUnits("eth_chainId"))
+
+	costs, err := evmrpc.ParseMethodComputeUnits([]string{"eth_getLogs=30", " debug_* = 60 "})
+	require.Nil(t, err)
+	require.Equal(t, map[string]uint64{"eth_getLogs": 30, "debug_*": 60}, costs)
+	_, err = evmrpc.ParseMethodComputeUnits([]string{"eth_getLogs"})
+	require.NotNil(t, err)
+	_, err = evmrpc.ParseMethodComputeUnits([]string{"=30"})
+	require.NotNil(t, err)
+	_, err = evmrpc.ParseMethodComputeUnits([]string{"eth_getLogs=-1"})
+	require.NotNil(t, err)
+}
+
+func TestRateLimitIP(t *testing.T) {
+	limiter := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{IPRequestsPerSecond: 2})
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "test_greet").StatusCode)
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "test_greet").StatusCode)
+	resp := rpcRequest(t, url, "test_greet")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	requireRateLimitError(t, resp)
+
+	// every call of a batch counts as a request
+	time.Sleep(time.Second)
+	require.Equal(t, http.StatusTooManyRequests, batchRpcRequest(t, url, []string{"test_greet", "test_greet", "test_greet"}).StatusCode)
+	require.Equal(t, http.StatusOK, batchRpcRequest(t, url, []string{"test_greet", "test_greet"}).StatusCode)
+}
+
+func TestRateLimitAPIKey(t *testing.T) {
+	limiter := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{
+		APIKeys:                     []string{"key"},
+		APIKeyComputeUnitsPerSecond: 10,
+		MethodComputeUnits:          map[string]uint64{"test_*": 30},
+	})
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	require.Equal(t, http.StatusUnauthorized, rpcRequest(t, url, "test_greet", evmrpc.APIKeyHeader, "bad").StatusCode)
+	require.Equal(t, http.StatusUnauthorized, rpcRequest(t, url+"?api_key=bad", "test_greet").StatusCode)
+
+	// the bucket holds enough compute units for the most expensive method
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "test_greet", evmrpc.APIKeyHeader, "key").StatusCode)
+	resp := rpcRequest(t, url+"?api_key=key", "test_greet")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	requireRateLimitError(t, resp)
+
+	// requests without api key are limited per IP, which has no limit here
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "test_greet").StatusCode)
+}
+
+func TestRateLimitWebSocketSubscriptions(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.HTTPEnabled = false
+	config.WSPort = TestRateLimitWSPort
+	config.IPComputeUnitsPerSecond = 1
+	config.MethodComputeUnits = []string{"eth_subscribe=20"}
+	limiter, err := evmrpc.NewRateLimiterFromConfig(config)
+	require.Nil(t, err)
+	wsServer, err := evmrpc.NewEVMWebSocketServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig, "", nil, nil, limiter)
+	require.Nil(t, err)
+	require.Nil(t, wsServer.Start())
+
+	headers := make(http.Header)
+	headers.Set("Origin", "localhost")
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%s:%d", TestAddr, TestRateLimitWSPort), headers)
+	require.Nil(t, err)
+	defer conn.Close()
+
+	// the connection costs 1 compute unit and the bucket holds 50
+	for i := 0; i < 3; i++ {
+		body := fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_subscribe","params":["newHeads"],"id":%d}`, i)
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(body)))
+		res := map[string]interface{}{}
+		require.Nil(t, conn.ReadJSON(&res))
+		if i < 2 {
+			require.Nil(t, res["error"])
+			require.NotNil(t, res["result"])
+		} else {
+			require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+		}
+	}
+}
+
+func TestRateLimitWebSocketCalls(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.HTTPEnabled = false
+	config.WSPort = TestRateLimitWSCallsPort
+	config.WSOrigins = "http://localhost"
+	config.IPComputeUnitsPerSecond = 1
+	config.MethodComputeUnits = []string{"eth_chainId=20"}
+	limiter, err := evmrpc.NewRateLimiterFromConfig(config)
+	require.Nil(t, err)
+	wsServer, err := evmrpc.NewEVMWebSocketServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig, "", nil, nil, limiter)
+	require.Nil(t, err)
+	require.Nil(t, wsServer.Start())
+	url := fmt.Sprintf("ws://%s:%d", TestAddr, TestRateLimitWSCallsPort)
+
+	// the RPC server still validates the origin
+	headers := make(http.Header)
+	headers.Set("Origin", "http://other")
+	_, resp, err := websocket.DefaultDialer.Dial(url, headers)
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	headers.Set("Origin", "http://localhost")
+	conn, _, err := websocket.DefaultDialer.Dial(url, headers)
+	require.Nil(t, err)
+	defer conn.Close()
+
+	// the connection costs 1 compute unit and the bucket holds 50
+	for i := 0; i < 3; i++ {
+		body := fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":%d}`, i)
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(body)))
+		res := map[string]interface{}{}
+		require.Nil(t, conn.ReadJSON(&res))
+		require.Equal(t, float64(i), res["id"])
+		if i < 2 {
+			require.Nil(t, res["error"])
+			require.NotNil(t, res["result"])
+		} else {
+			require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+		}
+	}
+
+	// every call of a throttled batch gets the error
+	body := `[{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":3},{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":4}]`
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(body)))
+	res := []map[string]interface{}{}
+	require.Nil(t, conn.ReadJSON(&res))
+	require.Len(t, res, 2)
+	for i, r := range res {
+		require.Equal(t, float64(i+3), r["id"])
+		require.Equal(t, float64(evmrpc.RateLimitErrorCode), r["error"].(map[string]interface{})["code"])
+	}
+}
+
+func TestRateLimitSharedAcrossServers(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.HTTPPort = TestRateLimitSharedPort
+	config.WSPort = TestRateLimitSharedWS
+	config.IPComputeUnitsPerSecond = 1
+	config.MethodComputeUnits = []string{"eth_chainId=20"}
+	limiter, err := evmrpc.NewRateLimiterFromConfig(config)
+	require.Nil(t, err)
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	httpServer, err := evmrpc.NewEVMHTTPServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, ctxProvider, TxConfig, "", nil, nil, limiter)
+	require.Nil(t, err)
+	require.Nil(t, httpServer.Start())
+	wsServer, err := evmrpc.NewEVMWebSocketServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, ctxProvider, TxConfig, "", nil, nil, limiter)
+	require.Nil(t, err)
+	require.Nil(t, wsServer.Start())
+
+	// the HTTP calls spend 40 of the 50 compute units of the bucket
+	url := fmt.Sprintf("http://%s:%d", TestAddr, TestRateLimitSharedPort)
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "eth_chainId").StatusCode)
+	require.Equal(t, http.StatusOK, rpcRequest(t, url, "eth_chainId").StatusCode)
+
+	// so the WebSocket connection can't afford another call
+	headers := make(http.Header)
+	headers.Set("Origin", "localhost")
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%s:%d", TestAddr, TestRateLimitSharedWS), headers)
+	require.Nil(t, err)
+	defer conn.Close()
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`)))
+	res := map[string]interface{}{}
+	require.Nil(t, conn.ReadJSON(&res))
+	require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+}
+
+func TestRateLimitGraphQLQueryCost(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.HTTPPort = TestRateLimitGraphQLPort
+	config.GraphQLEnabled = true
+	config.IPComputeUnitsPerSecond = 1
+	config.MethodComputeUnits = []string{"graphql=10"}
+	limiter, err := evmrpc.NewRateLimiterFromConfig(config)
+	require.Nil(t, err)
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	httpServer, err := evmrpc.NewEVMHTTPServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, ctxProvider, TxConfig, "", nil, nil, limiter)
+	require.Nil(t, err)
+	require.Nil(t, httpServer.Start())
+
+	// a query is charged for every field it resolves, so this one spends the 50 compute units of the bucket
+	resp := graphQLRequest(t, TestRateLimitGraphQLPort, `{ block(number: 8) { number hash gasLimit gasUsed timestamp } }`)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// and the next one is throttled, even though it would only resolve a single field
+	resp = graphQLRequest(t, TestRateLimitGraphQLPort, `{ chainID }`)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	requireRateLimitError(t, resp)
+}
+
+func requireRateLimitError(t *testing.T, resp *http.Response) {
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	res := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(body, &res))
+	require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+}
@@ -55,7 +55,8 @@ type WsConfig struct {
 }
 
 type RPCEndpointConfig struct {
-	JwtSecret              []byte // optional JWT secret
+	JwtSecret              []byte       // optional JWT secret
+	RateLimiter            *RateLimiter // optional per-client rate limiting
 	batchItemLimit         int
 	batchResponseSizeLimit int
 }
@@ -283,6 +284,15 @@ func (h *HTTPServer) doStop() {
 	h.server, h.listener = nil, nil
 }
 
+// RegisterHandler mounts a handler on the given path, which is served next to JSON-RPC once
+// it's enabled.
+func (h *HTTPServer) RegisterHandler(name, path string, handler http.Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlerNames[path] = name
+	h.mux.Handle(path, handler)
+}
+
 // EnableRPC turns on JSON-RPC over HTTP on the server.
 func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 	h.mu.Lock()
@@ -303,8 +313,12 @@ func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 		srv.RegisterDenyList(method)
 	}
 	h.HTTPConfig = config
+	var handler http.Handler = srv
+	if config.RateLimiter != nil {
+		handler = newRateLimitHandler(config.RateLimiter, handler)
+	}
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
+		Handler: NewHTTPHandlerStack(handler, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -336,8 +350,12 @@ func (h *HTTPServer) EnableWS(apis []rpc.API, config WsConfig) error {
 		return err
 	}
 	h.WsConfig = config
+	handler := srv.WebsocketHandler(config.Origins)
+	if config.RateLimiter != nil {
+		handler = newWSRateLimitHandler(config.RateLimiter, handler)
+	}
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.JwtSecret),
+		Handler: NewWSHandlerStack(handler, config.JwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -2,7 +2,9 @@ package evmrpc
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +12,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -27,6 +32,7 @@ type SendAPI struct {
 	ctxProvider    func(int64) sdk.Context
 	homeDir        string
 	backend        *Backend
+	blobStore      *BlobStore
 	connectionType ConnectionType
 }
 
@@ -34,7 +40,22 @@ type SendConfig struct {
 	slow bool
 }
 
-func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, homeDir string, simulateConfig *SimulateConfig, connectionType ConnectionType) *SendAPI {
+const (
+	// DefaultSendRawTransactionSyncTimeout is how long kii_sendRawTransactionSync waits for the
+	// transaction to be included when the caller doesn't specify a timeout
+	DefaultSendRawTransactionSyncTimeout = 10 * time.Second
+
+	// MaxSendRawTransactionSyncTimeout caps the timeout a caller can ask for
+	MaxSendRawTransactionSyncTimeout = time.Minute
+
+	// SendRawTransactionSyncTimeoutErrorCode is returned when the transaction was accepted into the
+	// mempool but wasn't included before the timeout (see EIP-7966)
+	SendRawTransactionSyncTimeoutErrorCode = 4
+
+	receiptPollInterval = 100 * time.Millisecond
+)
+
+func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, homeDir string, simulateConfig *SimulateConfig, blobStore *BlobStore, connectionType ConnectionType) *SendAPI {
 	return &SendAPI{
 		tmClient:       tmClient,
 		txConfig:       txConfig,
@@ -43,6 +64,7 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 		ctxProvider:    ctxProvider,
 		homeDir:        homeDir,
 		backend:        NewBackend(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig),
+		blobStore:      blobStore,
 		connectionType: connectionType,
 	}
 }
@@ -50,19 +72,26 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (hash common.Hash, err error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_sendRawTransaction", s.connectionType, startTime, err == nil)
-	tx := new(ethtypes.Transaction)
-	if err = tx.UnmarshalBinary(input); err != nil {
-		return
-	}
-	hash = tx.Hash()
-	txData, err := ethtx.NewTxDataFromTx(tx)
+	txData, err := decodeRawTransaction(input)
 	if err != nil {
 		return
 	}
+	if blobTx, ok := txData.(*ethtx.BlobTx); ok {
+		blobTxHash, pendingErr := s.addPendingSidecar(blobTx)
+		if pendingErr != nil {
+			return hash, pendingErr
+		}
+		defer func() {
+			if err != nil {
+				_ = s.blobStore.RemovePendingSidecar(blobTxHash)
+			}
+		}()
+	}
 	msg, err := types.NewMsgEVMTransaction(txData)
 	if err != nil {
 		return
 	}
+	hash = msg.Hash()
 	txBuilder := s.txConfig.NewTxBuilder()
 	if err = txBuilder.SetMsgs(msg); err != nil {
 		return
@@ -79,7 +108,7 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.CheckTx.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.CheckTx.Code, "")
+			err = NewCheckTxError(res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
 		}
 	} else {
 		res, broadcastError := s.tmClient.BroadcastTx(ctx, txbz)
@@ -88,12 +117,47 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.Code, "")
+			err = NewCheckTxError(res.Codespace, res.Code, res.Log)
 		}
 	}
 	return
 }
 
+// addPendingSidecar verifies the sidecar of a blob transaction and keeps it in the blob store
+// until the transaction is included in a block. The sidecar is removed from the transaction,
+// which doesn't change its hash, so that blocks only carry the blob hashes.
+func (s *SendAPI) addPendingSidecar(blobTx *ethtx.BlobTx) (common.Hash, error) {
+	if s.blobStore == nil {
+		return common.Hash{}, errBlobStoreNotActive
+	}
+	etx := ethtypes.NewTx(blobTx.AsEthereumData())
+	sidecar := etx.BlobTxSidecar()
+	if sidecar == nil {
+		return common.Hash{}, errors.New("missing sidecar in blob transaction")
+	}
+	if err := ethtx.ValidateBlobSidecar(etx.BlobHashes(), sidecar); err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.blobStore.AddPendingSidecar(etx.Hash(), sidecar, time.Now()); err != nil {
+		return common.Hash{}, err
+	}
+	blobTx.Sidecar = nil
+	return etx.Hash(), nil
+}
+
+// decodeRawTransaction decodes a signed transaction in its binary encoding. Set-code
+// transactions are decoded separately since go-ethereum doesn't support them.
+func decodeRawTransaction(input hexutil.Bytes) (ethtx.TxData, error) {
+	if len(input) > 0 && input[0] == ethtx.SetCodeTxType {
+		return ethtx.DecodeSetCodeTx(input)
+	}
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return nil, err
+	}
+	return ethtx.NewTxDataFromTx(tx)
+}
+
 func (s *SendAPI) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _ *string) (result *ethapi.SignTransactionResult, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_signTransaction", s.connectionType, startTime, returnErr == nil)
@@ -128,6 +192,17 @@ func (s *SendAPI) SendTransaction(ctx context.Context, args ethapi.TransactionAr
 }
 
 func (s *SendAPI) signTransaction(unsignedTx *ethtypes.Transaction, from string) (*ethtypes.Transaction, error) {
+	privKey, err := s.hostedKey(from)
+	if err != nil {
+		return nil, err
+	}
+	chainId := s.keeper.ChainID(s.ctxProvider(LatestCtxHeight))
+	signer := ethtypes.LatestSignerForChainID(chainId)
+	return ethtypes.SignTx(unsignedTx, signer, privKey)
+}
+
+// hostedKey returns the key of an address from the keyring of the node
+func (s *SendAPI) hostedKey(from string) (*ecdsa.PrivateKey, error) {
 	kb, err := getTestKeyring(s.homeDir)
 	if err != nil {
 		return nil, err
@@ -136,7 +211,133 @@ func (s *SendAPI) signTransaction(unsignedTx *ethtypes.Transaction, from string)
 	if !ok {
 		return nil, errors.New("from address does not have hosted key")
 	}
-	chainId := s.keeper.ChainID(s.ctxProvider(LatestCtxHeight))
-	signer := ethtypes.LatestSignerForChainID(chainId)
-	return ethtypes.SignTx(unsignedTx, signer, privKey)
+	return privKey, nil
+}
+
+// SendSyncAPI exposes kii_sendRawTransactionSync, which broadcasts a transaction like
+// eth_sendRawTransaction and then waits for it to be included
+type SendSyncAPI struct {
+	sendAPI *SendAPI
+}
+
+func NewSendSyncAPI(sendAPI *SendAPI) *SendSyncAPI {
+	return &SendSyncAPI{sendAPI: sendAPI}
+}
+
+// SendRawTransactionSync broadcasts the transaction and returns its receipt once it is included.
+// If the transaction failed on execution, the VM error is returned instead, with the receipt as
+// error data. timeout is in milliseconds.
+func (s *SendSyncAPI) SendRawTransactionSync(ctx context.Context, input hexutil.Bytes, timeout *hexutil.Uint64) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("kii_sendRawTransactionSync", s.sendAPI.connectionType, startTime, returnErr)
+	waitFor := DefaultSendRawTransactionSyncTimeout
+	if timeout != nil {
+		waitFor = time.Duration(*timeout) * time.Millisecond
+	}
+	if waitFor > MaxSendRawTransactionSyncTimeout {
+		waitFor = MaxSendRawTransactionSyncTimeout
+	}
+	hash, err := s.sendAPI.SendRawTransaction(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waitFor)
+	defer cancel()
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+	for {
+		sdkCtx := s.sendAPI.ctxProvider(LatestCtxHeight)
+		receipt, err := s.sendAPI.keeper.GetReceipt(sdkCtx, hash)
+		if err == nil {
+			height := int64(receipt.BlockNumber)
+			block, err := blockByNumberWithRetry(ctx, s.sendAPI.tmClient, &height, 1)
+			if err != nil {
+				return nil, err
+			}
+			fields, err := encodeReceipt(receipt, s.sendAPI.txConfig.TxDecoder(), block, blockBlobBaseFee(s.sendAPI.keeper, s.sendAPI.ctxProvider(height)), func(h common.Hash) bool {
+				_, err := s.sendAPI.keeper.GetReceipt(sdkCtx, h)
+				return err == nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			if receipt.Status != uint32(ethtypes.ReceiptStatusSuccessful) {
+				return nil, &SendError{code: -32000, msg: receipt.VmError, data: fields}
+			}
+			return fields, nil
+		} else if !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, &SendError{
+				code: SendRawTransactionSyncTimeoutErrorCode,
+				msg:  "transaction was added to the mempool but wasn't included within the timeout",
+				data: hash.Hex(),
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendError is a JSON-RPC error for transactions that were rejected on submission or failed on
+// execution, with a geth-compatible code and message
+type SendError struct {
+	code int
+	msg  string
+	data interface{}
+}
+
+func (e *SendError) Error() string { return e.msg }
+
+func (e *SendError) ErrorCode() int { return e.code }
+
+func (e *SendError) ErrorData() interface{} { return e.data }
+
+// NewCheckTxError converts a failed CheckTx response into the error geth would have returned for
+// the same rejection (e.g. "nonce too low"), so that wallets and libraries matching on geth
+// messages keep working. The ABCI codespace, code and log are kept as error data.
+func NewCheckTxError(codespace string, code uint32, log string) *SendError {
+	abciErr := sdkerrors.ABCIError(codespace, code, log)
+	msg := log
+	if msg == "" {
+		msg = errors.Unwrap(abciErr).Error()
+	}
+	switch {
+	case sdkerrors.ErrWrongSequence.Is(abciErr):
+		msg = core.ErrNonceTooLow.Error()
+		if strings.Contains(log, core.ErrNonceTooHigh.Error()) {
+			msg = core.ErrNonceTooHigh.Error()
+		}
+	case sdkerrors.ErrInsufficientFee.Is(abciErr):
+		msg = txpool.ErrUnderpriced.Error()
+	case sdkerrors.ErrInsufficientFunds.Is(abciErr):
+		// association failures are reported as is since geth has no equivalent
+		if !strings.Contains(log, "association") {
+			msg = core.ErrInsufficientFunds.Error()
+		}
+	case sdkerrors.ErrOutOfGas.Is(abciErr):
+		msg = core.ErrIntrinsicGas.Error()
+		if strings.Contains(log, "exceeds block max gas") {
+			msg = txpool.ErrGasLimit.Error()
+		}
+	case sdkerrors.ErrInvalidChainID.Is(abciErr):
+		msg = ethtypes.ErrInvalidChainId.Error()
+	case sdkerrors.ErrUnsupportedTxType.Is(abciErr):
+		msg = core.ErrTxTypeNotSupported.Error()
+	case sdkerrors.ErrInvalidCoins.Is(abciErr):
+		msg = txpool.ErrNegativeValue.Error()
+	case sdkerrors.ErrInvalidPubKey.Is(abciErr):
+		msg = txpool.ErrInvalidSender.Error()
+	case sdkerrors.ErrTxInMempoolCache.Is(abciErr):
+		msg = txpool.ErrAlreadyKnown.Error()
+	case sdkerrors.ErrMempoolIsFull.Is(abciErr):
+		msg = legacypool.ErrTxPoolOverflow.Error()
+	}
+	return &SendError{
+		code: -32000,
+		msg:  msg,
+		data: map[string]interface{}{"codespace": codespace, "code": code, "log": log},
+	}
 }
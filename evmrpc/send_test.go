@@ -7,9 +7,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain/evmrpc"
 	"github.com/kiichain/kiichain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -61,5 +63,77 @@ func TestSendRawTransaction(t *testing.T) {
 	// bad server
 	resObj = sendRequestBad(t, "sendRawTransaction", payload)
 	errMap = resObj["error"].(map[string]interface{})
-	require.Equal(t, ": invalid sequence", errMap["message"].(string))
+	require.Equal(t, "log", errMap["message"].(string))
+	require.Equal(t, "test", errMap["data"].(map[string]interface{})["codespace"])
+}
+
+func TestNewCheckTxError(t *testing.T) {
+	for _, tc := range []struct {
+		code uint32
+		log  string
+		msg  string
+	}{
+		{sdkerrors.ErrWrongSequence.ABCICode(), "invalid sequence", "nonce too low"},
+		{sdkerrors.ErrWrongSequence.ABCICode(), "nonce too high: address 0x1, tx: 3 state: 1: invalid sequence", "nonce too high"},
+		{sdkerrors.ErrInsufficientFee.ABCICode(), "insufficient fee", "transaction underpriced"},
+		{sdkerrors.ErrInsufficientFunds.ABCICode(), "insufficient funds", "insufficient funds for gas * price + value"},
+		{sdkerrors.ErrInsufficientFunds.ABCICode(), "account needs to have at least 1 wei to force association: insufficient funds", "account needs to have at least 1 wei to force association: insufficient funds"},
+		{sdkerrors.ErrOutOfGas.ABCICode(), "out of gas", "intrinsic gas too low"},
+		{sdkerrors.ErrOutOfGas.ABCICode(), "tx gas limit 100 exceeds block max gas 10: out of gas", "exceeds block gas limit"},
+		{sdkerrors.ErrTxInMempoolCache.ABCICode(), "tx already exists in cache", "already known"},
+		{sdkerrors.ErrUnauthorized.ABCICode(), "signature verification failed", "signature verification failed"},
+		{sdkerrors.ErrUnauthorized.ABCICode(), "", "unauthorized"},
+	} {
+		err := evmrpc.NewCheckTxError(sdkerrors.RootCodespace, tc.code, tc.log)
+		require.Equal(t, tc.msg, err.Error())
+		require.Equal(t, -32000, err.ErrorCode())
+	}
+}
+
+func TestSendRawTransactionSync(t *testing.T) {
+	mnemonic := "fish mention unlock february marble dove vintage sand hub ordinary fade found inject room embark supply fabric improve spike stem give current similar glimpse"
+	derivedPriv, _ := hd.Secp256k1.Derive()(mnemonic, "", "")
+	privKey := hd.Secp256k1.Generate()(derivedPriv)
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	ethCfg := types.DefaultChainConfig().EthereumConfig(EVMKeeper.ChainID(Ctx))
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(Ctx.BlockHeight()), uint64(Ctx.BlockTime().Unix()))
+	to := common.HexToAddress("010203")
+	signedPayload := func(nonce uint64) (common.Hash, string) {
+		tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			Nonce:     nonce,
+			GasFeeCap: big.NewInt(10),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(1000),
+			ChainID:   EVMKeeper.ChainID(Ctx),
+		}), signer, key)
+		require.Nil(t, err)
+		bz, err := tx.MarshalBinary()
+		require.Nil(t, err)
+		return tx.Hash(), "0x" + hex.EncodeToString(bz)
+	}
+
+	// included
+	hash, payload := signedPayload(100)
+	require.Nil(t, EVMKeeper.MockReceipt(Ctx, hash, &types.Receipt{TxHashHex: hash.Hex(), BlockNumber: MockHeight, TransactionIndex: 0, Status: 1}))
+	resObj := sendRequestGoodWithNamespace(t, "kii", "sendRawTransactionSync", payload)
+	require.Nil(t, resObj["error"])
+	receipt := resObj["result"].(map[string]interface{})
+	require.Equal(t, hash.Hex(), receipt["transactionHash"])
+	require.Equal(t, "0x1", receipt["status"])
+
+	// failed on execution
+	hash, payload = signedPayload(101)
+	require.Nil(t, EVMKeeper.MockReceipt(Ctx, hash, &types.Receipt{TxHashHex: hash.Hex(), BlockNumber: MockHeight, TransactionIndex: 0, Status: 0, VmError: "execution reverted"}))
+	resObj = sendRequestGoodWithNamespace(t, "kii", "sendRawTransactionSync", payload)
+	errMap := resObj["error"].(map[string]interface{})
+	require.Equal(t, "execution reverted", errMap["message"])
+	require.Equal(t, hash.Hex(), errMap["data"].(map[string]interface{})["transactionHash"])
+
+	// not included within the timeout
+	hash, payload = signedPayload(102)
+	resObj = sendRequestGoodWithNamespace(t, "kii", "sendRawTransactionSync", payload, "0x1f4")
+	errMap = resObj["error"].(map[string]interface{})
+	require.Equal(t, float64(evmrpc.SendRawTransactionSyncTimeoutErrorCode), errMap["code"])
+	require.Equal(t, hash.Hex(), errMap["data"])
 }
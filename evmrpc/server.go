@@ -5,7 +5,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain/utils"
 	evmCfg "github.com/kiichain/kiichain/x/evm/config"
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	"github.com/tendermint/tendermint/libs/log"
@@ -31,6 +33,9 @@ func NewEVMHTTPServer(
 	ctxProvider func(int64) sdk.Context,
 	txConfig client.TxConfig,
 	homeDir string,
+	bloomIndex *BloomIndex,
+	blobStore *BlobStore,
+	rateLimiter *RateLimiter,
 ) (EVMServer, error) {
 	httpServer := NewHTTPServer(logger, rpc.HTTPTimeouts{
 		ReadTimeout:       config.ReadTimeout,
@@ -42,10 +47,12 @@ func NewEVMHTTPServer(
 		return nil, err
 	}
 	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
-	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, ConnectionTypeHTTP)
+	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, blobStore, ConnectionTypeHTTP)
 	ctx := ctxProvider(LatestCtxHeight)
+	gasPriceOracle := NewGasPriceOracle(tmClient, k, ctxProvider, txConfig.TxDecoder(), config.GasPriceOracleBlocks)
 
 	txAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeHTTP)
+	pendingTxFetcher := NewPendingTxFetcher(tmClient, k, ctxProvider, txConfig.TxDecoder(), int(config.MaxTxPoolTxs))
 	// filterAPI := NewFilterAPI(tmClient, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP)
 
 	apis := []rpc.API{
@@ -71,7 +78,11 @@ func NewEVMHTTPServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeHTTP),
+			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, gasPriceOracle, ConnectionTypeHTTP),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewFeeSuggestionAPI(gasPriceOracle, ConnectionTypeHTTP),
 		},
 		{
 			Namespace: "eth",
@@ -81,17 +92,29 @@ func NewEVMHTTPServer(
 			Namespace: "eth",
 			Service:   NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeHTTP),
 		},
+		{
+			Namespace: "eth",
+			Service:   NewBlobAPI(tmClient, blobStore, ConnectionTypeHTTP),
+		},
 		{
 			Namespace: "net",
 			Service:   NewNetAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), ConnectionTypeHTTP),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewFilterAPI(tmClient, k, ctxProvider, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP, "eth"),
+			Service:   NewFilterAPI(tmClient, k, ctxProvider, pendingTxFetcher, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, bloomIndex, ConnectionTypeHTTP, "eth"),
 		},
 		{
 			Namespace: "kii",
-			Service:   NewFilterAPI(tmClient, k, ctxProvider, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP, "kii"),
+			Service:   NewFilterAPI(tmClient, k, ctxProvider, pendingTxFetcher, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, bloomIndex, ConnectionTypeHTTP, "kii"),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewSendSyncAPI(sendAPI),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewProofAPI(tmClient, ConnectionTypeHTTP),
 		},
 		{
 			Namespace: "kii",
@@ -109,6 +132,26 @@ func NewEVMHTTPServer(
 			Namespace: "debug",
 			Service:   NewDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, ConnectionTypeHTTP),
 		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, config.MaxBlocksForLog, ConnectionTypeHTTP),
+		},
+	}
+	if config.BundlerEnabled {
+		bundler, err := NewBundler(logger, &BundlerConfig{
+			Address:      common.HexToAddress(config.BundlerAddress),
+			EntryPoints:  utils.Map(config.BundlerEntryPoints, common.HexToAddress),
+			Interval:     config.BundlerInterval,
+			MaxBundleOps: config.BundlerMaxOpsPerBundle,
+		}, sendAPI, NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeHTTP))
+		if err != nil {
+			return nil, err
+		}
+		bundler.Start()
+		apis = append(apis, rpc.API{
+			Namespace: "eth",
+			Service:   NewBundlerAPI(bundler, ConnectionTypeHTTP),
+		})
 	}
 	// Test API can only exist on non-live chain IDs.  These APIs instrument certain overrides.
 	if config.EnableTestAPI && !evmCfg.IsLiveChainID(ctx) {
@@ -121,9 +164,35 @@ func NewEVMHTTPServer(
 		logger.Info("Disabling Test EVM APIs", "liveChainID", evmCfg.IsLiveChainID(ctx), "enableTestAPI", config.EnableTestAPI)
 	}
 
+	if config.GraphQLEnabled {
+		handler, err := NewGraphQLHandler(&graphQLResolver{
+			blockAPI:      NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, "eth"),
+			txAPI:         txAPI,
+			stateAPI:      NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeHTTP),
+			infoAPI:       NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, gasPriceOracle, ConnectionTypeHTTP),
+			simulationAPI: NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeHTTP),
+			sendAPI:       sendAPI,
+			logFetcher:    &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, bloomIndex: bloomIndex},
+			maxBlocks:     config.MaxBlocksForLog,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if rateLimiter != nil {
+			handler = newGraphQLRateLimitHandler(rateLimiter, handler)
+		}
+		handler = NewHTTPHandlerStack(handler, strings.Split(config.CORSOrigins, ","), []string{"*"}, common.FromHex(config.JwtSecret))
+		httpServer.RegisterHandler("GraphQL", GraphQLPath, handler)
+		httpServer.RegisterHandler("GraphQL", GraphQLPath+"/", handler)
+	}
+
 	if err := httpServer.EnableRPC(apis, HTTPConfig{
 		CorsAllowedOrigins: strings.Split(config.CORSOrigins, ","),
 		Vhosts:             []string{"*"},
+		RPCEndpointConfig: RPCEndpointConfig{
+			JwtSecret:   common.FromHex(config.JwtSecret),
+			RateLimiter: rateLimiter,
+		},
 	}); err != nil {
 		return nil, err
 	}
@@ -138,6 +207,9 @@ func NewEVMWebSocketServer(
 	ctxProvider func(int64) sdk.Context,
 	txConfig client.TxConfig,
 	homeDir string,
+	bloomIndex *BloomIndex,
+	blobStore *BlobStore,
+	rateLimiter *RateLimiter,
 ) (EVMServer, error) {
 	httpServer := NewHTTPServer(logger, rpc.HTTPTimeouts{
 		ReadTimeout:       config.ReadTimeout,
@@ -149,6 +221,7 @@ func NewEVMWebSocketServer(
 		return nil, err
 	}
 	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
+	gasPriceOracle := NewGasPriceOracle(tmClient, k, ctxProvider, txConfig.TxDecoder(), config.GasPriceOracleBlocks)
 	apis := []rpc.API{
 		{
 			Namespace: "echo",
@@ -168,30 +241,44 @@ func NewEVMWebSocketServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeWS),
+			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, gasPriceOracle, ConnectionTypeWS),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewFeeSuggestionAPI(gasPriceOracle, ConnectionTypeWS),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, ConnectionTypeWS),
+			Service:   NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, blobStore, ConnectionTypeWS),
 		},
 		{
 			Namespace: "eth",
 			Service:   NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeWS),
 		},
+		{
+			Namespace: "eth",
+			Service:   NewBlobAPI(tmClient, blobStore, ConnectionTypeWS),
+		},
 		{
 			Namespace: "net",
 			Service:   NewNetAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), ConnectionTypeWS),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSubscriptionAPI(tmClient, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider}, &SubscriptionConfig{subscriptionCapacity: 100, newHeadLimit: config.MaxSubscriptionsNewHead}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeWS),
+			Service:   NewSubscriptionAPI(tmClient, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, bloomIndex: bloomIndex}, NewPendingTxFetcher(tmClient, k, ctxProvider, txConfig.TxDecoder(), int(config.MaxTxPoolTxs)), &SubscriptionConfig{subscriptionCapacity: 100, newHeadLimit: config.MaxSubscriptionsNewHead}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeWS),
 		},
 		{
 			Namespace: "web3",
 			Service:   &Web3API{},
 		},
 	}
-	if err := httpServer.EnableWS(apis, WsConfig{Origins: strings.Split(config.WSOrigins, ",")}); err != nil {
+	if err := httpServer.EnableWS(apis, WsConfig{
+		Origins: strings.Split(config.WSOrigins, ","),
+		RPCEndpointConfig: RPCEndpointConfig{
+			JwtSecret:   common.FromHex(config.JwtSecret),
+			RateLimiter: rateLimiter,
+		},
+	}); err != nil {
 		return nil, err
 	}
 	return httpServer, nil
@@ -0,0 +1,52 @@
+package evmrpc
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
+)
+
+// RPCTransaction is go-ethereum's RPC transaction along with the authorization list of EIP-7702
+// set-code transactions, which the go-ethereum version Kii runs on doesn't support
+type RPCTransaction struct {
+	*ethapi.RPCTransaction
+	AuthorizationList []*RPCSetCodeAuthorization `json:"authorizationList,omitempty"`
+}
+
+// RPCSetCodeAuthorization is an authorization of a set-code transaction
+type RPCSetCodeAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
+}
+
+// newSetCodeRPCTransaction fixes up the RPC encoding go-ethereum produces for the execution view
+// of a set-code transaction, whose type, hash and sender aren't the ones of the transaction
+func newSetCodeRPCTransaction(res *ethapi.RPCTransaction, txData *ethtx.SetCodeTx) *RPCTransaction {
+	res.Type = hexutil.Uint64(ethtx.SetCodeTxType)
+	res.Hash = txData.Hash()
+	if from, err := txData.Sender(); err == nil {
+		res.From = from
+	}
+	return &RPCTransaction{RPCTransaction: res, AuthorizationList: encodeAuthorizationList(txData)}
+}
+
+func encodeAuthorizationList(txData *ethtx.SetCodeTx) []*RPCSetCodeAuthorization {
+	authList := make([]*RPCSetCodeAuthorization, 0, len(txData.Authorizations))
+	for _, auth := range txData.Authorizations {
+		v, r, s := auth.GetRawSignatureValues()
+		authList = append(authList, &RPCSetCodeAuthorization{
+			ChainID: (*hexutil.Big)(auth.GetChainID()),
+			Address: auth.GetAddress(),
+			Nonce:   hexutil.Uint64(auth.Nonce),
+			YParity: hexutil.Uint64(v.Uint64()),
+			R:       (*hexutil.Big)(r),
+			S:       (*hexutil.Big)(s),
+		})
+	}
+	return authList
+}
@@ -0,0 +1,101 @@
+package evmrpc_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type setCodeClient struct {
+	MockClient
+	tx tmtypes.Tx
+}
+
+func (c *setCodeClient) UnconfirmedTxs(context.Context, *int, *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	return &coretypes.ResultUnconfirmedTxs{Count: 1, Total: 1, Txs: []tmtypes.Tx{c.tx}}, nil
+}
+
+func buildSetCodeTx(t *testing.T) (*ethtx.SetCodeTx, common.Address, common.Address) {
+	mnemonic := "fish mention unlock february marble dove vintage sand hub ordinary fade found inject room embark supply fabric improve spike stem give current similar glimpse"
+	derivedPriv, _ := hd.Secp256k1.Derive()(mnemonic, "", "")
+	privKey := hd.Secp256k1.Generate()(derivedPriv)
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	authKey, _ := crypto.GenerateKey()
+
+	chainID := EVMKeeper.ChainID(Ctx)
+	authChainID := sdk.NewIntFromBigInt(chainID)
+	auth := ethtx.SetCodeAuthorization{ChainID: &authChainID, Address: common.HexToAddress("0x1234").Hex(), Nonce: 7}
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), authKey)
+	require.Nil(t, err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+	txData := &ethtx.SetCodeTx{
+		Nonce:          1,
+		GasLimit:       100000,
+		To:             crypto.PubkeyToAddress(authKey.PublicKey).Hex(),
+		Authorizations: []ethtx.SetCodeAuthorization{auth},
+	}
+	txData.SetAmount(sdk.ZeroInt())
+	txData.SetGasFeeCap(sdk.NewInt(10))
+	txData.SetGasTipCap(sdk.NewInt(10))
+	txData.SetSignatureValues(chainID, nil, nil, nil)
+	sig, err = crypto.Sign(txData.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	txData.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	return txData, crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(authKey.PublicKey)
+}
+
+func TestSendRawSetCodeTransaction(t *testing.T) {
+	txData, _, _ := buildSetCodeTx(t)
+	bz, err := txData.MarshalBinary()
+	require.Nil(t, err)
+	resObj := sendRequestGood(t, "sendRawTransaction", "0x"+hex.EncodeToString(bz))
+	require.Equal(t, txData.Hash().Hex(), resObj["result"])
+
+	// malformed set-code payloads are rejected
+	resObj = sendRequestGood(t, "sendRawTransaction", "0x04"+hex.EncodeToString(bz[2:]))
+	require.NotNil(t, resObj["error"])
+}
+
+func TestGetPendingSetCodeTransaction(t *testing.T) {
+	txData, sender, authority := buildSetCodeTx(t)
+	msg, err := types.NewMsgEVMTransaction(txData)
+	require.Nil(t, err)
+	builder := TxConfig.NewTxBuilder()
+	require.Nil(t, builder.SetMsgs(msg))
+	bz, err := Encoder(builder.GetTx())
+	require.Nil(t, err)
+	api := evmrpc.NewTransactionAPI(&setCodeClient{tx: bz}, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig, "", evmrpc.ConnectionTypeHTTP)
+
+	res, err := api.GetTransactionByHash(context.Background(), txData.Hash())
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, hexutil.Uint64(ethtx.SetCodeTxType), res.Type)
+	require.Equal(t, txData.Hash(), res.Hash)
+	require.Equal(t, sender, res.From)
+	require.Len(t, res.AuthorizationList, 1)
+	require.Equal(t, common.HexToAddress("0x1234"), res.AuthorizationList[0].Address)
+	require.Equal(t, hexutil.Uint64(7), res.AuthorizationList[0].Nonce)
+
+	// the authorization list is encoded alongside go-ethereum's fields
+	encoded, err := json.Marshal(res)
+	require.Nil(t, err)
+	fields := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(encoded, &fields))
+	require.Equal(t, "0x4", fields["type"])
+	require.Equal(t, authority.Hex(), common.HexToAddress(fields["to"].(string)).Hex())
+	require.Len(t, fields["authorizationList"], 1)
+}
@@ -0,0 +1,456 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/kiichain/kiichain/precompiles/wasmd"
+	"github.com/kiichain/kiichain/utils"
+	"github.com/kiichain/kiichain/x/evm/state"
+)
+
+const (
+	// MaxSimulateBlocks is the max number of blocks, including the ones filling gaps, simulated in one request
+	MaxSimulateBlocks = 256
+
+	// error codes of the execution-apis spec
+	simErrCodeReverted       = 3
+	simErrCodeVMError        = -32015
+	simErrCodeBlockNumber    = -38020
+	simErrCodeBlockTimestamp = -38021
+	simErrCodeBlockGasLimit  = -38015
+	simErrCodeClientLimit    = -38026
+)
+
+// TransferLogAddress is the pseudo-address of the ERC-7528 logs emitted for ether transfers
+// when transfer tracing is enabled
+var TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+type SimBlock struct {
+	BlockOverrides *SimBlockOverrides       `json:"blockOverrides"`
+	StateOverrides *ethapi.StateOverride    `json:"stateOverrides"`
+	Calls          []ethapi.TransactionArgs `json:"calls"`
+}
+
+// SimBlockOverrides are the header fields of a simulated block that can be overridden
+type SimBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big    `json:"blobBaseFee"`
+}
+
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	LogsBloom     ethtypes.Bloom  `json:"logsBloom"`
+	Transactions  []interface{}   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// simError is a request-level error carrying one of the execution-apis error codes
+type simError struct {
+	code int
+	msg  string
+}
+
+func (e *simError) Error() string  { return e.msg }
+func (e *simError) ErrorCode() int { return e.code }
+
+// SimulateV1 executes bundles of calls on top of the given block, each bundle in its own simulated
+// block. State changes carry over from one call to the next and from one block to the next.
+// The gas of all the calls is charged against SimulationGasLimit and the whole simulation against
+// SimulationEVMTimeout.
+func (s *SimulationAPI) SimulateV1(ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []*SimBlockResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("eth_simulateV1", s.connectionType, startTime, returnErr)
+	return s.simulate(ctx, opts, blockNrOrHash)
+}
+
+// CallMany is an alias of SimulateV1
+func (s *SimulationAPI) CallMany(ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []*SimBlockResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("eth_callMany", s.connectionType, startTime, returnErr)
+	return s.simulate(ctx, opts, blockNrOrHash)
+}
+
+func (s *SimulationAPI) simulate(ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []*SimBlockResult, returnErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if strings.Contains(fmt.Sprintf("%s", r), "Int overflow") {
+				returnErr = errors.New("error: balance override overflow")
+			} else {
+				returnErr = fmt.Errorf("something went wrong: %v", r)
+			}
+		}
+	}()
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, &simError{code: -32602, msg: "empty input"}
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	if timeout := s.backend.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	ctx = context.WithValue(ctx, CtxIsWasmdPrecompileCallKey, false)
+	stateDB, baseHeader, err := s.backend.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	statedb := stateDB.(*state.DBImpl)
+	baseHeight := baseHeader.Number.Int64()
+	baseBlock, err := blockByNumber(ctx, s.backend.tmClient, &baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := sanitizeSimBlocks(opts.BlockStateCalls, baseHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &simulator{
+		backend:   s.backend,
+		statedb:   statedb,
+		opts:      opts,
+		gasBudget: s.backend.RPCGasCap(),
+		tracer:    &simTracer{traceTransfers: opts.TraceTransfers},
+	}
+	statedb.SetLogger(sim.tracer.hooks())
+	parentHash := common.BytesToHash(baseBlock.BlockID.Hash)
+	for _, block := range blocks {
+		res, err := sim.processBlock(ctx, block, baseHeader, parentHash)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+		parentHash = res.Hash
+	}
+	return result, nil
+}
+
+// sanitizeSimBlocks fills in the number and timestamp of every block and inserts empty blocks
+// where there are gaps between block numbers
+func sanitizeSimBlocks(blocks []SimBlock, base *ethtypes.Header) ([]SimBlock, error) {
+	res := []SimBlock{}
+	prevNumber := base.Number.Uint64()
+	prevTimestamp := base.Time
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = &SimBlockOverrides{}
+		}
+		if block.BlockOverrides.Number == nil {
+			block.BlockOverrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		number := block.BlockOverrides.Number.ToInt()
+		if !number.IsUint64() || number.Uint64() <= prevNumber {
+			return nil, &simError{code: simErrCodeBlockNumber, msg: fmt.Sprintf("block numbers must be in order: %d <= %d", number, prevNumber)}
+		}
+		if number.Uint64()-base.Number.Uint64() > MaxSimulateBlocks {
+			return nil, &simError{code: simErrCodeClientLimit, msg: fmt.Sprintf("too many blocks, at most %d can be simulated", MaxSimulateBlocks)}
+		}
+		// empty blocks for the gap
+		for n := prevNumber + 1; n < number.Uint64(); n++ {
+			prevTimestamp++
+			timestamp := hexutil.Uint64(prevTimestamp)
+			res = append(res, SimBlock{BlockOverrides: &SimBlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(n)), Time: &timestamp}})
+		}
+		prevNumber = number.Uint64()
+		if block.BlockOverrides.Time == nil {
+			timestamp := hexutil.Uint64(prevTimestamp + 1)
+			block.BlockOverrides.Time = &timestamp
+		}
+		if uint64(*block.BlockOverrides.Time) <= prevTimestamp {
+			return nil, &simError{code: simErrCodeBlockTimestamp, msg: fmt.Sprintf("block timestamps must be in order: %d <= %d", *block.BlockOverrides.Time, prevTimestamp)}
+		}
+		prevTimestamp = uint64(*block.BlockOverrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+type simulator struct {
+	backend   *Backend
+	statedb   *state.DBImpl
+	opts      SimOpts
+	gasBudget uint64
+	tracer    *simTracer
+}
+
+func (sim *simulator) processBlock(ctx context.Context, block SimBlock, baseHeader *ethtypes.Header, parentHash common.Hash) (*SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		Number:     overrides.Number.ToInt(),
+		Time:       uint64(*overrides.Time),
+		GasLimit:   baseHeader.GasLimit,
+		Difficulty: common.Big0,
+		BaseFee:    baseHeader.BaseFee,
+	}
+	// fees aren't charged outside of validation mode, unless a base fee is explicitly requested
+	if !sim.opts.Validation {
+		header.BaseFee = utils.Big0
+	}
+	blockCtx, err := sim.backend.keeper.GetVMBlockContext(sim.statedb.Ctx(), core.GasPool(header.GasLimit))
+	if err != nil {
+		return nil, err
+	}
+	blockCtx.BlockNumber = header.Number
+	blockCtx.Time = header.Time
+	blockCtx.GasLimit = header.GasLimit
+	blockCtx.BaseFee = header.BaseFee
+	(&ethapi.BlockOverrides{
+		GasLimit:    overrides.GasLimit,
+		Coinbase:    overrides.FeeRecipient,
+		Random:      overrides.PrevRandao,
+		BaseFee:     overrides.BaseFeePerGas,
+		BlobBaseFee: overrides.BlobBaseFee,
+	}).Apply(blockCtx)
+	header.GasLimit = blockCtx.GasLimit
+	header.BaseFee = blockCtx.BaseFee
+	header.Coinbase = blockCtx.Coinbase
+	if err := block.StateOverrides.Apply(sim.statedb); err != nil {
+		return nil, err
+	}
+
+	var (
+		gasUsed  uint64
+		txs      = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders  = make([]common.Address, 0, len(block.Calls))
+		calls    = make([]SimCallResult, 0, len(block.Calls))
+		allLogs  []*ethtypes.Log
+		gasPool  = new(core.GasPool).AddGas(header.GasLimit)
+		chainCfg = sim.backend.ChainConfig()
+	)
+	for i := range block.Calls {
+		args := block.Calls[i]
+		if args.From == nil {
+			args.From = &common.Address{}
+		}
+		if args.Nonce == nil {
+			nonce := hexutil.Uint64(sim.statedb.GetNonce(*args.From))
+			args.Nonce = &nonce
+		}
+		remaining := header.GasLimit - gasUsed
+		if args.Gas != nil && uint64(*args.Gas) > remaining {
+			return nil, &simError{code: simErrCodeBlockGasLimit, msg: fmt.Sprintf("block gas limit reached: %d >= %d", gasUsed, header.GasLimit)}
+		}
+		if args.Gas == nil {
+			gas := hexutil.Uint64(remaining)
+			args.Gas = &gas
+		}
+		if sim.gasBudget == 0 {
+			return nil, &simError{code: simErrCodeClientLimit, msg: "simulation gas limit reached"}
+		}
+		if err := args.CallDefaults(sim.gasBudget, header.BaseFee, chainCfg.ChainID); err != nil {
+			return nil, err
+		}
+		msg := args.ToMessage(header.BaseFee)
+		msg.SkipAccountChecks = !sim.opts.Validation
+		tx := args.ToTransaction()
+		sim.statedb.WithCtx(sim.statedb.Ctx().WithEVMEntryViaWasmdPrecompile(wasmd.IsWasmdCall(msg.To)))
+		sim.tracer.reset(tx.Hash(), uint(len(txs)))
+		evm := sim.backend.GetEVM(ctx, msg, sim.statedb, header, &vm.Config{NoBaseFee: !sim.opts.Validation, Tracer: sim.tracer.hooks()}, blockCtx)
+		go func() {
+			<-ctx.Done()
+			evm.Cancel()
+		}()
+		res, err := core.ApplyMessage(evm, msg, gasPool)
+		if err := sim.statedb.Error(); err != nil {
+			return nil, err
+		}
+		if evm.Cancelled() {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", sim.backend.RPCEVMTimeout())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("call %d of block %d: %w", i, header.Number, err)
+		}
+		gasUsed += res.UsedGas
+		if res.UsedGas > sim.gasBudget {
+			sim.gasBudget = 0
+		} else {
+			sim.gasBudget -= res.UsedGas
+		}
+		callRes := SimCallResult{ReturnData: res.Return(), GasUsed: hexutil.Uint64(res.UsedGas), Logs: []*ethtypes.Log{}}
+		if res.Failed() {
+			callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			if errors.Is(res.Err, vm.ErrExecutionReverted) {
+				revertErr := NewRevertError(res)
+				callRes.Error = &SimCallError{Code: simErrCodeReverted, Message: revertErr.Error(), Data: revertErr.reason}
+			} else {
+				callRes.Error = &SimCallError{Code: simErrCodeVMError, Message: res.Err.Error()}
+			}
+		} else {
+			callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+			callRes.Logs = sim.tracer.callLogs()
+			allLogs = append(allLogs, callRes.Logs...)
+		}
+		txs = append(txs, tx)
+		senders = append(senders, *args.From)
+		calls = append(calls, callRes)
+	}
+
+	header.GasUsed = gasUsed
+	header.TxHash = ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil))
+	header.Bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(allLogs))
+	blockHash := header.Hash()
+	for i, l := range allLogs {
+		l.BlockHash = blockHash
+		l.BlockNumber = header.Number.Uint64()
+		l.Index = uint(i)
+	}
+	res := &SimBlockResult{
+		Number:        hexutil.Uint64(header.Number.Uint64()),
+		Hash:          blockHash,
+		ParentHash:    header.ParentHash,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(header.GasUsed),
+		Miner:         header.Coinbase,
+		BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+		LogsBloom:     header.Bloom,
+		Transactions:  make([]interface{}, 0, len(txs)),
+		Calls:         calls,
+	}
+	for i, tx := range txs {
+		if !sim.opts.ReturnFullTransactions {
+			res.Transactions = append(res.Transactions, tx.Hash())
+			continue
+		}
+		res.Transactions = append(res.Transactions, newSimRPCTransaction(tx, senders[i], blockHash, header, uint64(i)))
+	}
+	return res, nil
+}
+
+func newSimRPCTransaction(tx *ethtypes.Transaction, from common.Address, blockHash common.Hash, header *ethtypes.Header, index uint64) *ethapi.RPCTransaction {
+	v, r, s := tx.RawSignatureValues()
+	result := &ethapi.RPCTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      (*hexutil.Big)(header.Number),
+		From:             from,
+		Gas:              hexutil.Uint64(tx.Gas()),
+		GasPrice:         (*hexutil.Big)(tx.GasPrice()),
+		Hash:             tx.Hash(),
+		Input:            hexutil.Bytes(tx.Data()),
+		Nonce:            hexutil.Uint64(tx.Nonce()),
+		To:               tx.To(),
+		TransactionIndex: (*hexutil.Uint64)(&index),
+		Value:            (*hexutil.Big)(tx.Value()),
+		Type:             hexutil.Uint64(tx.Type()),
+		V:                (*hexutil.Big)(v),
+		R:                (*hexutil.Big)(r),
+		S:                (*hexutil.Big)(s),
+	}
+	if tx.Type() != ethtypes.LegacyTxType {
+		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		al := tx.AccessList()
+		result.Accesses = &al
+	}
+	if tx.Type() == ethtypes.DynamicFeeTxType {
+		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
+	}
+	return result
+}
+
+// simTracer collects the logs of a simulated call, dropping the ones of reverted frames, and
+// optionally adds ERC-7528 logs for ether transfers
+type simTracer struct {
+	traceTransfers bool
+	// logs of each frame of the call stack, the first one being the call itself
+	logs    [][]*ethtypes.Log
+	txHash  common.Hash
+	txIndex uint
+}
+
+func (t *simTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+		OnLog:   t.onLog,
+	}
+}
+
+func (t *simTracer) reset(txHash common.Hash, txIndex uint) {
+	t.logs = [][]*ethtypes.Log{{}}
+	t.txHash = txHash
+	t.txIndex = txIndex
+}
+
+func (t *simTracer) callLogs() []*ethtypes.Log {
+	return t.logs[0]
+}
+
+func (t *simTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.logs = append(t.logs, []*ethtypes.Log{})
+	if t.traceTransfers && value != nil && value.Sign() > 0 && vm.OpCode(typ) != vm.DELEGATECALL {
+		t.onLog(&ethtypes.Log{
+			Address: TransferLogAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		})
+	}
+}
+
+func (t *simTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	frame := t.logs[len(t.logs)-1]
+	t.logs = t.logs[:len(t.logs)-1]
+	if !reverted {
+		t.logs[len(t.logs)-1] = append(t.logs[len(t.logs)-1], frame...)
+	}
+}
+
+func (t *simTracer) onLog(l *ethtypes.Log) {
+	l.TxHash = t.txHash
+	l.TxIndex = t.txIndex
+	t.logs[len(t.logs)-1] = append(t.logs[len(t.logs)-1], l)
+}
@@ -0,0 +1,138 @@
+package evmrpc_test
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/kiichain/kiichain/example/contracts/simplestorage"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateV1(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	defer func() { Ctx = Ctx.WithBlockHeight(8) }()
+	_, from := testkeeper.MockAddressPair()
+	_, recipient := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../example/contracts/simplestorage/SimpleStorage.bin")
+	require.Nil(t, err)
+	abi, err := simplestorage.SimplestorageMetaData.GetAbi()
+	require.Nil(t, err)
+	setInput, err := abi.Pack("set", big.NewInt(20))
+	require.Nil(t, err)
+	getInput, err := abi.Pack("get")
+	require.Nil(t, err)
+	badInput, err := abi.Pack("bad")
+	require.Nil(t, err)
+	contractAddr := crypto.CreateAddress(from, 0)
+
+	opts := map[string]interface{}{
+		"traceTransfers": true,
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{
+				"stateOverrides": map[string]interface{}{
+					from.Hex(): map[string]interface{}{"balance": "0x3b9aca00"},
+				},
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "input": "0x" + strings.TrimSpace(string(code))},
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex(), "input": fmt.Sprintf("%#x", setInput)},
+					map[string]interface{}{"from": from.Hex(), "to": recipient.Hex(), "value": "0x3e8"},
+				},
+			},
+			map[string]interface{}{
+				"blockOverrides": map[string]interface{}{"number": "0x4", "feeRecipient": recipient.Hex()},
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex(), "input": fmt.Sprintf("%#x", getInput)},
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex(), "input": fmt.Sprintf("%#x", badInput)},
+				},
+			},
+		},
+	}
+	resObj := sendRequestGood(t, "simulateV1", opts, "latest")
+	require.Nil(t, resObj["error"])
+	blocks := resObj["result"].([]interface{})
+	// block 3 is an empty block filling the gap up to block 4
+	require.Len(t, blocks, 3)
+	first, gap, last := blocks[0].(map[string]interface{}), blocks[1].(map[string]interface{}), blocks[2].(map[string]interface{})
+	require.Equal(t, "0x2", first["number"])
+	require.Equal(t, "0x3", gap["number"])
+	require.Empty(t, gap["calls"])
+	require.Equal(t, "0x4", last["number"])
+	require.Equal(t, first["hash"], gap["parentHash"])
+	require.Equal(t, gap["hash"], last["parentHash"])
+	require.Equal(t, strings.ToLower(recipient.Hex()), last["miner"])
+
+	calls := first["calls"].([]interface{})
+	require.Len(t, calls, 3)
+	for _, call := range calls {
+		require.Equal(t, "0x1", call.(map[string]interface{})["status"])
+	}
+	setLogs := calls[1].(map[string]interface{})["logs"].([]interface{})
+	require.Len(t, setLogs, 1)
+	require.Equal(t, strings.ToLower(contractAddr.Hex()), setLogs[0].(map[string]interface{})["address"])
+	require.Equal(t, common.BigToHash(big.NewInt(20)).Hex(), setLogs[0].(map[string]interface{})["data"])
+	transferLogs := calls[2].(map[string]interface{})["logs"].([]interface{})
+	require.Len(t, transferLogs, 1)
+	require.Equal(t, strings.ToLower(evmrpc.TransferLogAddress.Hex()), transferLogs[0].(map[string]interface{})["address"])
+	require.Equal(t, "0x1", transferLogs[0].(map[string]interface{})["logIndex"])
+	require.Len(t, first["transactions"], 3)
+
+	// state carries over to later blocks
+	calls = last["calls"].([]interface{})
+	require.Equal(t, common.BigToHash(big.NewInt(20)).Hex(), calls[0].(map[string]interface{})["returnData"])
+	reverted := calls[1].(map[string]interface{})
+	require.Equal(t, "0x0", reverted["status"])
+	require.Equal(t, float64(3), reverted["error"].(map[string]interface{})["code"])
+
+	// eth_callMany is an alias
+	resObj = sendRequestGood(t, "callMany", opts, "latest")
+	require.Nil(t, resObj["error"])
+	require.Len(t, resObj["result"].([]interface{}), 3)
+}
+
+func TestSimulateV1Errors(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	defer func() { Ctx = Ctx.WithBlockHeight(8) }()
+	_, from := testkeeper.MockAddressPair()
+	_, to := testkeeper.MockAddressPair()
+
+	resObj := sendRequestGood(t, "simulateV1", map[string]interface{}{"blockStateCalls": []interface{}{}}, "latest")
+	require.Equal(t, "empty input", resObj["error"].(map[string]interface{})["message"])
+
+	resObj = sendRequestGood(t, "simulateV1", map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": "0x10"}},
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": "0xf"}},
+		},
+	}, "latest")
+	require.Equal(t, float64(-38020), resObj["error"].(map[string]interface{})["code"])
+
+	resObj = sendRequestGood(t, "simulateV1", map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": fmt.Sprintf("%#x", 1+evmrpc.MaxSimulateBlocks+1)}},
+		},
+	}, "latest")
+	require.Equal(t, float64(-38026), resObj["error"].(map[string]interface{})["code"])
+
+	// calls are checked against the account state in validation mode
+	call := map[string]interface{}{"from": from.Hex(), "to": to.Hex(), "value": "0x3e8", "maxFeePerGas": "0x3b9aca00"}
+	resObj = sendRequestGood(t, "simulateV1", map[string]interface{}{
+		"validation":      true,
+		"blockStateCalls": []interface{}{map[string]interface{}{"calls": []interface{}{call}}},
+	}, "latest")
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "insufficient funds")
+
+	// the gas of all calls is capped by the simulation gas limit
+	resObj = sendRequestGood(t, "simulateV1", map[string]interface{}{
+		"blockStateCalls": []interface{}{map[string]interface{}{"calls": []interface{}{
+			map[string]interface{}{"from": from.Hex(), "to": to.Hex(), "gas": fmt.Sprintf("%#x", 20_000_000)},
+		}}},
+	}, "latest")
+	require.Equal(t, float64(-38015), resObj["error"].(map[string]interface{})["code"])
+}
@@ -5,20 +5,17 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/store/cachekv"
-	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain/evmrpc/verify"
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	"github.com/kiichain/kiichain/x/evm/state"
 	"github.com/kiichain/kiichain/x/evm/types"
-	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 )
@@ -84,7 +81,7 @@ func (a *StateAPI) GetStorageAt(ctx context.Context, address common.Address, hex
 			return nil, err
 		}
 	}
-	key, _, err := decodeHash(hexKey)
+	key, _, err := verify.DecodeHash(hexKey)
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode storage key: %s", err)
 	}
@@ -92,16 +89,12 @@ func (a *StateAPI) GetStorageAt(ctx context.Context, address common.Address, hex
 	return state[:], nil
 }
 
-// Result structs for GetProof
-// This differs from go-ethereum AccountResult in two ways:
-// 1. Proof object is an iavl proof, not a trie proof
-// 2. Per-account fields are excluded because there is no per-account root
-type ProofResult struct {
-	Address      common.Address     `json:"address"`
-	HexValues    []string           `json:"hexValues"`
-	StorageProof []*crypto.ProofOps `json:"storageProof"`
-}
+// ProofResult is the result of GetProof, see verify.ProofResult
+type ProofResult = verify.ProofResult
 
+// GetProof returns the account fields and storage values of address together with proofs that
+// they are committed in the app hash of the header following the block. The proofs can be
+// checked with the verify package or kii_verifyProof.
 func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (result *ProofResult, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getProof", a.connectionType, startTime, returnErr == nil)
@@ -119,39 +112,71 @@ func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storage
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(block.Block.Height)
+	height := block.Block.Height
+	sdkCtx := a.ctxProvider(height)
 	if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 		return nil, err
 	}
-	var iavl *iavlstore.Store
-	s := sdkCtx.MultiStore().GetKVStore((a.keeper.GetStoreKey()))
-OUTER:
-	for {
-		switch cast := s.(type) {
-		case *iavlstore.Store:
-			iavl = cast
-			break OUTER
-		case *cachekv.Store:
-			if cast.GetParent() == nil {
-				return nil, errors.New("cannot find EVM IAVL store")
-			}
-			s = cast.GetParent()
-		default:
-			return nil, errors.New("cannot find EVM IAVL store")
+	prove := func(store string, key []byte) (*verify.Proof, error) {
+		res, err := a.tmClient.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", store), key, rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+		if err != nil {
+			return nil, err
+		}
+		if res.Response.IsErr() {
+			return nil, errors.New(res.Response.Log)
 		}
+		return &verify.Proof{Store: store, Key: key, Value: res.Response.Value, Proof: res.Response.ProofOps}, nil
+	}
+
+	accountProof := &verify.AccountProof{}
+	if accountProof.Association, err = prove(types.StoreKey, types.EVMAddressToKiiAddressKey(address)); err != nil {
+		return nil, err
+	}
+	kiiAddr := sdk.AccAddress(address[:])
+	if len(accountProof.Association.Value) > 0 {
+		kiiAddr = sdk.AccAddress(accountProof.Association.Value)
+	}
+	if accountProof.Balance, err = prove(banktypes.StoreKey, append(banktypes.CreateAccountBalancesPrefix(kiiAddr), []byte(a.keeper.GetBaseDenom(sdkCtx))...)); err != nil {
+		return nil, err
+	}
+	if accountProof.WeiBalance, err = prove(banktypes.StoreKey, append(append([]byte{}, banktypes.WeiBalancesPrefix...), kiiAddr...)); err != nil {
+		return nil, err
+	}
+	if accountProof.Nonce, err = prove(types.StoreKey, append(append([]byte{}, types.NonceKeyPrefix...), address[:]...)); err != nil {
+		return nil, err
+	}
+	if accountProof.CodeHash, err = prove(types.StoreKey, append(append([]byte{}, types.CodeHashKeyPrefix...), address[:]...)); err != nil {
+		return nil, err
+	}
+	account, err := accountProof.Account(address)
+	if err != nil {
+		return nil, err
+	}
+	storageHash, err := accountProof.Nonce.StoreRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	proofResult := ProofResult{
+		Address:      address,
+		Height:       hexutil.Uint64(height),
+		Balance:      (*hexutil.Big)(account.Balance),
+		Nonce:        hexutil.Uint64(account.Nonce),
+		CodeHash:     account.CodeHash,
+		StorageHash:  storageHash,
+		AccountProof: accountProof,
 	}
-	proofResult := ProofResult{Address: address}
 	for _, key := range storageKeys {
-		paddedKey := common.BytesToHash([]byte(key))
-		formattedKey := append(types.StateKey(address), paddedKey[:]...)
-		qres := iavl.Query(abci.RequestQuery{
-			Path:   "/key",
-			Data:   formattedKey,
-			Height: block.Block.Height,
-			Prove:  true,
-		})
-		proofResult.HexValues = append(proofResult.HexValues, hex.EncodeToString(qres.Value))
-		proofResult.StorageProof = append(proofResult.StorageProof, qres.ProofOps)
+		storageKey, err := verify.StorageKey(address, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode storage key: %s", err)
+		}
+		proof, err := prove(types.StoreKey, storageKey)
+		if err != nil {
+			return nil, err
+		}
+		proofResult.HexValues = append(proofResult.HexValues, hex.EncodeToString(proof.Value))
+		proofResult.StorageProof = append(proofResult.StorageProof, proof.Proof)
 	}
 
 	return &proofResult, nil
@@ -162,22 +187,3 @@ func (a *StateAPI) GetNonce(_ context.Context, address common.Address) uint64 {
 	defer recordMetrics("eth_getNonce", a.connectionType, startTime, true)
 	return a.keeper.GetNonce(a.ctxProvider(LatestCtxHeight), address)
 }
-
-// decodeHash parses a hex-encoded 32-byte hash. The input may optionally
-// be prefixed by 0x and can have a byte length up to 32.
-func decodeHash(s string) (h common.Hash, inputLength int, err error) {
-	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
-		s = s[2:]
-	}
-	if (len(s) & 1) > 0 {
-		s = "0" + s
-	}
-	b, err := hex.DecodeString(s)
-	if err != nil {
-		return common.Hash{}, 0, errors.New("hex string invalid")
-	}
-	if len(b) > 32 {
-		return common.Hash{}, len(b), errors.New("hex string too long, want at most 32 bytes")
-	}
-	return common.BytesToHash(b), len(b), nil
-}
@@ -11,13 +11,21 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain/app"
 	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/kiichain/kiichain/evmrpc/verify"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestGetBalance(t *testing.T) {
@@ -205,18 +213,41 @@ func TestGetStorageAt(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(8)
 }
 
+// proofClient serves store queries with proofs from a test app
+type proofClient struct {
+	MockClient
+	app *app.App
+}
+
+func (c *proofClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res, err := c.app.Query(ctx, &abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: *res}, nil
+}
+
 func TestGetProof(t *testing.T) {
 	testApp := app.Setup(false, false)
 	_, evmAddr := testkeeper.MockAddressPair()
 	key, val := []byte("test"), []byte("abc")
-	testApp.EvmKeeper.SetState(testApp.GetContextForDeliverTx([]byte{}), evmAddr, common.BytesToHash(key), common.BytesToHash(val))
+	// storage keys are hex slots, like the keys of eth_getStorageAt
+	hexKey := common.BytesToHash(key).Hex()
+	ctx := testApp.GetContextForDeliverTx([]byte{})
+	testApp.EvmKeeper.SetState(ctx, evmAddr, common.BytesToHash(key), common.BytesToHash(val))
+	testApp.EvmKeeper.SetNonce(ctx, evmAddr, 5)
+	amt := sdk.NewCoins(sdk.NewCoin(testApp.EvmKeeper.GetBaseDenom(ctx), sdk.NewInt(10)), sdk.NewCoin("uatom", sdk.NewInt(20)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, "evm", amt))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, "evm", sdk.AccAddress(evmAddr[:]), amt))
 	for i := 0; i < MockHeight; i++ {
 		testApp.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: int64(i + 1)})
 		testApp.SetDeliverStateToCommit()
 		_, err := testApp.Commit(context.Background())
 		require.Nil(t, err)
 	}
-	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP)
+	appHash := testApp.LastCommitID().Hash
+	client := &proofClient{app: testApp}
+	stateAPI := evmrpc.NewStateAPI(client, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP)
 	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000616263", testApp.EvmKeeper.GetState(testApp.GetCheckCtx(), evmAddr, common.BytesToHash(key)).Hex())
 	tests := []struct {
 		key         string
@@ -224,17 +255,23 @@ func TestGetProof(t *testing.T) {
 		expectedVal []byte
 	}{
 		{
-			key:         string(key),
+			key:         hexKey,
 			blockNr:     rpc.BlockNumber(-2),
 			expectedVal: val,
 		},
 		{
-			key:         string(key),
+			key:         hexKey,
 			blockNr:     rpc.BlockNumber(8),
 			expectedVal: val,
 		},
 		{
-			key:         "non existent",
+			// unpadded hex slots are left padded
+			key:         "0x74657374",
+			blockNr:     rpc.BlockNumber(-2),
+			expectedVal: val,
+		},
+		{
+			key:         "0x1234",
 			blockNr:     rpc.BlockNumber(-2),
 			expectedVal: []byte{},
 		},
@@ -247,5 +284,59 @@ func TestGetProof(t *testing.T) {
 		require.Equal(t, common.BytesToHash(test.expectedVal), common.HexToHash(vals[0]))
 		proofs := res.StorageProof
 		require.Equal(t, "ics23:iavl", proofs[0].Ops[0].Type)
+		require.Equal(t, "ics23:simple", proofs[0].Ops[1].Type)
+
+		require.Equal(t, hexutil.Uint64(MockHeight), res.Height)
+		require.Equal(t, hexutil.Uint64(5), res.Nonce)
+		require.Equal(t, "0x9184e72a000", res.Balance.String())
+		require.Equal(t, ethtypes.EmptyCodeHash, res.CodeHash)
+		require.Nil(t, verify.VerifyProof(res, []string{test.key}, appHash))
+	}
+
+	_, err := stateAPI.GetProof(context.Background(), evmAddr, []string{"not hex"}, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+	require.NotNil(t, err)
+
+	res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{hexKey}, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+	require.Nil(t, err)
+	require.NotNil(t, verify.VerifyProof(res, []string{hexKey}, testApp.GetCheckCtx().BlockHeader().AppHash))
+	require.NotNil(t, verify.VerifyProof(res, []string{"0x02"}, appHash))
+	tampered := *res
+	tampered.Nonce = 6
+	require.Contains(t, verify.VerifyProof(&tampered, []string{hexKey}, appHash).Error(), "nonce")
+	tampered = *res
+	tampered.HexValues = []string{hex.EncodeToString(common.BytesToHash([]byte("abd")).Bytes())}
+	require.Contains(t, verify.VerifyProof(&tampered, []string{hexKey}, appHash).Error(), "storage proof")
+	tampered = *res
+	tampered.AccountProof = &verify.AccountProof{
+		Association: res.AccountProof.Association,
+		Balance:     &verify.Proof{Store: res.AccountProof.Balance.Store, Key: res.AccountProof.Balance.Key, Value: res.AccountProof.WeiBalance.Value, Proof: res.AccountProof.Balance.Proof},
+		WeiBalance:  res.AccountProof.WeiBalance,
+		Nonce:       res.AccountProof.Nonce,
+		CodeHash:    res.AccountProof.CodeHash,
 	}
+	require.NotNil(t, verify.VerifyProof(&tampered, []string{hexKey}, appHash))
+
+	// a valid proof of the balance of another denom is rejected
+	otherDenomKey := append(banktypes.CreateAccountBalancesPrefix(sdk.AccAddress(evmAddr[:])), []byte("uatom")...)
+	otherDenom, err := client.ABCIQueryWithOptions(context.Background(), "/store/bank/key", otherDenomKey, rpcclient.ABCIQueryOptions{Height: MockHeight, Prove: true})
+	require.Nil(t, err)
+	otherDenomProof := &verify.Proof{Store: banktypes.StoreKey, Key: otherDenomKey, Value: otherDenom.Response.Value, Proof: otherDenom.Response.ProofOps}
+	require.Nil(t, otherDenomProof.Verify(appHash))
+	tampered = *res
+	accountProof := *res.AccountProof
+	accountProof.Balance = otherDenomProof
+	tampered.AccountProof = &accountProof
+	require.Contains(t, verify.VerifyProof(&tampered, []string{hexKey}, appHash).Error(), "proof is for bank/")
+
+	header := &tmtypes.Header{Height: MockHeight + 1, AppHash: appHash}
+	require.Nil(t, verify.VerifyProofWithHeader(res, []string{hexKey}, header))
+	header.Height = MockHeight
+	require.NotNil(t, verify.VerifyProofWithHeader(res, []string{hexKey}, header))
+
+	// the mock headers don't commit the test app state
+	proofAPI := evmrpc.NewProofAPI(client, evmrpc.ConnectionTypeHTTP)
+	verification, err := proofAPI.VerifyProof(context.Background(), *res, []string{hexKey})
+	require.Nil(t, err)
+	require.False(t, verification.Valid)
+	require.NotEmpty(t, verification.Error)
 }
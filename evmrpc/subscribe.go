@@ -21,6 +21,7 @@ import (
 
 const SleepInterval = 5 * time.Second
 const NewHeadsListenerBuffer = 10
+const PendingTxPollInterval = 500 * time.Millisecond
 
 type SubscriptionAPI struct {
 	tmClient            rpcclient.Client
@@ -31,6 +32,10 @@ type SubscriptionAPI struct {
 	newHeadListenersMtx *sync.RWMutex
 	newHeadListeners    map[rpc.ID]chan map[string]interface{}
 	connectionType      ConnectionType
+
+	pendingTxFetcher      *PendingTxFetcher
+	pendingTxListenersMtx *sync.RWMutex
+	pendingTxListeners    map[rpc.ID]chan *ethtypes.Transaction
 }
 
 type SubscriptionConfig struct {
@@ -38,16 +43,19 @@ type SubscriptionConfig struct {
 	newHeadLimit         uint64
 }
 
-func NewSubscriptionAPI(tmClient rpcclient.Client, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
+func NewSubscriptionAPI(tmClient rpcclient.Client, logFetcher *LogFetcher, pendingTxFetcher *PendingTxFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
 	logFetcher.filterConfig = filterConfig
 	api := &SubscriptionAPI{
-		tmClient:            tmClient,
-		subscriptionManager: NewSubscriptionManager(tmClient),
-		subscriptonConfig:   subscriptionConfig,
-		logFetcher:          logFetcher,
-		newHeadListenersMtx: &sync.RWMutex{},
-		newHeadListeners:    make(map[rpc.ID]chan map[string]interface{}),
-		connectionType:      connectionType,
+		tmClient:              tmClient,
+		subscriptionManager:   NewSubscriptionManager(tmClient),
+		subscriptonConfig:     subscriptionConfig,
+		logFetcher:            logFetcher,
+		newHeadListenersMtx:   &sync.RWMutex{},
+		newHeadListeners:      make(map[rpc.ID]chan map[string]interface{}),
+		connectionType:        connectionType,
+		pendingTxFetcher:      pendingTxFetcher,
+		pendingTxListenersMtx: &sync.RWMutex{},
+		pendingTxListeners:    make(map[rpc.ID]chan *ethtypes.Transaction),
 	}
 	id, subCh, err := api.subscriptionManager.Subscribe(context.Background(), NewHeadQueryBuilder(), api.subscriptonConfig.subscriptionCapacity)
 	if err != nil {
@@ -77,14 +85,15 @@ func NewSubscriptionAPI(tmClient rpcclient.Client, logFetcher *LogFetcher, subsc
 			api.newHeadListenersMtx.Unlock()
 		}
 	}()
+	go api.pollPendingTxs()
 	return api
 }
 
-func handleListener(c chan map[string]interface{}, ethHeader map[string]interface{}) bool {
+func handleListener[T any](c chan T, item T) bool {
 	// if the channel is already closed, sending to it/closing it will panic
 	defer func() { _ = recover() }()
 	select {
-	case c <- ethHeader:
+	case c <- item:
 		return true
 	default:
 		// this path is hit when the buffer is full, meaning that the subscriber is not consuming
@@ -138,6 +147,100 @@ func (a *SubscriptionAPI) NewHeads(ctx context.Context) (s *rpc.Subscription, er
 	return rpcSub, nil
 }
 
+func (a *SubscriptionAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_newPendingTransactions", a.connectionType, time.Now(), err == nil)
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	listener := make(chan *ethtypes.Transaction, a.subscriptonConfig.subscriptionCapacity)
+	a.pendingTxListenersMtx.Lock()
+	defer a.pendingTxListenersMtx.Unlock()
+	// pending tx subscriptions are capped by the same limit as new heads
+	if uint64(len(a.pendingTxListeners)) >= a.subscriptonConfig.newHeadLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	a.pendingTxListeners[rpcSub.ID] = listener
+
+	go func() {
+	OUTER:
+		for {
+			select {
+			case tx, ok := <-listener:
+				if !ok {
+					break OUTER
+				}
+				err = notifier.Notify(rpcSub.ID, a.pendingTxFetcher.EncodePendingTx(tx, fullTx != nil && *fullTx))
+				if err != nil {
+					break OUTER
+				}
+			case <-rpcSub.Err():
+				break OUTER
+			case <-notifier.Closed():
+				break OUTER
+			}
+		}
+		a.pendingTxListenersMtx.Lock()
+		defer a.pendingTxListenersMtx.Unlock()
+		delete(a.pendingTxListeners, rpcSub.ID)
+		defer func() { _ = recover() }() // might have already been closed
+		close(listener)
+	}()
+
+	return rpcSub, nil
+}
+
+// pollPendingTxs polls the mempool while there are pending tx listeners and
+// forwards each EVM tx the first time it is seen
+func (a *SubscriptionAPI) pollPendingTxs() {
+	seen := map[common.Hash]struct{}{}
+	for {
+		time.Sleep(PendingTxPollInterval)
+		a.pendingTxListenersMtx.RLock()
+		hasListeners := len(a.pendingTxListeners) > 0
+		a.pendingTxListenersMtx.RUnlock()
+		if !hasListeners {
+			continue
+		}
+
+		txs, err := a.pendingTxFetcher.GetPendingTxs(context.Background())
+		if err != nil {
+			fmt.Printf("error fetching pending txs due to %s\n", err)
+			continue
+		}
+		// only keep track of what is still in the mempool
+		current := make(map[common.Hash]struct{}, len(txs))
+		newTxs := []*ethtypes.Transaction{}
+		for _, tx := range txs {
+			current[tx.Hash()] = struct{}{}
+			if _, ok := seen[tx.Hash()]; !ok {
+				newTxs = append(newTxs, tx)
+			}
+		}
+		seen = current
+		if len(newTxs) == 0 {
+			continue
+		}
+
+		a.pendingTxListenersMtx.Lock()
+		toDelete := []rpc.ID{}
+		for id, c := range a.pendingTxListeners {
+			for _, tx := range newTxs {
+				if !handleListener(c, tx) {
+					toDelete = append(toDelete, id)
+					break
+				}
+			}
+		}
+		for _, id := range toDelete {
+			delete(a.pendingTxListeners, id)
+		}
+		a.pendingTxListenersMtx.Unlock()
+	}
+}
+
 func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriteria) (s *rpc.Subscription, err error) {
 	defer recordMetrics("eth_logs", a.connectionType, time.Now(), err == nil)
 	notifier, supported := rpc.NotifierFromContext(ctx)
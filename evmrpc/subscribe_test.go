@@ -83,6 +83,48 @@ func TestSubscribeNewHeads(t *testing.T) {
 	}
 }
 
+func TestSubscribeNewPendingTransactions(t *testing.T) {
+	t.Parallel()
+	recvCh, done := sendWSRequestGood(t, "subscribe", "newPendingTransactions", true)
+	defer func() { done <- struct{}{} }()
+
+	receivedSubMsg := false
+	timer := time.NewTimer(3 * time.Second)
+
+	var subscriptionId string
+
+	for {
+		select {
+		case resObj := <-recvCh:
+			_, ok := resObj["error"]
+			if ok {
+				t.Fatal("Received error:", resObj["error"])
+			}
+			if !receivedSubMsg {
+				// get subscriptionId from first message
+				subscriptionId = resObj["result"].(string)
+				receivedSubMsg = true
+				continue
+			}
+			method := resObj["method"].(string)
+			if method != "eth_subscription" {
+				t.Fatal("Method is not eth_subscription")
+			}
+			paramMap := resObj["params"].(map[string]interface{})
+			if paramMap["subscription"] != subscriptionId {
+				t.Fatal("Subscription ID does not match")
+			}
+			// full tx objects were requested
+			resultMap := paramMap["result"].(map[string]interface{})
+			require.Equal(t, "0x2", resultMap["nonce"].(string))
+			require.Equal(t, 66, len(resultMap["hash"].(string)))
+			return
+		case <-timer.C:
+			t.Fatal("No pending tx received within 3 seconds")
+		}
+	}
+}
+
 func TestSubscribeEmptyLogs(t *testing.T) {
 	t.Parallel()
 	recvCh, done := sendWSRequestGood(t, "subscribe", "logs")
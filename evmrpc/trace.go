@@ -0,0 +1,448 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	// TraceTypeTrace is the only trace type supported by trace_replayBlockTransactions and trace_call
+	TraceTypeTrace = "trace"
+
+	callTracerName = "callTracer"
+)
+
+// TraceAPI implements the Parity-style trace namespace on top of geth's native callTracer
+type TraceAPI struct {
+	tracersAPI     *tracers.API
+	backend        *Backend
+	tmClient       rpcclient.Client
+	keeper         *keeper.Keeper
+	ctxProvider    func(int64) sdk.Context
+	maxBlock       int64
+	connectionType ConnectionType
+}
+
+func NewTraceAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, maxBlock int64, connectionType ConnectionType) *TraceAPI {
+	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config)
+	tracersAPI := tracers.NewAPI(backend)
+	return &TraceAPI{tracersAPI: tracersAPI, backend: backend, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, maxBlock: maxBlock, connectionType: connectionType}
+}
+
+// ParityTrace is a single flat call trace
+type ParityTrace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+type CallTraceAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+type CallTraceResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+type CreateTraceAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+type CreateTraceResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+type SuicideTraceAction struct {
+	Address       common.Address `json:"address"`
+	RefundAddress common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big   `json:"balance"`
+}
+
+// TraceResults is the result of replaying a tx for trace_replayBlockTransactions and trace_call.
+// Only the trace type is supported so StateDiff and VmTrace are always null.
+type TraceResults struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*ParityTrace `json:"trace"`
+	VmTrace         interface{}    `json:"vmTrace"`
+	TransactionHash *common.Hash   `json:"transactionHash,omitempty"`
+}
+
+// TraceFilterArgs are the arguments of trace_filter
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// callFrame mirrors the JSON output of geth's native callTracer
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	To      *common.Address `json:"to,omitempty"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+}
+
+// txTraceContext locates a traced tx within its block
+type txTraceContext struct {
+	blockHash   common.Hash
+	blockNumber uint64
+	txHash      common.Hash
+	txIndex     uint64
+}
+
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_block", api.connectionType, startTime, returnErr == nil)
+	height, err := api.getHeight(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(ctx, height)
+}
+
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_transaction", api.connectionType, startTime, returnErr == nil)
+	_, blockHash, blockNumber, index, err := api.backend.GetTransaction(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.tracersAPI.TraceTransaction(ctx, hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	frame, err := toCallFrame(res)
+	if err != nil {
+		return nil, err
+	}
+	return flattenCallFrame(frame, &txTraceContext{blockHash: blockHash, blockNumber: blockNumber, txHash: hash, txIndex: index}), nil
+}
+
+// Filter returns the traces within a block range matching the from and to addresses,
+// the range can't span more than MaxBlocksForLog blocks
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_filter", api.connectionType, startTime, returnErr == nil)
+	latest := api.ctxProvider(LatestCtxHeight).BlockHeight()
+	begin, end := latest, latest
+	if args.FromBlock != nil {
+		if begin, returnErr = api.getHeight(ctx, *args.FromBlock); returnErr != nil {
+			return nil, returnErr
+		}
+	}
+	if args.ToBlock != nil {
+		if end, returnErr = api.getHeight(ctx, *args.ToBlock); returnErr != nil {
+			return nil, returnErr
+		}
+	}
+	if begin > end {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
+	}
+	if api.maxBlock > 0 && end >= (begin+api.maxBlock) {
+		return nil, fmt.Errorf("block range %d-%d exceeds the maximum of %d blocks", begin, end, api.maxBlock)
+	}
+
+	fromAddresses := toAddressSet(args.FromAddress)
+	toAddresses := toAddressSet(args.ToAddress)
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	result = []*ParityTrace{}
+	matched := uint64(0)
+	for height := begin; height <= end; height++ {
+		traces, err := api.traceBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			if !traceMatchesAddresses(trace, fromAddresses, toAddresses) {
+				continue
+			}
+			matched++
+			if matched <= after {
+				continue
+			}
+			result = append(result, trace)
+			if count > 0 && uint64(len(result)) >= count {
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+func (api *TraceAPI) ReplayBlockTransactions(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, traceTypes []string) (result []*TraceResults, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_replayBlockTransactions", api.connectionType, startTime, returnErr == nil)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	frames, txCtxs, err := api.traceBlockFrames(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]*TraceResults, 0, len(frames))
+	for i, frame := range frames {
+		txHash := txCtxs[i].txHash
+		// replayed traces aren't located within the block
+		traces := flattenCallFrame(frame, nil)
+		result = append(result, &TraceResults{Output: frame.Output, Trace: traces, TransactionHash: &txHash})
+	}
+	return result, nil
+}
+
+func (api *TraceAPI) Call(ctx context.Context, args ethapi.TransactionArgs, traceTypes []string, blockNrOrHash *rpc.BlockNumberOrHash) (result *TraceResults, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_call", api.connectionType, startTime, returnErr == nil)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	res, err := api.tracersAPI.TraceCall(ctx, args, *blockNrOrHash, &tracers.TraceCallConfig{TraceConfig: *callTracerConfig()})
+	if err != nil {
+		return nil, err
+	}
+	frame, err := toCallFrame(res)
+	if err != nil {
+		return nil, err
+	}
+	return &TraceResults{Output: frame.Output, Trace: flattenCallFrame(frame, nil)}, nil
+}
+
+// traceBlock returns the flat traces of all the EVM txs of a block
+func (api *TraceAPI) traceBlock(ctx context.Context, height int64) ([]*ParityTrace, error) {
+	frames, txCtxs, err := api.traceBlockFrames(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	traces := []*ParityTrace{}
+	for i, frame := range frames {
+		traces = append(traces, flattenCallFrame(frame, txCtxs[i])...)
+	}
+	return traces, nil
+}
+
+// traceBlockFrames runs the callTracer over all the EVM txs of a block
+func (api *TraceAPI) traceBlockFrames(ctx context.Context, height int64) ([]*callFrame, []*txTraceContext, error) {
+	block, err := blockByNumberWithRetry(ctx, api.tmClient, &height, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	ethBlock, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	// blocks without EVM txs don't need to be replayed
+	if len(ethBlock.Transactions()) == 0 {
+		return []*callFrame{}, []*txTraceContext{}, nil
+	}
+	results, err := api.tracersAPI.TraceBlockByNumber(ctx, rpc.BlockNumber(height), callTracerConfig())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	frames := make([]*callFrame, 0, len(results))
+	txCtxs := make([]*txTraceContext, 0, len(results))
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, nil, fmt.Errorf("failed to trace tx %s: %s", res.TxHash.Hex(), res.Error)
+		}
+		frame, err := toCallFrame(res.Result)
+		if err != nil {
+			return nil, nil, err
+		}
+		frames = append(frames, frame)
+		txCtxs = append(txCtxs, &txTraceContext{blockHash: blockHash, blockNumber: uint64(height), txHash: res.TxHash, txIndex: uint64(i)})
+	}
+	return frames, txCtxs, nil
+}
+
+func (api *TraceAPI) getHeight(ctx context.Context, number rpc.BlockNumber) (int64, error) {
+	blockNumber, err := getBlockNumber(ctx, api.tmClient, number)
+	if err != nil {
+		return 0, err
+	}
+	if blockNumber == nil {
+		// same as the simulation backend, latest is the last block with committed state
+		return api.ctxProvider(LatestCtxHeight).BlockHeight(), nil
+	}
+	return *blockNumber, nil
+}
+
+func callTracerConfig() *tracers.TraceConfig {
+	tracer := callTracerName
+	return &tracers.TraceConfig{Tracer: &tracer}
+}
+
+// toCallFrame converts the output of the callTracer into a callFrame
+func toCallFrame(res interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	frame := &callFrame{}
+	if err := json.Unmarshal(bz, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+// flattenCallFrame converts a callTracer frame and its sub calls into a list of flat traces,
+// depth first, with each trace pointing to its position in the call tree
+func flattenCallFrame(frame *callFrame, txCtx *txTraceContext) []*ParityTrace {
+	traces := []*ParityTrace{}
+	var flatten func(f *callFrame, traceAddress []int)
+	flatten = func(f *callFrame, traceAddress []int) {
+		trace := newParityTrace(f, traceAddress)
+		if txCtx != nil {
+			trace.BlockHash = &txCtx.blockHash
+			trace.BlockNumber = &txCtx.blockNumber
+			trace.TransactionHash = &txCtx.txHash
+			trace.TransactionPosition = &txCtx.txIndex
+		}
+		traces = append(traces, trace)
+		for i := range f.Calls {
+			subTraceAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+			copy(subTraceAddress, traceAddress)
+			flatten(&f.Calls[i], append(subTraceAddress, i))
+		}
+	}
+	flatten(frame, []int{})
+	return traces
+}
+
+func newParityTrace(f *callFrame, traceAddress []int) *ParityTrace {
+	trace := &ParityTrace{
+		Subtraces:    len(f.Calls),
+		TraceAddress: traceAddress,
+		Error:        f.Error,
+	}
+	value := f.Value
+	if value == nil {
+		value = (*hexutil.Big)(common.Big0)
+	}
+	var to common.Address
+	if f.To != nil {
+		to = *f.To
+	}
+	switch f.Type {
+	case "CREATE", "CREATE2":
+		trace.Type = "create"
+		trace.Action = &CreateTraceAction{From: f.From, Gas: f.Gas, Init: f.Input, Value: value}
+		if f.Error == "" {
+			trace.Result = &CreateTraceResult{Address: to, Code: f.Output, GasUsed: f.GasUsed}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = "suicide"
+		trace.Action = &SuicideTraceAction{Address: f.From, RefundAddress: to, Balance: value}
+	default:
+		trace.Type = "call"
+		trace.Action = &CallTraceAction{CallType: strings.ToLower(f.Type), From: f.From, Gas: f.Gas, Input: f.Input, To: to, Value: value}
+		if f.Error == "" {
+			trace.Result = &CallTraceResult{GasUsed: f.GasUsed, Output: f.Output}
+		}
+	}
+	return trace
+}
+
+func toAddressSet(addrs []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		set[addr] = struct{}{}
+	}
+	return set
+}
+
+// traceMatchesAddresses checks that the trace sender is in fromAddresses and its recipient in
+// toAddresses, an empty set matches any address
+func traceMatchesAddresses(trace *ParityTrace, fromAddresses, toAddresses map[common.Address]struct{}) bool {
+	var from, to common.Address
+	switch action := trace.Action.(type) {
+	case *CallTraceAction:
+		from, to = action.From, action.To
+	case *CreateTraceAction:
+		from = action.From
+		if res, ok := trace.Result.(*CreateTraceResult); ok {
+			to = res.Address
+		}
+	case *SuicideTraceAction:
+		from, to = action.Address, action.RefundAddress
+	}
+	if len(fromAddresses) > 0 {
+		if _, ok := fromAddresses[from]; !ok {
+			return false
+		}
+	}
+	if len(toAddresses) > 0 {
+		if _, ok := toAddresses[to]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		if traceType != TraceTypeTrace {
+			return fmt.Errorf("trace type %s is not supported", traceType)
+		}
+	}
+	if len(traceTypes) == 0 {
+		return errors.New("at least one trace type must be requested")
+	}
+	return nil
+}
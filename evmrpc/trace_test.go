@@ -0,0 +1,119 @@
+package evmrpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func requireDebugTraceCall(t *testing.T, trace map[string]interface{}) {
+	require.Equal(t, "call", trace["type"])
+	require.Equal(t, float64(0), trace["subtraces"])
+	require.Empty(t, trace["traceAddress"])
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, "call", action["callType"])
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", action["from"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", action["to"])
+	require.Equal(t, "0x55f0", action["gas"])
+	require.Equal(t, "0x616263", action["input"])
+	require.Equal(t, "0x3e8", action["value"])
+	require.NotNil(t, trace["result"])
+}
+
+func TestParityTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "block", "0x65")
+	traces := resObj["result"].([]interface{})
+	require.Len(t, traces, 1)
+	trace := traces[0].(map[string]interface{})
+	requireDebugTraceCall(t, trace)
+	require.Equal(t, float64(DebugTraceMockHeight), trace["blockNumber"])
+	require.Equal(t, float64(0), trace["transactionPosition"])
+	require.NotEmpty(t, trace["blockHash"])
+	require.NotEmpty(t, trace["transactionHash"])
+}
+
+func TestParityTraceTransaction(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "transaction", DebugTraceHashHex)
+	traces := resObj["result"].([]interface{})
+	require.Len(t, traces, 1)
+	trace := traces[0].(map[string]interface{})
+	requireDebugTraceCall(t, trace)
+	require.Equal(t, DebugTraceHashHex, trace["transactionHash"])
+	require.Equal(t, float64(DebugTraceMockHeight), trace["blockNumber"])
+}
+
+func TestParityTraceFilter(t *testing.T) {
+	// matching sender
+	resObj := sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock":   "0x65",
+		"toBlock":     "0x65",
+		"fromAddress": []common.Address{common.HexToAddress("0x5b4eba929f3811980f5ae0c5d04fa200f837df4e")},
+	})
+	traces := resObj["result"].([]interface{})
+	require.Len(t, traces, 1)
+	requireDebugTraceCall(t, traces[0].(map[string]interface{}))
+
+	// no matching recipient
+	_, other := testkeeper.MockAddressPair()
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x65",
+		"toBlock":   "0x65",
+		"toAddress": []common.Address{other},
+	})
+	require.Empty(t, resObj["result"].([]interface{}))
+
+	// pagination skips the first match
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x65",
+		"toBlock":   "0x65",
+		"after":     1,
+	})
+	require.Empty(t, resObj["result"].([]interface{}))
+
+	// the block range is capped
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x1",
+		"toBlock":   fmt.Sprintf("%#x", 1+2000),
+	})
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "exceeds the maximum of 2000 blocks")
+}
+
+func TestParityTraceReplayBlockTransactions(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"trace"})
+	results := resObj["result"].([]interface{})
+	require.Len(t, results, 1)
+	result := results[0].(map[string]interface{})
+	require.Nil(t, result["stateDiff"])
+	require.Nil(t, result["vmTrace"])
+	require.NotEmpty(t, result["transactionHash"])
+	traces := result["trace"].([]interface{})
+	require.Len(t, traces, 1)
+	requireDebugTraceCall(t, traces[0].(map[string]interface{}))
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"vmTrace"})
+	require.Equal(t, "trace type vmTrace is not supported", resObj["error"].(map[string]interface{})["message"])
+}
+
+func TestParityTraceCall(t *testing.T) {
+	_, from := testkeeper.MockAddressPair()
+	_, contractAddr := testkeeper.MockAddressPair()
+	txArgs := map[string]interface{}{
+		"from":    from.Hex(),
+		"to":      contractAddr.Hex(),
+		"chainId": fmt.Sprintf("%#x", EVMKeeper.ChainID(Ctx)),
+	}
+
+	resObj := sendRequestGoodWithNamespace(t, "trace", "call", txArgs, []interface{}{"trace"}, "0x65")
+	result := resObj["result"].(map[string]interface{})
+	traces := result["trace"].([]interface{})
+	require.Len(t, traces, 1)
+	trace := traces[0].(map[string]interface{})
+	require.Equal(t, "call", trace["type"])
+	require.Nil(t, trace["blockNumber"])
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, from.Hex(), common.HexToAddress(action["from"].(string)).Hex())
+	require.Equal(t, contractAddr.Hex(), common.HexToAddress(action["to"].(string)).Hex())
+}
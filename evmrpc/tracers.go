@@ -1,21 +1,33 @@
 package evmrpc
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // run init()s to register native tracers
 	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/kiichain/kiichain/x/evm/state"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 type DebugAPI struct {
 	tracersAPI     *tracers.API
+	backend        *Backend
 	tmClient       rpcclient.Client
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
@@ -26,7 +38,7 @@ type DebugAPI struct {
 func NewDebugAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, connectionType ConnectionType) *DebugAPI {
 	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config)
 	tracersAPI := tracers.NewAPI(backend)
-	return &DebugAPI{tracersAPI: tracersAPI, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, connectionType: connectionType}
+	return &DebugAPI{tracersAPI: tracersAPI, backend: backend, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, connectionType: connectionType}
 }
 
 func (api *DebugAPI) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
@@ -56,3 +68,167 @@ func (api *DebugAPI) TraceCall(ctx context.Context, args ethapi.TransactionArgs,
 	result, returnErr = api.tracersAPI.TraceCall(ctx, args, blockNrOrHash, config)
 	return
 }
+
+// TraceBlock traces a RLP encoded block, such as the one returned by debug_getRawBlock
+func (api *DebugAPI) TraceBlock(ctx context.Context, blob hexutil.Bytes, config *tracers.TraceConfig) (result interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_traceBlock", api.connectionType, startTime, returnErr == nil)
+	result, returnErr = api.tracersAPI.TraceBlock(ctx, blob, config)
+	return
+}
+
+// TraceBadBlock always fails since Tendermint never commits invalid blocks, so there
+// are no bad blocks to trace
+func (api *DebugAPI) TraceBadBlock(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_traceBadBlock", api.connectionType, startTime, returnErr == nil)
+	return nil, fmt.Errorf("bad block %#x not found", hash)
+}
+
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last key in the storage.
+}
+
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// StorageRangeAt returns the storage of a contract as of before the tx at txIndex in the block.
+// Like geth, slots are paged in hashed key order, so keyStart and nextKey are hashed slots. The
+// store is keyed by raw slots, so the whole storage of the contract is loaded to sort it.
+func (api *DebugAPI) StorageRangeAt(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (result StorageRangeResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_storageRangeAt", api.connectionType, startTime, returnErr == nil)
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	block, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	_, _, statedb, release, err := api.backend.StateAtTransaction(ctx, block, txIndex, 0)
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	defer release()
+	db, ok := statedb.(*state.DBImpl)
+	if !ok {
+		return StorageRangeResult{}, errors.New("unexpected state db type")
+	}
+
+	type slot struct {
+		hash  common.Hash
+		key   common.Hash
+		value common.Hash
+	}
+	start := common.BytesToHash(keyStart)
+	slots := []slot{}
+	api.keeper.IterateStorage(db.Ctx(), contractAddress, common.Hash{}, func(key common.Hash, val common.Hash) bool {
+		if hash := crypto.Keccak256Hash(key[:]); bytes.Compare(hash[:], start[:]) >= 0 {
+			slots = append(slots, slot{hash: hash, key: key, value: val})
+		}
+		return false
+	})
+	sort.Slice(slots, func(i, j int) bool { return bytes.Compare(slots[i].hash[:], slots[j].hash[:]) < 0 })
+
+	result = StorageRangeResult{Storage: map[common.Hash]StorageEntry{}}
+	for i, s := range slots {
+		if i >= maxResult {
+			nextKey := s.hash
+			result.NextKey = &nextKey
+			break
+		}
+		preimage := s.key
+		result.Storage[s.hash] = StorageEntry{Key: &preimage, Value: s.value}
+	}
+	return result, nil
+}
+
+// GetRawTransaction returns the binary encoding of a tx, or nil if it's not found
+func (api *DebugAPI) GetRawTransaction(ctx context.Context, hash common.Hash) (result hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawTransaction", api.connectionType, startTime, returnErr == nil)
+	tx, _, _, _, err := api.backend.GetTransaction(ctx, hash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if tx == nil {
+		return nil, nil
+	}
+	return tx.MarshalBinary()
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of the EVM txs of a block
+func (api *DebugAPI) GetRawReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawReceipts", api.connectionType, startTime, returnErr == nil)
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := api.ctxProvider(height)
+	result = []hexutil.Bytes{}
+	for _, tx := range block.Transactions() {
+		receipt, err := api.keeper.GetReceipt(sdkCtx, tx.Hash())
+		if err != nil {
+			// txs that failed before execution have no receipt
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return nil, err
+		}
+		ethReceipt := &ethtypes.Receipt{
+			Type:              uint8(receipt.TxType),
+			Status:            uint64(receipt.Status),
+			CumulativeGasUsed: receipt.CumulativeGasUsed,
+			Logs:              keeper.GetLogsForTx(receipt),
+		}
+		ethReceipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{ethReceipt})
+		bz, err := ethReceipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bz)
+	}
+	return result, nil
+}
+
+// GetRawBlock returns the RLP encoding of the EVM view of a block. The parent hash is the
+// Tendermint hash of the previous block so the encoded block can be passed to debug_traceBlock.
+func (api *DebugAPI) GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawBlock", api.connectionType, startTime, returnErr == nil)
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	tmBlock, err := blockByNumberWithRetry(ctx, api.tmClient, &height, 1)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	header := block.Header()
+	header.ParentHash = common.BytesToHash(tmBlock.Block.Header.LastBlockID.Hash)
+	blobGasUsed, excessBlobGas := api.keeper.GetBlockBlobGas(api.ctxProvider(height))
+	header.BlobGasUsed = &blobGasUsed
+	header.ExcessBlobGas = &excessBlobGas
+	// the withdrawals hash precedes the blob gas fields in the header encoding, so the block
+	// carries the empty list of withdrawals it commits to
+	withdrawals := ethtypes.Withdrawals{}
+	withdrawalsHash := ethtypes.DeriveSha(withdrawals, trie.NewStackTrie(nil))
+	header.WithdrawalsHash = &withdrawalsHash
+	return rlp.EncodeToBytes(ethtypes.NewBlockWithHeader(header).WithBody(block.Transactions(), nil).WithWithdrawals(withdrawals))
+}
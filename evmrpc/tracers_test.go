@@ -2,8 +2,15 @@ package evmrpc_test
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/stretchr/testify/require"
 )
@@ -86,3 +93,113 @@ func TestTraceCall(t *testing.T) {
 	require.Equal(t, float64(21000), result["gas"])
 	require.Equal(t, false, result["failed"])
 }
+
+func TestTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawBlock", "0x65")
+	rawBlock := resObj["result"].(string)
+
+	args := map[string]interface{}{"tracer": "callTracer"}
+	resObj = sendRequestGoodWithNamespace(t, "debug", "traceBlock", rawBlock, args)
+	result := resObj["result"].([]interface{})[0].(map[string]interface{})["result"].(map[string]interface{})
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", result["from"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", result["to"])
+	require.Equal(t, "CALL", result["type"])
+	require.Equal(t, "0x3e8", result["value"])
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "traceBlock", "0x1234", args)
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "could not decode block")
+}
+
+func TestTraceBadBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "traceBadBlock", "0x"+DebugTraceBlockHash)
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "not found")
+}
+
+func TestStorageRangeAt(t *testing.T) {
+	contract := "0x1234567890123456789023456789012345678901"
+	resObj := sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, contract, "0x", 10)
+	result := resObj["result"].(map[string]interface{})
+	require.Nil(t, result["nextKey"])
+	storage := result["storage"].(map[string]interface{})
+	require.Len(t, storage, 1)
+	key := common.BytesToHash([]byte("key"))
+	entry := storage[crypto.Keccak256Hash(key[:]).Hex()].(map[string]interface{})
+	require.Equal(t, key.Hex(), entry["key"])
+	require.Equal(t, common.BytesToHash([]byte("value")).Hex(), entry["value"])
+
+	// the range is capped by maxResult
+	resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, contract, "0x", 0)
+	result = resObj["result"].(map[string]interface{})
+	require.Empty(t, result["storage"])
+	require.Equal(t, crypto.Keccak256Hash(key[:]).Hex(), result["nextKey"])
+
+	// pages follow the hashed key order
+	pagedContract := common.HexToAddress("0x1234567890123456789023456789012345670000")
+	hashes := []string{}
+	for i := byte(1); i <= 4; i++ {
+		slot := common.BytesToHash([]byte{i})
+		EVMKeeper.SetState(Ctx, pagedContract, slot, common.BytesToHash([]byte{i}))
+		hashes = append(hashes, crypto.Keccak256Hash(slot[:]).Hex())
+	}
+	sort.Strings(hashes)
+	keyStart := "0x"
+	for i := 0; i < 4; i += 2 {
+		resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, pagedContract.Hex(), keyStart, 2)
+		result = resObj["result"].(map[string]interface{})
+		storage = result["storage"].(map[string]interface{})
+		require.Len(t, storage, 2)
+		require.Contains(t, storage, hashes[i])
+		require.Contains(t, storage, hashes[i+1])
+		if i == 0 {
+			require.Equal(t, hashes[2], result["nextKey"])
+			keyStart = hashes[2]
+		} else {
+			require.Nil(t, result["nextKey"])
+		}
+	}
+}
+
+func TestGetRawTransaction(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawTransaction", DebugTraceHashHex)
+	tx := &ethtypes.Transaction{}
+	require.Nil(t, tx.UnmarshalBinary(hexutil.MustDecode(resObj["result"].(string))))
+	require.Equal(t, "0x0000000000000000000000000000000000010203", strings.ToLower(tx.To().Hex()))
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getRawTransaction", "0x1234567890123456789023456789012345678901234567890123456789099999")
+	require.Equal(t, "0x", resObj["result"])
+}
+
+func TestGetRawReceipts(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawReceipts", "0x8")
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	receipt := &ethtypes.Receipt{}
+	require.Nil(t, receipt.UnmarshalBinary(hexutil.MustDecode(result[0].(string))))
+	require.Equal(t, uint8(1), receipt.Type)
+	require.Equal(t, uint64(123), receipt.CumulativeGasUsed)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, common.HexToAddress("0x1111111111111111111111111111111111111111"), receipt.Logs[0].Address)
+}
+
+func TestGetRawBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawBlock", "0x65")
+	block := &ethtypes.Block{}
+	require.Nil(t, rlp.DecodeBytes(hexutil.MustDecode(resObj["result"].(string)), block))
+	require.Equal(t, uint64(DebugTraceMockHeight), block.NumberU64())
+	require.Len(t, block.Transactions(), 1)
+	require.Equal(t, common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000006"), block.ParentHash())
+	blobGasUsed, excessBlobGas := EVMKeeper.GetBlockBlobGas(Ctx)
+	require.Equal(t, blobGasUsed, *block.BlobGasUsed())
+	require.Equal(t, excessBlobGas, *block.ExcessBlobGas())
+	require.Equal(t, ethtypes.EmptyWithdrawalsHash, *block.Header().WithdrawalsHash)
+	require.Empty(t, block.Withdrawals())
+
+	// the stored blob gas of the block is returned
+	EVMKeeper.SetBlockBlobGas(Ctx, 131072, 262144)
+	defer EVMKeeper.SetBlockBlobGas(Ctx, blobGasUsed, excessBlobGas)
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getRawBlock", "0x65")
+	block = &ethtypes.Block{}
+	require.Nil(t, rlp.DecodeBytes(hexutil.MustDecode(resObj["result"].(string)), block))
+	require.Equal(t, uint64(131072), *block.BlobGasUsed())
+	require.Equal(t, uint64(262144), *block.ExcessBlobGas())
+}
@@ -13,12 +13,14 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -58,7 +60,7 @@ func (t *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.
 	if err != nil {
 		return nil, err
 	}
-	return encodeReceipt(receipt, t.txConfig.TxDecoder(), block, func(h common.Hash) bool {
+	return encodeReceipt(receipt, t.txConfig.TxDecoder(), block, blockBlobBaseFee(t.keeper, t.ctxProvider(height)), func(h common.Hash) bool {
 		_, err := t.keeper.GetReceipt(sdkctx, h)
 		return err == nil
 	})
@@ -74,7 +76,7 @@ func (t *TransactionAPI) GetVMError(hash common.Hash) (result string, returnErr
 	return receipt.VmError, nil
 }
 
-func (t *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNr rpc.BlockNumber, index hexutil.Uint) (result *ethapi.RPCTransaction, returnErr error) {
+func (t *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNr rpc.BlockNumber, index hexutil.Uint) (result *RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionByBlockNumberAndIndex", t.connectionType, startTime, returnErr == nil)
 	blockNumber, err := getBlockNumber(ctx, t.tmClient, blockNr)
@@ -88,7 +90,7 @@ func (t *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context
 	return t.getTransactionWithBlock(block, index)
 }
 
-func (t *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash common.Hash, index hexutil.Uint) (result *ethapi.RPCTransaction, returnErr error) {
+func (t *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash common.Hash, index hexutil.Uint) (result *RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionByBlockHashAndIndex", t.connectionType, startTime, returnErr == nil)
 	block, err := blockByHash(ctx, t.tmClient, blockHash[:])
@@ -98,7 +100,7 @@ func (t *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context,
 	return t.getTransactionWithBlock(block, index)
 }
 
-func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (result *ethapi.RPCTransaction, returnErr error) {
+func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (result *RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionByHash", t.connectionType, startTime, returnErr == nil)
 	sdkCtx := t.ctxProvider(LatestCtxHeight)
@@ -109,8 +111,9 @@ func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 			break
 		}
 		for _, tx := range res.Txs {
-			etx := getEthTxForTxBz(tx, t.txConfig.TxDecoder())
-			if etx != nil && etx.Hash() == hash {
+			msg := getEvmMsgForTxBz(tx, t.txConfig.TxDecoder())
+			if msg != nil && msg.Hash() == hash {
+				etx, txData := msg.AsTransaction()
 				signer := ethtypes.MakeSigner(
 					types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx)),
 					big.NewInt(sdkCtx.BlockHeight()),
@@ -118,7 +121,7 @@ func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 				)
 				from, _ := ethtypes.Sender(signer, etx)
 				v, r, s := etx.RawSignatureValues()
-				res := ethapi.RPCTransaction{
+				res := &ethapi.RPCTransaction{
 					Type:     hexutil.Uint64(etx.Type()),
 					From:     from,
 					Gas:      hexutil.Uint64(etx.Gas()),
@@ -132,7 +135,10 @@ func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 					R:        (*hexutil.Big)(r),
 					S:        (*hexutil.Big)(s),
 				}
-				return &res, nil
+				if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+					return newSetCodeRPCTransaction(res, sctx), nil
+				}
+				return &RPCTransaction{RPCTransaction: res}, nil
 			}
 		}
 	}
@@ -187,15 +193,16 @@ func (t *TransactionAPI) GetTransactionCount(ctx context.Context, address common
 	return (*hexutil.Uint64)(&nonce), nil
 }
 
-func (t *TransactionAPI) getTransactionWithBlock(block *coretypes.ResultBlock, index hexutil.Uint) (*ethapi.RPCTransaction, error) {
+func (t *TransactionAPI) getTransactionWithBlock(block *coretypes.ResultBlock, index hexutil.Uint) (*RPCTransaction, error) {
 	if int(index) >= len(block.Block.Txs) {
 		return nil, nil
 	}
-	ethtx := getEthTxForTxBz(block.Block.Txs[int(index)], t.txConfig.TxDecoder())
-	if ethtx == nil {
+	msg := getEvmMsgForTxBz(block.Block.Txs[int(index)], t.txConfig.TxDecoder())
+	if msg == nil {
 		return nil, nil
 	}
-	receipt, err := t.keeper.GetReceipt(t.ctxProvider(LatestCtxHeight), ethtx.Hash())
+	etx, txData := msg.AsTransaction()
+	receipt, err := t.keeper.GetReceipt(t.ctxProvider(LatestCtxHeight), msg.Hash())
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +212,11 @@ func (t *TransactionAPI) getTransactionWithBlock(block *coretypes.ResultBlock, i
 	blockHash := common.HexToHash(block.BlockID.Hash.String())
 	blockNumber := uint64(block.Block.Height)
 	blockTime := block.Block.Time
-	res := ethapi.NewRPCTransaction(ethtx, blockHash, blockNumber, uint64(blockTime.Second()), uint64(receipt.TransactionIndex), baseFeePerGas, chainConfig)
-	return res, nil
+	res := ethapi.NewRPCTransaction(etx, blockHash, blockNumber, uint64(blockTime.Second()), uint64(receipt.TransactionIndex), baseFeePerGas, chainConfig)
+	if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+		return newSetCodeRPCTransaction(res, sctx), nil
+	}
+	return &RPCTransaction{RPCTransaction: res}, nil
 }
 
 func (t *TransactionAPI) Sign(addr common.Address, data hexutil.Bytes) (result hexutil.Bytes, returnErr error) {
@@ -227,6 +237,17 @@ func (t *TransactionAPI) Sign(addr common.Address, data hexutil.Bytes) (result h
 }
 
 func getEthTxForTxBz(tx tmtypes.Tx, decoder sdk.TxDecoder) *ethtypes.Transaction {
+	msg := getEvmMsgForTxBz(tx, decoder)
+	if msg == nil {
+		return nil
+	}
+	etx, _ := msg.AsTransaction()
+	return etx
+}
+
+// getEvmMsgForTxBz returns the EVM message of a transaction, which unlike the go-ethereum
+// transaction also knows the hash of set-code transactions
+func getEvmMsgForTxBz(tx tmtypes.Tx, decoder sdk.TxDecoder) *types.MsgEVMTransaction {
 	decoded, err := decoder(tx)
 	if err != nil {
 		return nil
@@ -239,8 +260,7 @@ func getEthTxForTxBz(tx tmtypes.Tx, decoder sdk.TxDecoder) *ethtypes.Transaction
 	if !ok || evmTx.IsAssociateTx() {
 		return nil
 	}
-	ethtx, _ := evmTx.AsTransaction()
-	return ethtx
+	return evmTx
 }
 
 // Gets the EVM tx index based on the tx index (typically from receipt.TransactionIndex
@@ -264,7 +284,13 @@ func GetEvmTxIndex(txs tmtypes.Txs, txIndex uint32, decoder sdk.TxDecoder, recei
 	return -1, false
 }
 
-func encodeReceipt(receipt *types.Receipt, decoder sdk.TxDecoder, block *coretypes.ResultBlock, receiptChecker func(common.Hash) bool) (map[string]interface{}, error) {
+// blockBlobBaseFee returns the blob base fee of the block whose state ctx is at
+func blockBlobBaseFee(k *keeper.Keeper, ctx sdk.Context) *big.Int {
+	_, excessBlobGas := k.GetBlockBlobGas(ctx)
+	return eip4844.CalcBlobFee(excessBlobGas)
+}
+
+func encodeReceipt(receipt *types.Receipt, decoder sdk.TxDecoder, block *coretypes.ResultBlock, blobBaseFee *big.Int, receiptChecker func(common.Hash) bool) (map[string]interface{}, error) {
 	blockHash := block.BlockID.Hash
 	bh := common.HexToHash(blockHash.String())
 	logs := keeper.GetLogsForTx(receipt)
@@ -301,5 +327,20 @@ func encodeReceipt(receipt *types.Receipt, decoder sdk.TxDecoder, block *coretyp
 	if receipt.To != "" {
 		fields["to"] = common.HexToAddress(receipt.To)
 	}
+	if receipt.TxType == ethtx.SetCodeTxType && int(receipt.TransactionIndex) < len(block.Block.Txs) {
+		if msg := getEvmMsgForTxBz(block.Block.Txs[receipt.TransactionIndex], decoder); msg != nil {
+			if _, txData := msg.AsTransaction(); txData != nil {
+				if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+					fields["authorizationList"] = encodeAuthorizationList(sctx)
+				}
+			}
+		}
+	}
+	if receipt.TxType == ethtypes.BlobTxType && int(receipt.TransactionIndex) < len(block.Block.Txs) {
+		if etx := getEthTxForTxBz(block.Block.Txs[receipt.TransactionIndex], decoder); etx != nil {
+			fields["blobGasUsed"] = hexutil.Uint64(etx.BlobGas())
+			fields["blobGasPrice"] = (*hexutil.Big)(blobBaseFee)
+		}
+	}
 	return fields, nil
 }
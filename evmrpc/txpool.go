@@ -2,18 +2,70 @@ package evmrpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
+// PendingTxFetcher reads the EVM transactions sitting in the Tendermint mempool
+type PendingTxFetcher struct {
+	tmClient    rpcclient.Client
+	k           *keeper.Keeper
+	ctxProvider func(int64) sdk.Context
+	txDecoder   sdk.TxDecoder
+	maxNumTxs   int
+}
+
+func NewPendingTxFetcher(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, maxNumTxs int) *PendingTxFetcher {
+	return &PendingTxFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, txDecoder: txDecoder, maxNumTxs: maxNumTxs}
+}
+
+// GetPendingTxs returns up to maxNumTxs unconfirmed EVM txs, non-EVM txs are skipped
+func (f *PendingTxFetcher) GetPendingTxs(ctx context.Context) ([]*ethtypes.Transaction, error) {
+	total := f.maxNumTxs
+	resUnconfirmedTxs, err := f.tmClient.UnconfirmedTxs(ctx, nil, &total)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := []*ethtypes.Transaction{}
+	for _, tx := range resUnconfirmedTxs.Txs {
+		msg := getEvmMsgForTxBz(tx, f.txDecoder)
+		if msg == nil { // not an evm tx
+			continue
+		}
+		ethTx, txData := msg.AsTransaction()
+		if _, ok := txData.(*ethtx.SetCodeTx); ok {
+			// go-ethereum transactions can't represent set-code transactions
+			continue
+		}
+		txs = append(txs, ethTx)
+	}
+	return txs, nil
+}
+
+// EncodePendingTx returns the hash of the tx, or the full RPC transaction if fullTx is set
+func (f *PendingTxFetcher) EncodePendingTx(tx *ethtypes.Transaction, fullTx bool) interface{} {
+	if !fullTx {
+		return tx.Hash()
+	}
+	sdkCtx := f.ctxProvider(LatestCtxHeight)
+	chainConfig := types.DefaultChainConfig().EthereumConfig(f.k.ChainID(sdkCtx))
+	return ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+}
+
 type TxPoolAPI struct {
 	tmClient       rpcclient.Client
 	keeper         *keeper.Keeper
@@ -31,15 +83,113 @@ func NewTxPoolAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(
 	return &TxPoolAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, txPoolConfig: txPoolConfig, connectionType: connectionType}
 }
 
-// For now, we put all unconfirmed txs in pending and none in queued
+// Content returns the unconfirmed EVM txs grouped by status, sender and nonce
 func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("kii_content", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.getPoolTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := t.ctxProvider(LatestCtxHeight)
+	chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
 	content := map[string]map[string]map[string]*ethapi.RPCTransaction{
 		"pending": make(map[string]map[string]*ethapi.RPCTransaction),
 		"queued":  make(map[string]map[string]*ethapi.RPCTransaction),
 	}
+	for status, accounts := range pool {
+		for fromAddr, txs := range accounts {
+			dump := make(map[string]*ethapi.RPCTransaction, len(txs))
+			for _, tx := range txs {
+				dump[strconv.FormatUint(tx.Nonce(), 10)] = ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+			}
+			content[status][fromAddr.String()] = dump
+		}
+	}
+	return content, nil
+}
+
+// ContentFrom returns the unconfirmed EVM txs of a single sender grouped by status and nonce
+func (t *TxPoolAPI) ContentFrom(ctx context.Context, addr common.Address) (result map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_contentFrom", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.getPoolTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := t.ctxProvider(LatestCtxHeight)
+	chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
+	content := map[string]map[string]*ethapi.RPCTransaction{
+		"pending": make(map[string]*ethapi.RPCTransaction),
+		"queued":  make(map[string]*ethapi.RPCTransaction),
+	}
+	for status, accounts := range pool {
+		for _, tx := range accounts[addr] {
+			content[status][strconv.FormatUint(tx.Nonce(), 10)] = ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+		}
+	}
+	return content, nil
+}
+
+// Status returns the number of pending and queued EVM txs
+func (t *TxPoolAPI) Status(ctx context.Context) (result map[string]hexutil.Uint, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_status", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.getPoolTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	status := map[string]hexutil.Uint{}
+	for s, accounts := range pool {
+		cnt := 0
+		for _, txs := range accounts {
+			cnt += len(txs)
+		}
+		status[s] = hexutil.Uint(cnt)
+	}
+	return status, nil
+}
+
+// Inspect returns a textual summary of the unconfirmed EVM txs grouped by status, sender and nonce
+func (t *TxPoolAPI) Inspect(ctx context.Context) (result map[string]map[string]map[string]string, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_inspect", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.getPoolTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
+	}
+	// same format as geth
+	format := func(tx *ethtypes.Transaction) string {
+		if to := tx.To(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	for status, accounts := range pool {
+		for fromAddr, txs := range accounts {
+			dump := make(map[string]string, len(txs))
+			for _, tx := range txs {
+				dump[strconv.FormatUint(tx.Nonce(), 10)] = format(tx)
+			}
+			content[status][fromAddr.String()] = dump
+		}
+	}
+	return content, nil
+}
+
+// getPoolTxs classifies the unconfirmed EVM txs as pending or queued for each sender.
+// A tx is pending if there is no nonce gap between the sender's nonce and the tx nonce,
+// accounting for the txs the keeper tracks as pending from CheckTx. Txs with a nonce that
+// is already mined are left out.
+func (t *TxPoolAPI) getPoolTxs(ctx context.Context) (map[string]map[common.Address][]*ethtypes.Transaction, error) {
 	total := t.txPoolConfig.maxNumTxs
 	resUnconfirmedTxs, err := t.tmClient.UnconfirmedTxs(ctx, nil, &total)
 	if err != nil {
@@ -53,27 +203,49 @@ func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]m
 		uint64(sdkCtx.BlockTime().Unix()),
 	)
 
+	bySender := map[common.Address][]*ethtypes.Transaction{}
 	for _, tx := range resUnconfirmedTxs.Txs {
-		ethTx := getEthTxForTxBz(tx, t.txDecoder)
-		if ethTx == nil { // not an evm tx
+		msg := getEvmMsgForTxBz(tx, t.txDecoder)
+		if msg == nil { // not an evm tx
+			continue
+		}
+		ethTx, txData := msg.AsTransaction()
+		if _, ok := txData.(*ethtx.SetCodeTx); ok {
+			// go-ethereum transactions can't represent set-code transactions
 			continue
 		}
 		fromAddr, err := ethtypes.Sender(signer, ethTx)
 		if err != nil {
-			return nil, err
+			sdkCtx.Logger().Error(fmt.Sprintf("getPoolTxs: unable to recover the sender of %s: %s", ethTx.Hash().Hex(), err))
+			continue
 		}
+		bySender[fromAddr] = append(bySender[fromAddr], ethTx)
+	}
 
-		nonce := ethTx.Nonce()
-		chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
-		res := ethapi.NewRPCPendingTransaction(ethTx, nil, chainConfig)
-		nonceStr := strconv.FormatUint(nonce, 10)
-		if content["pending"][fromAddr.String()] == nil {
-			content["pending"][fromAddr.String()] = map[string]*ethapi.RPCTransaction{
-				nonceStr: res,
+	pool := map[string]map[common.Address][]*ethtypes.Transaction{
+		"pending": make(map[common.Address][]*ethtypes.Transaction),
+		"queued":  make(map[common.Address][]*ethtypes.Transaction),
+	}
+	for fromAddr, txs := range bySender {
+		sort.SliceStable(txs, func(i, j int) bool { return txs[i].Nonce() < txs[j].Nonce() })
+		minedNonce := t.keeper.GetNonce(sdkCtx, fromAddr)
+		// first nonce gap among the txs tracked by the keeper
+		nextNonce := t.keeper.CalculateNextNonce(sdkCtx, fromAddr, true)
+		for _, tx := range txs {
+			nonce := tx.Nonce()
+			switch {
+			case nonce < minedNonce:
+				continue
+			case nonce < nextNonce:
+				pool["pending"][fromAddr] = append(pool["pending"][fromAddr], tx)
+			case nonce == nextNonce:
+				// the mempool may hold txs the keeper doesn't track yet
+				nextNonce++
+				pool["pending"][fromAddr] = append(pool["pending"][fromAddr], tx)
+			default:
+				pool["queued"][fromAddr] = append(pool["queued"][fromAddr], tx)
 			}
-		} else {
-			content["pending"][fromAddr.String()][nonceStr] = res
 		}
 	}
-	return content, nil
+	return pool, nil
 }
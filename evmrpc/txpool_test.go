@@ -1,17 +1,32 @@
 package evmrpc_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestTxPoolContent(t *testing.T) {
+	cleanup := fillUnconfirmedTxNonceGap(t)
+	defer cleanup()
+
 	body := "{\"jsonrpc\": \"2.0\",\"method\": \"txpool_content\",\"params\":[],\"id\":\"test\"}"
 	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%d", TestAddr, TestPort), strings.NewReader(body))
 	require.Nil(t, err)
@@ -65,6 +80,124 @@ func TestTxPoolContent(t *testing.T) {
 	require.Equal(t, 0, len(queuedMap))
 }
 
+func TestTxPoolStatus(t *testing.T) {
+	// the mock tx nonce is ahead of the sender's nonce so it's queued
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "status")
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x0", result["pending"])
+	require.Equal(t, "0x1", result["queued"])
+
+	// once the gap is filled by txs tracked by the keeper, it becomes pending
+	cleanup := fillUnconfirmedTxNonceGap(t)
+	defer cleanup()
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "status")
+	result = resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x1", result["pending"])
+	require.Equal(t, "0x0", result["queued"])
+}
+
+func TestTxPoolInspect(t *testing.T) {
+	sender, tx := unconfirmedTxSender(t)
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "inspect")
+	result := resObj["result"].(map[string]interface{})
+	require.Empty(t, result["pending"])
+	queued := result["queued"].(map[string]interface{})
+	require.Len(t, queued, 1)
+	txs := queued[sender.Hex()].(map[string]interface{})
+	require.Equal(
+		t,
+		fmt.Sprintf("%s: 2000 wei + 1000 gas × 10 wei", tx.To().Hex()),
+		txs[fmt.Sprintf("%d", tx.Nonce())],
+	)
+}
+
+func TestTxPoolContentFrom(t *testing.T) {
+	sender, tx := unconfirmedTxSender(t)
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "contentFrom", sender.Hex())
+	result := resObj["result"].(map[string]interface{})
+	require.Empty(t, result["pending"])
+	queued := result["queued"].(map[string]interface{})
+	require.Len(t, queued, 1)
+	queuedTx := queued[fmt.Sprintf("%d", tx.Nonce())].(map[string]interface{})
+	require.Equal(t, tx.Hash().Hex(), queuedTx["hash"])
+	require.Equal(t, sender.Hex(), common.HexToAddress(queuedTx["from"].(string)).Hex())
+
+	// other senders have nothing in the pool
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "contentFrom", "0x1234567890123456789012345678901234567890")
+	result = resObj["result"].(map[string]interface{})
+	require.Empty(t, result["pending"])
+	require.Empty(t, result["queued"])
+}
+
+// badSenderClient adds a tx whose sender can't be recovered to the mock unconfirmed txs
+type badSenderClient struct {
+	MockClient
+	badTx tmtypes.Tx
+}
+
+func (c *badSenderClient) UnconfirmedTxs(ctx context.Context, page, perPage *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	res, err := c.MockClient.UnconfirmedTxs(ctx, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+	res.Txs = append([]tmtypes.Tx{c.badTx}, res.Txs...)
+	return res, nil
+}
+
+func TestTxPoolSkipsUnrecoverableSender(t *testing.T) {
+	// signed for another chain, so the sender can't be recovered
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	badTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(1)), &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       1000,
+		To:        &to,
+	})
+	require.Nil(t, err)
+	typedTx, err := ethtx.NewDynamicFeeTx(badTx)
+	require.Nil(t, err)
+	msg, err := types.NewMsgEVMTransaction(typedTx)
+	require.Nil(t, err)
+	builder := TxConfig.NewTxBuilder()
+	require.Nil(t, builder.SetMsgs(msg))
+	bz, err := Encoder(builder.GetTx())
+	require.Nil(t, err)
+
+	client := &badSenderClient{badTx: bz}
+	api := evmrpc.NewTxPoolAPI(client, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig.TxDecoder(), &evmrpc.TxPoolConfig{}, evmrpc.ConnectionTypeHTTP)
+	status, err := api.Status(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, hexutil.Uint(0), status["pending"])
+	require.Equal(t, hexutil.Uint(1), status["queued"])
+}
+
+// unconfirmedTxSender returns the mock unconfirmed tx and its sender
+func unconfirmedTxSender(t *testing.T) (common.Address, *ethtypes.Transaction) {
+	tx, _ := UnconfirmedTx.GetMsgs()[0].(*types.MsgEVMTransaction).AsTransaction()
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	require.Nil(t, err)
+	return sender, tx
+}
+
+// fillUnconfirmedTxNonceGap tracks pending txs on the keeper for the nonces between
+// the sender's nonce and the mock unconfirmed tx nonce
+func fillUnconfirmedTxNonceGap(t *testing.T) func() {
+	sender, tx := unconfirmedTxSender(t)
+	keys := []tmtypes.TxKey{}
+	for nonce := EVMKeeper.GetNonce(Ctx, sender); nonce < tx.Nonce(); nonce++ {
+		key := tmtypes.Tx(fmt.Sprintf("txpool-test-%d", nonce)).Key()
+		EVMKeeper.AddPendingNonce(key, sender, nonce, 0)
+		keys = append(keys, key)
+	}
+	return func() {
+		for _, key := range keys {
+			EVMKeeper.RemovePendingNonce(key)
+		}
+	}
+}
+
 func requireNotZeroHex(t *testing.T, hexStr string) {
 	if strings.HasPrefix(hexStr, "0x") {
 		hexStr = hexStr[2:]
@@ -0,0 +1,265 @@
+package evmrpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain/x/evm/artifacts/entrypoint"
+)
+
+// error codes of the ERC-4337 bundler RPC methods
+const (
+	UserOpInvalidFieldsErrorCode        = -32602
+	UserOpRejectedByEntryPointErrorCode = -32500
+	UserOpRejectedByPaymasterErrorCode  = -32501
+	UserOpEntityThrottledErrorCode      = -32504
+	UserOpInvalidSignatureErrorCode     = -32507
+)
+
+// calldata cost of a UserOperation, mirroring the reference bundler's preVerificationGas calculation
+const (
+	userOpBundleOverhead = 21000
+	userOpPerOpOverhead  = 18300
+	userOpPerWordCost    = 4
+	userOpZeroByteCost   = 4
+	userOpNonZeroCost    = 16
+	userOpDummySigSize   = 65
+)
+
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// UserOperation is an ERC-4337 v0.7 user operation as sent over RPC, with the factory and
+// paymaster fields unpacked
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// PackedUserOperation is the encoding of a UserOperation the EntryPoint takes
+type PackedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+func bigOrZero(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
+
+// packUint128s packs two 128-bit values into one word, high first
+func packUint128s(high *big.Int, low *big.Int) (res [32]byte) {
+	high.FillBytes(res[:16])
+	low.FillBytes(res[16:])
+	return
+}
+
+func (op *UserOperation) FactoryAddress() common.Address {
+	if op.Factory == nil {
+		return common.Address{}
+	}
+	return *op.Factory
+}
+
+func (op *UserOperation) PaymasterAddress() common.Address {
+	if op.Paymaster == nil {
+		return common.Address{}
+	}
+	return *op.Paymaster
+}
+
+func (op *UserOperation) InitCode() []byte {
+	if op.Factory == nil {
+		return []byte{}
+	}
+	return append(op.Factory.Bytes(), op.FactoryData...)
+}
+
+func (op *UserOperation) PaymasterAndData() []byte {
+	if op.Paymaster == nil {
+		return []byte{}
+	}
+	gasLimits := packUint128s(bigOrZero(op.PaymasterVerificationGasLimit), bigOrZero(op.PaymasterPostOpGasLimit))
+	res := append(op.Paymaster.Bytes(), gasLimits[:]...)
+	return append(res, op.PaymasterData...)
+}
+
+func (op *UserOperation) Pack() PackedUserOperation {
+	return PackedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              bigOrZero(op.Nonce),
+		InitCode:           op.InitCode(),
+		CallData:           op.CallData,
+		AccountGasLimits:   packUint128s(bigOrZero(op.VerificationGasLimit), bigOrZero(op.CallGasLimit)),
+		PreVerificationGas: bigOrZero(op.PreVerificationGas),
+		GasFees:            packUint128s(bigOrZero(op.MaxPriorityFeePerGas), bigOrZero(op.MaxFeePerGas)),
+		PaymasterAndData:   op.PaymasterAndData(),
+		Signature:          op.Signature,
+	}
+}
+
+// Hash is the hash the account signs, which is what EntryPoint.getUserOpHash returns
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := op.Pack()
+	bytes32Ty, _ := abi.NewType("bytes32", "", nil)
+	uint256Ty, _ := abi.NewType("uint256", "", nil)
+	addressTy, _ := abi.NewType("address", "", nil)
+	inner, err := abi.Arguments{
+		{Type: addressTy}, {Type: uint256Ty}, {Type: bytes32Ty}, {Type: bytes32Ty},
+		{Type: bytes32Ty}, {Type: uint256Ty}, {Type: bytes32Ty}, {Type: bytes32Ty},
+	}.Pack(
+		packed.Sender, packed.Nonce, crypto.Keccak256Hash(packed.InitCode), crypto.Keccak256Hash(packed.CallData),
+		packed.AccountGasLimits, packed.PreVerificationGas, packed.GasFees, crypto.Keccak256Hash(packed.PaymasterAndData),
+	)
+	if err != nil {
+		// only fails if a value doesn't fit its type, which Validate rules out
+		panic(err)
+	}
+	outer, err := abi.Arguments{{Type: bytes32Ty}, {Type: addressTy}, {Type: uint256Ty}}.Pack(crypto.Keccak256Hash(inner), entryPoint, chainID)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(outer)
+}
+
+// Validate checks the fields of the UserOperation without looking at the chain
+func (op *UserOperation) Validate() error {
+	if op.Sender == (common.Address{}) {
+		return errors.New("sender is required")
+	}
+	if op.Nonce == nil {
+		return errors.New("nonce is required")
+	}
+	gasFields := map[string]*hexutil.Big{
+		"callGasLimit":         op.CallGasLimit,
+		"verificationGasLimit": op.VerificationGasLimit,
+		"preVerificationGas":   op.PreVerificationGas,
+		"maxFeePerGas":         op.MaxFeePerGas,
+		"maxPriorityFeePerGas": op.MaxPriorityFeePerGas,
+	}
+	if op.Paymaster != nil {
+		gasFields["paymasterVerificationGasLimit"] = op.PaymasterVerificationGasLimit
+		gasFields["paymasterPostOpGasLimit"] = op.PaymasterPostOpGasLimit
+	} else if op.PaymasterVerificationGasLimit != nil || op.PaymasterPostOpGasLimit != nil || len(op.PaymasterData) > 0 {
+		return errors.New("paymaster fields are set without a paymaster")
+	}
+	for name, value := range gasFields {
+		if value == nil {
+			return fmt.Errorf("%s is required", name)
+		}
+		if value.ToInt().Sign() < 0 || value.ToInt().Cmp(maxUint128) > 0 {
+			return fmt.Errorf("%s doesn't fit in 128 bits", name)
+		}
+	}
+	if op.Factory == nil && len(op.FactoryData) > 0 {
+		return errors.New("factoryData is set without a factory")
+	}
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return errors.New("maxPriorityFeePerGas is higher than maxFeePerGas")
+	}
+	if minimum := op.MinPreVerificationGas(); op.PreVerificationGas.ToInt().Cmp(new(big.Int).SetUint64(minimum)) < 0 {
+		return fmt.Errorf("preVerificationGas is below the minimum of %d", minimum)
+	}
+	return nil
+}
+
+// MinPreVerificationGas is the share of the bundle transaction's gas the EntryPoint can't meter
+// for this UserOperation, i.e. its calldata and the per-operation overhead. Gas and fee fields
+// are priced as if they took 8 bytes each, so that the result doesn't change once they are
+// filled in after estimation.
+func (op *UserOperation) MinPreVerificationGas() uint64 {
+	placeholder := (*hexutil.Big)(new(big.Int).SetUint64(math.MaxUint64))
+	priced := *op
+	priced.CallGasLimit, priced.VerificationGasLimit, priced.PreVerificationGas = placeholder, placeholder, placeholder
+	priced.MaxFeePerGas, priced.MaxPriorityFeePerGas = placeholder, placeholder
+	if op.Paymaster != nil {
+		priced.PaymasterVerificationGasLimit, priced.PaymasterPostOpGasLimit = placeholder, placeholder
+	}
+	packed := priced.Pack()
+	if len(packed.Signature) == 0 {
+		packed.Signature = make([]byte, userOpDummySigSize)
+	}
+	encoded, err := entrypoint.GetParsedABI().Methods["getUserOpHash"].Inputs.Pack(packed)
+	if err != nil {
+		return 0
+	}
+	gas := uint64(userOpBundleOverhead + userOpPerOpOverhead)
+	for _, b := range encoded {
+		if b == 0 {
+			gas += userOpZeroByteCost
+		} else {
+			gas += userOpNonZeroCost
+		}
+	}
+	return gas + userOpPerWordCost*uint64((len(encoded)+31)/32)
+}
+
+// userOpFailure is the reason the EntryPoint rejected a UserOperation of a bundle
+type userOpFailure struct {
+	opIndex int
+	reason  string
+}
+
+// parseFailedOp decodes the FailedOp and FailedOpWithRevert errors of handleOps
+func parseFailedOp(revertData []byte) (*userOpFailure, bool) {
+	epABI := entrypoint.GetParsedABI()
+	for _, name := range []string{"FailedOp", "FailedOpWithRevert"} {
+		abiErr := epABI.Errors[name]
+		if len(revertData) < 4 || !bytes.Equal(revertData[:4], abiErr.ID[:4]) {
+			continue
+		}
+		values, err := abiErr.Inputs.Unpack(revertData[4:])
+		if err != nil || len(values) < 2 {
+			return nil, false
+		}
+		opIndex, ok := values[0].(*big.Int)
+		if !ok || !opIndex.IsInt64() {
+			return nil, false
+		}
+		reason, _ := values[1].(string)
+		return &userOpFailure{opIndex: int(opIndex.Int64()), reason: reason}, true
+	}
+	return nil, false
+}
+
+// userOpError converts an EntryPoint rejection into the ERC-4337 error for it. Reasons are
+// prefixed with AA1 for factory, AA2 for account and AA3 for paymaster failures.
+func userOpError(reason string) *SendError {
+	code := UserOpRejectedByEntryPointErrorCode
+	switch {
+	case strings.HasPrefix(reason, "AA24"):
+		code = UserOpInvalidSignatureErrorCode
+	case strings.HasPrefix(reason, "AA3"):
+		code = UserOpRejectedByPaymasterErrorCode
+	}
+	return &SendError{code: code, msg: reason}
+}
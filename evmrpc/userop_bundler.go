@@ -0,0 +1,421 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/kiichain/kiichain/x/evm/artifacts/entrypoint"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// BundleInclusionTimeout is how long the bundler waits for a bundle transaction to be
+	// included before putting its UserOperations back into the pool
+	BundleInclusionTimeout = time.Minute
+
+	// SubmittedUserOpRetention is how long bundled UserOperations can be looked up by hash
+	SubmittedUserOpRetention = time.Hour
+)
+
+// BundlerConfig configures the ERC-4337 bundler of the node
+type BundlerConfig struct {
+	// Address signs the bundle transactions and receives the fees of the bundled UserOperations.
+	// Its key must be in the keyring of the node.
+	Address      common.Address
+	EntryPoints  []common.Address
+	Interval     time.Duration
+	MaxBundleOps int
+}
+
+type submittedUserOp struct {
+	pooled      *PooledUserOp
+	txHash      common.Hash
+	submittedAt time.Time
+}
+
+type pendingBundle struct {
+	txHash     common.Hash
+	entryPoint common.Address
+	ops        []*PooledUserOp
+	sentAt     time.Time
+}
+
+// Bundler validates UserOperations by simulating them against the EntryPoint, keeps the valid
+// ones in a UserOpPool and periodically bundles them into a handleOps transaction it signs with
+// a key of the node. Only one bundle is in flight at a time so that its nonce is always known.
+type Bundler struct {
+	config        *BundlerConfig
+	pool          *UserOpPool
+	sendAPI       *SendAPI
+	simulationAPI *SimulationAPI
+	logger        log.Logger
+
+	bundleMtx     sync.Mutex
+	pendingBundle *pendingBundle
+
+	mtx       sync.RWMutex
+	submitted map[common.Hash]*submittedUserOp
+}
+
+func NewBundler(logger log.Logger, config *BundlerConfig, sendAPI *SendAPI, simulationAPI *SimulationAPI) (*Bundler, error) {
+	if len(config.EntryPoints) == 0 {
+		return nil, errors.New("bundler needs at least one entry point")
+	}
+	if config.Interval <= 0 {
+		return nil, errors.New("bundler interval must be positive")
+	}
+	if config.MaxBundleOps <= 0 {
+		return nil, errors.New("bundler max ops per bundle must be positive")
+	}
+	if _, err := sendAPI.hostedKey(config.Address.Hex()); err != nil {
+		return nil, fmt.Errorf("bundler address %s: %w", config.Address.Hex(), err)
+	}
+	return &Bundler{
+		config:        config,
+		pool:          NewUserOpPool(),
+		sendAPI:       sendAPI,
+		simulationAPI: simulationAPI,
+		logger:        logger,
+		submitted:     map[common.Hash]*submittedUserOp{},
+	}, nil
+}
+
+func (b *Bundler) Pool() *UserOpPool {
+	return b.pool
+}
+
+// Start bundles pooled UserOperations in the background
+func (b *Bundler) Start() {
+	go func() {
+		ticker := time.NewTicker(b.config.Interval)
+		defer ticker.Stop()
+		for {
+			<-ticker.C
+			if err := b.Bundle(context.Background()); err != nil {
+				b.logger.Error(fmt.Sprintf("failed to bundle user operations: %s", err))
+			}
+		}
+	}()
+}
+
+func (b *Bundler) supportsEntryPoint(entryPoint common.Address) bool {
+	for _, supported := range b.config.EntryPoints {
+		if supported == entryPoint {
+			return true
+		}
+	}
+	return false
+}
+
+// minFeePerGas is the lowest maxFeePerGas a bundle transaction can currently be sent with
+func (b *Bundler) minFeePerGas() *big.Int {
+	sdkCtx := b.sendAPI.ctxProvider(LatestCtxHeight)
+	baseFee := b.sendAPI.keeper.GetDynamicBaseFeePerGas(sdkCtx).TruncateInt().BigInt()
+	minimumFee := b.sendAPI.keeper.GetMinimumFeePerGas(sdkCtx).TruncateInt().BigInt()
+	if baseFee.Cmp(minimumFee) < 0 {
+		return minimumFee
+	}
+	return baseFee
+}
+
+func (b *Bundler) chainID() *big.Int {
+	return b.sendAPI.keeper.ChainID(b.sendAPI.ctxProvider(LatestCtxHeight))
+}
+
+// AddUserOp validates the UserOperation and puts it into the pool
+func (b *Bundler) AddUserOp(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	if !b.supportsEntryPoint(entryPoint) {
+		return common.Hash{}, &SendError{code: UserOpInvalidFieldsErrorCode, msg: fmt.Sprintf("unsupported entry point %s", entryPoint.Hex())}
+	}
+	if err := op.Validate(); err != nil {
+		return common.Hash{}, &SendError{code: UserOpInvalidFieldsErrorCode, msg: err.Error()}
+	}
+	if minFee := b.minFeePerGas(); op.MaxFeePerGas.ToInt().Cmp(minFee) < 0 {
+		return common.Hash{}, &SendError{code: UserOpInvalidFieldsErrorCode, msg: fmt.Sprintf("maxFeePerGas is below the current base fee of %s", minFee)}
+	}
+	if err := b.checkDeployments(op, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	if err := b.pool.CheckEntities(op); err != nil {
+		return common.Hash{}, err
+	}
+	failure, err := b.simulateHandleOps(ctx, entryPoint, []*UserOperation{op})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if failure != nil {
+		return common.Hash{}, userOpError(failure.reason)
+	}
+	hash := op.Hash(entryPoint, b.chainID())
+	if err := b.pool.Add(&PooledUserOp{UserOp: op, EntryPoint: entryPoint, Hash: hash, AddedAt: time.Now()}); err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// checkDeployments checks that the EntryPoint is deployed and that a factory is given if and
+// only if the sender still has to be deployed
+func (b *Bundler) checkDeployments(op *UserOperation, entryPoint common.Address) error {
+	sdkCtx := b.sendAPI.ctxProvider(LatestCtxHeight)
+	if b.sendAPI.keeper.GetCodeSize(sdkCtx, entryPoint) == 0 {
+		return &SendError{code: UserOpInvalidFieldsErrorCode, msg: fmt.Sprintf("entry point %s isn't deployed", entryPoint.Hex())}
+	}
+	senderDeployed := b.sendAPI.keeper.GetCodeSize(sdkCtx, op.Sender) > 0
+	if !senderDeployed && op.Factory == nil {
+		return &SendError{code: UserOpInvalidFieldsErrorCode, msg: "sender isn't deployed and no factory is given"}
+	}
+	if senderDeployed && op.Factory != nil {
+		return &SendError{code: UserOpInvalidFieldsErrorCode, msg: "factory is given for a deployed sender"}
+	}
+	return nil
+}
+
+func (b *Bundler) handleOpsArgs(entryPoint common.Address, ops []*UserOperation) (ethapi.TransactionArgs, error) {
+	packed := make([]PackedUserOperation, len(ops))
+	for i, op := range ops {
+		packed[i] = op.Pack()
+	}
+	data, err := entrypoint.GetParsedABI().Pack("handleOps", packed, b.config.Address)
+	if err != nil {
+		return ethapi.TransactionArgs{}, err
+	}
+	input := hexutil.Bytes(data)
+	return ethapi.TransactionArgs{From: &b.config.Address, To: &entryPoint, Input: &input}, nil
+}
+
+// simulateHandleOps runs handleOps with the UserOperations on the latest state and returns the
+// first operation the EntryPoint rejected, if any
+func (b *Bundler) simulateHandleOps(ctx context.Context, entryPoint common.Address, ops []*UserOperation) (*userOpFailure, error) {
+	args, err := b.handleOpsArgs(entryPoint, ops)
+	if err != nil {
+		return nil, err
+	}
+	_, err = b.simulationAPI.Call(ctx, args, nil, nil, nil)
+	if err == nil {
+		return nil, nil
+	}
+	var revertErr *RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, err
+	}
+	revertData, decodeErr := hexutil.Decode(revertErr.reason)
+	if decodeErr != nil {
+		return nil, err
+	}
+	failure, ok := parseFailedOp(revertData)
+	if !ok || failure.opIndex < 0 || failure.opIndex >= len(ops) {
+		return nil, err
+	}
+	return failure, nil
+}
+
+// Bundle sends the next bundle once the previous one is included. UserOperations that fail when
+// simulated together are dropped, and their factory or paymaster is penalized if it caused the
+// failure.
+func (b *Bundler) Bundle(ctx context.Context) error {
+	b.bundleMtx.Lock()
+	defer b.bundleMtx.Unlock()
+	now := time.Now()
+	b.pool.DecayReputation(now)
+	b.pruneSubmitted(now)
+	if b.pendingBundle != nil {
+		done, err := b.checkPendingBundle(now)
+		if err != nil || !done {
+			return err
+		}
+	}
+	for _, entryPoint := range b.config.EntryPoints {
+		ops, err := b.dropFailingOps(ctx, entryPoint, b.selectBundle(entryPoint))
+		if err != nil {
+			return err
+		}
+		if len(ops) > 0 {
+			return b.submitBundle(ctx, entryPoint, ops)
+		}
+	}
+	return nil
+}
+
+// selectBundle picks the pooled UserOperations that pay enough for the current base fee, highest
+// priority fee first. Operations of the same sender must be executed in nonce order, so only the
+// lowest nonce of each sender is picked.
+func (b *Bundler) selectBundle(entryPoint common.Address) []*PooledUserOp {
+	pending := b.pool.Pending(entryPoint)
+	lowestNonce := map[common.Address]*PooledUserOp{}
+	for _, pooled := range pending {
+		if lowest, ok := lowestNonce[pooled.UserOp.Sender]; !ok || pooled.UserOp.Nonce.ToInt().Cmp(lowest.UserOp.Nonce.ToInt()) < 0 {
+			lowestNonce[pooled.UserOp.Sender] = pooled
+		}
+	}
+	minFee := b.minFeePerGas()
+	gasCap := new(big.Int).SetUint64(b.simulationAPI.backend.RPCGasCap())
+	totalGas := new(big.Int)
+	res := []*PooledUserOp{}
+	for _, pooled := range pending {
+		if len(res) >= b.config.MaxBundleOps {
+			break
+		}
+		op := pooled.UserOp
+		if lowestNonce[op.Sender] != pooled || op.MaxFeePerGas.ToInt().Cmp(minFee) < 0 {
+			continue
+		}
+		opGas := new(big.Int).Add(op.VerificationGasLimit.ToInt(), op.CallGasLimit.ToInt())
+		opGas.Add(opGas, op.PreVerificationGas.ToInt())
+		opGas.Add(opGas, bigOrZero(op.PaymasterVerificationGasLimit))
+		opGas.Add(opGas, bigOrZero(op.PaymasterPostOpGasLimit))
+		if new(big.Int).Add(totalGas, opGas).Cmp(gasCap) > 0 {
+			continue
+		}
+		totalGas.Add(totalGas, opGas)
+		res = append(res, pooled)
+	}
+	return res
+}
+
+func (b *Bundler) dropFailingOps(ctx context.Context, entryPoint common.Address, ops []*PooledUserOp) ([]*PooledUserOp, error) {
+	for len(ops) > 0 {
+		userOps := make([]*UserOperation, len(ops))
+		for i, pooled := range ops {
+			userOps[i] = pooled.UserOp
+		}
+		failure, err := b.simulateHandleOps(ctx, entryPoint, userOps)
+		if err != nil {
+			return nil, err
+		}
+		if failure == nil {
+			return ops, nil
+		}
+		failed := ops[failure.opIndex]
+		b.logger.Info(fmt.Sprintf("dropping user operation %s: %s", failed.Hash.Hex(), failure.reason))
+		b.pool.Remove(failed.Hash)
+		switch {
+		case strings.HasPrefix(failure.reason, "AA1") && failed.UserOp.Factory != nil:
+			b.pool.Penalize(*failed.UserOp.Factory)
+		case (strings.HasPrefix(failure.reason, "AA33") || strings.HasPrefix(failure.reason, "AA34")) && failed.UserOp.Paymaster != nil:
+			b.pool.Penalize(*failed.UserOp.Paymaster)
+		}
+		ops = append(ops[:failure.opIndex:failure.opIndex], ops[failure.opIndex+1:]...)
+	}
+	return ops, nil
+}
+
+// submitBundle signs and broadcasts a handleOps transaction with the UserOperations. The bundle
+// pays the lowest fees all of its operations agree to.
+func (b *Bundler) submitBundle(ctx context.Context, entryPoint common.Address, ops []*PooledUserOp) error {
+	userOps := make([]*UserOperation, len(ops))
+	gasFeeCap := ops[0].UserOp.MaxFeePerGas.ToInt()
+	gasTipCap := ops[0].UserOp.MaxPriorityFeePerGas.ToInt()
+	for i, pooled := range ops {
+		userOps[i] = pooled.UserOp
+		if pooled.UserOp.MaxFeePerGas.ToInt().Cmp(gasFeeCap) < 0 {
+			gasFeeCap = pooled.UserOp.MaxFeePerGas.ToInt()
+		}
+		if pooled.UserOp.MaxPriorityFeePerGas.ToInt().Cmp(gasTipCap) < 0 {
+			gasTipCap = pooled.UserOp.MaxPriorityFeePerGas.ToInt()
+		}
+	}
+	args, err := b.handleOpsArgs(entryPoint, userOps)
+	if err != nil {
+		return err
+	}
+	gas, err := b.simulationAPI.EstimateGas(ctx, args, nil, nil)
+	if err != nil {
+		return err
+	}
+	sdkCtx := b.sendAPI.ctxProvider(LatestCtxHeight)
+	unsignedTx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   b.chainID(),
+		Nonce:     b.sendAPI.keeper.GetNonce(sdkCtx, b.config.Address),
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       uint64(gas),
+		To:        &entryPoint,
+		Data:      *args.Input,
+	})
+	signedTx, err := b.sendAPI.signTransaction(unsignedTx, b.config.Address.Hex())
+	if err != nil {
+		return err
+	}
+	bz, err := signedTx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	txHash, err := b.sendAPI.SendRawTransaction(ctx, bz)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for _, pooled := range ops {
+		b.pool.Remove(pooled.Hash)
+		b.submitted[pooled.Hash] = &submittedUserOp{pooled: pooled, txHash: txHash, submittedAt: now}
+	}
+	b.pendingBundle = &pendingBundle{txHash: txHash, entryPoint: entryPoint, ops: ops, sentAt: now}
+	return nil
+}
+
+// checkPendingBundle returns whether the in-flight bundle is done, i.e. included or timed out.
+// UserOperations the bundle didn't execute go back into the pool.
+func (b *Bundler) checkPendingBundle(now time.Time) (bool, error) {
+	bundle := b.pendingBundle
+	receipt, err := b.sendAPI.keeper.GetReceipt(b.sendAPI.ctxProvider(LatestCtxHeight), bundle.txHash)
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return false, err
+	}
+	if err != nil && now.Sub(bundle.sentAt) < BundleInclusionTimeout {
+		return false, nil
+	}
+	executed := map[common.Hash]bool{}
+	if err == nil {
+		for _, event := range findUserOpEvents(keeper.GetLogsForTx(receipt), bundle.entryPoint) {
+			executed[event.userOpHash] = true
+		}
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for _, pooled := range bundle.ops {
+		if executed[pooled.Hash] {
+			b.pool.Included(pooled.UserOp)
+			continue
+		}
+		delete(b.submitted, pooled.Hash)
+		b.pool.Restore([]*PooledUserOp{pooled})
+	}
+	b.pendingBundle = nil
+	return true, nil
+}
+
+func (b *Bundler) pruneSubmitted(now time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for hash, submitted := range b.submitted {
+		if now.Sub(submitted.submittedAt) > SubmittedUserOpRetention {
+			delete(b.submitted, hash)
+		}
+	}
+}
+
+// Lookup returns a pooled or bundled UserOperation along with the hash of the transaction that
+// bundled it, which is zero while the operation is pooled
+func (b *Bundler) Lookup(hash common.Hash) (*PooledUserOp, common.Hash) {
+	if pooled := b.pool.Get(hash); pooled != nil {
+		return pooled, common.Hash{}
+	}
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	if submitted, ok := b.submitted[hash]; ok {
+		return submitted.pooled, submitted.txHash
+	}
+	return nil, common.Hash{}
+}
@@ -0,0 +1,289 @@
+package evmrpc
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// reputation and mempool limits of the UserOperation pool, following ERC-7562
+const (
+	MinInclusionDenominator     = 10
+	ThrottlingSlack             = 10
+	BanSlack                    = 50
+	ThrottledEntityMempoolCount = 4
+	MaxUserOpsPerSender         = 4
+	MaxPooledUserOps            = 4096
+
+	// ReputationDecayInterval is how often the seen and included counters of entities decay
+	ReputationDecayInterval = time.Hour
+
+	// replacing a UserOperation requires raising both of its fees by at least 10%
+	userOpReplacementFeeBumpPercent = 110
+)
+
+type ReputationStatus int
+
+const (
+	ReputationOK ReputationStatus = iota
+	ReputationThrottled
+	ReputationBanned
+)
+
+func (s ReputationStatus) String() string {
+	switch s {
+	case ReputationThrottled:
+		return "throttled"
+	case ReputationBanned:
+		return "banned"
+	default:
+		return "ok"
+	}
+}
+
+type reputationEntry struct {
+	opsSeen     uint64
+	opsIncluded uint64
+}
+
+// PooledUserOp is a UserOperation that passed validation and waits to be bundled
+type PooledUserOp struct {
+	UserOp     *UserOperation
+	EntryPoint common.Address
+	Hash       common.Hash
+	AddedAt    time.Time
+}
+
+// UserOpPool holds the UserOperations waiting to be bundled along with the reputation of the
+// factories and paymasters they use. Entities whose operations are seen much more often than
+// they get included are throttled and eventually banned. The pool only lives in memory.
+type UserOpPool struct {
+	mtx        sync.Mutex
+	ops        map[common.Hash]*PooledUserOp
+	reputation map[common.Address]*reputationEntry
+	lastDecay  time.Time
+}
+
+func NewUserOpPool() *UserOpPool {
+	return &UserOpPool{
+		ops:        map[common.Hash]*PooledUserOp{},
+		reputation: map[common.Address]*reputationEntry{},
+		lastDecay:  time.Now(),
+	}
+}
+
+// entities returns the factory and paymaster of the UserOperation, which are the entities
+// reputation is tracked for
+func entities(op *UserOperation) []common.Address {
+	res := []common.Address{}
+	if op.Factory != nil {
+		res = append(res, *op.Factory)
+	}
+	if op.Paymaster != nil {
+		res = append(res, *op.Paymaster)
+	}
+	return res
+}
+
+func (p *UserOpPool) Reputation(entity common.Address) ReputationStatus {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.reputationStatus(entity)
+}
+
+func (p *UserOpPool) reputationStatus(entity common.Address) ReputationStatus {
+	entry, ok := p.reputation[entity]
+	if !ok {
+		return ReputationOK
+	}
+	maxSeen := entry.opsSeen / MinInclusionDenominator
+	switch {
+	case maxSeen <= entry.opsIncluded+ThrottlingSlack:
+		return ReputationOK
+	case maxSeen <= entry.opsIncluded+BanSlack:
+		return ReputationThrottled
+	default:
+		return ReputationBanned
+	}
+}
+
+func (p *UserOpPool) entry(entity common.Address) *reputationEntry {
+	entry, ok := p.reputation[entity]
+	if !ok {
+		entry = &reputationEntry{}
+		p.reputation[entity] = entry
+	}
+	return entry
+}
+
+// CheckEntities returns an error if an entity of the UserOperation is banned or throttled with
+// too many operations in the pool already
+func (p *UserOpPool) CheckEntities(op *UserOperation) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.checkEntities(op)
+}
+
+func (p *UserOpPool) checkEntities(op *UserOperation) error {
+	for _, entity := range entities(op) {
+		switch p.reputationStatus(entity) {
+		case ReputationBanned:
+			return &SendError{code: UserOpEntityThrottledErrorCode, msg: fmt.Sprintf("entity %s is banned", entity.Hex()), data: map[string]interface{}{"entity": entity}}
+		case ReputationThrottled:
+			if p.countForEntity(entity) >= ThrottledEntityMempoolCount {
+				return &SendError{code: UserOpEntityThrottledErrorCode, msg: fmt.Sprintf("entity %s is throttled", entity.Hex()), data: map[string]interface{}{"entity": entity}}
+			}
+		}
+	}
+	return nil
+}
+
+func (p *UserOpPool) countForEntity(entity common.Address) int {
+	count := 0
+	for _, pooled := range p.ops {
+		for _, e := range entities(pooled.UserOp) {
+			if e == entity {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// Add puts a validated UserOperation into the pool. An operation with the same sender and nonce
+// as a pooled one replaces it if it raises both fees enough.
+func (p *UserOpPool) Add(pooled *PooledUserOp) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if _, ok := p.ops[pooled.Hash]; ok {
+		return &SendError{code: UserOpInvalidFieldsErrorCode, msg: "user operation already known"}
+	}
+	if err := p.checkEntities(pooled.UserOp); err != nil {
+		return err
+	}
+	senderCount := 0
+	var replaced *PooledUserOp
+	for _, existing := range p.ops {
+		if existing.UserOp.Sender != pooled.UserOp.Sender || existing.EntryPoint != pooled.EntryPoint {
+			continue
+		}
+		if existing.UserOp.Nonce.ToInt().Cmp(pooled.UserOp.Nonce.ToInt()) == 0 {
+			replaced = existing
+		}
+		senderCount++
+	}
+	if replaced != nil {
+		if !feeBumped(replaced.UserOp.MaxFeePerGas, pooled.UserOp.MaxFeePerGas) || !feeBumped(replaced.UserOp.MaxPriorityFeePerGas, pooled.UserOp.MaxPriorityFeePerGas) {
+			return &SendError{code: UserOpInvalidFieldsErrorCode, msg: "replacement user operation must raise maxFeePerGas and maxPriorityFeePerGas by at least 10%"}
+		}
+		delete(p.ops, replaced.Hash)
+	} else if senderCount >= MaxUserOpsPerSender {
+		return &SendError{code: UserOpEntityThrottledErrorCode, msg: fmt.Sprintf("sender %s has too many user operations in the pool", pooled.UserOp.Sender.Hex())}
+	} else if len(p.ops) >= MaxPooledUserOps {
+		return &SendError{code: UserOpEntityThrottledErrorCode, msg: "user operation pool is full"}
+	}
+	p.ops[pooled.Hash] = pooled
+	for _, entity := range entities(pooled.UserOp) {
+		p.entry(entity).opsSeen++
+	}
+	return nil
+}
+
+func feeBumped(oldFee *hexutil.Big, newFee *hexutil.Big) bool {
+	minimum := new(big.Int).Mul(oldFee.ToInt(), big.NewInt(userOpReplacementFeeBumpPercent))
+	return new(big.Int).Mul(newFee.ToInt(), big.NewInt(100)).Cmp(minimum) >= 0
+}
+
+func (p *UserOpPool) Get(hash common.Hash) *PooledUserOp {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.ops[hash]
+}
+
+func (p *UserOpPool) Remove(hash common.Hash) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	delete(p.ops, hash)
+}
+
+// Restore puts back UserOperations whose bundle wasn't included, without counting them as seen
+// again
+func (p *UserOpPool) Restore(pooled []*PooledUserOp) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, op := range pooled {
+		p.ops[op.Hash] = op
+	}
+}
+
+// Pending returns the pooled UserOperations of an EntryPoint, highest priority fee first
+func (p *UserOpPool) Pending(entryPoint common.Address) []*PooledUserOp {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	res := []*PooledUserOp{}
+	for _, op := range p.ops {
+		if op.EntryPoint == entryPoint {
+			res = append(res, op)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if c := res[i].UserOp.MaxPriorityFeePerGas.ToInt().Cmp(res[j].UserOp.MaxPriorityFeePerGas.ToInt()); c != 0 {
+			return c > 0
+		}
+		if !res[i].AddedAt.Equal(res[j].AddedAt) {
+			return res[i].AddedAt.Before(res[j].AddedAt)
+		}
+		return res[i].UserOp.Nonce.ToInt().Cmp(res[j].UserOp.Nonce.ToInt()) < 0
+	})
+	return res
+}
+
+// Included records that the UserOperation made it on-chain, which improves the reputation of
+// its entities
+func (p *UserOpPool) Included(op *UserOperation) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, entity := range entities(op) {
+		p.entry(entity).opsIncluded++
+	}
+}
+
+// Penalize bans an entity whose operation failed after it passed validation, since that means
+// it behaved differently on-chain than when it was simulated
+func (p *UserOpPool) Penalize(entity common.Address) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	entry := p.entry(entity)
+	entry.opsSeen = (entry.opsIncluded + BanSlack + 1) * MinInclusionDenominator
+	for hash, pooled := range p.ops {
+		for _, e := range entities(pooled.UserOp) {
+			if e == entity {
+				delete(p.ops, hash)
+				break
+			}
+		}
+	}
+}
+
+// DecayReputation scales the seen and included counters of every entity by 23/24 for each
+// elapsed decay interval, so that reputation reflects roughly the last day
+func (p *UserOpPool) DecayReputation(now time.Time) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for now.Sub(p.lastDecay) >= ReputationDecayInterval {
+		p.lastDecay = p.lastDecay.Add(ReputationDecayInterval)
+		for entity, entry := range p.reputation {
+			entry.opsSeen = entry.opsSeen * 23 / 24
+			entry.opsIncluded = entry.opsIncluded * 23 / 24
+			if entry.opsSeen == 0 && entry.opsIncluded == 0 {
+				delete(p.reputation, entity)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package evmrpc_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/kiichain/kiichain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func pooledUserOp(sender common.Address, nonce int64, priorityFee int64, paymaster *common.Address) *evmrpc.PooledUserOp {
+	op := &evmrpc.UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(priorityFee * 2)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(priorityFee)),
+		Paymaster:            paymaster,
+	}
+	hash := common.BigToHash(new(big.Int).Add(new(big.Int).SetBytes(sender.Bytes()), big.NewInt(nonce*1000+priorityFee)))
+	return &evmrpc.PooledUserOp{UserOp: op, EntryPoint: common.HexToAddress("0x1"), Hash: hash, AddedAt: time.Now()}
+}
+
+func TestUserOpPool(t *testing.T) {
+	pool := evmrpc.NewUserOpPool()
+	sender := common.HexToAddress("0x100")
+	first := pooledUserOp(sender, 0, 10, nil)
+	require.Nil(t, pool.Add(first))
+	require.NotNil(t, pool.Add(first))
+	require.Equal(t, first, pool.Get(first.Hash))
+
+	// replacing requires bumping both fees by 10%
+	require.NotNil(t, pool.Add(pooledUserOp(sender, 0, 10, nil)))
+	replacement := pooledUserOp(sender, 0, 11, nil)
+	require.Nil(t, pool.Add(replacement))
+	require.Nil(t, pool.Get(first.Hash))
+	require.Equal(t, replacement, pool.Get(replacement.Hash))
+
+	for nonce := int64(1); nonce < evmrpc.MaxUserOpsPerSender; nonce++ {
+		require.Nil(t, pool.Add(pooledUserOp(sender, nonce, 10, nil)))
+	}
+	require.NotNil(t, pool.Add(pooledUserOp(sender, evmrpc.MaxUserOpsPerSender, 10, nil)))
+
+	other := pooledUserOp(common.HexToAddress("0x200"), 0, 20, nil)
+	require.Nil(t, pool.Add(other))
+	pending := pool.Pending(common.HexToAddress("0x1"))
+	require.Len(t, pending, evmrpc.MaxUserOpsPerSender+1)
+	require.Equal(t, other, pending[0])
+	require.Equal(t, replacement, pending[1])
+	require.Empty(t, pool.Pending(common.HexToAddress("0x2")))
+
+	pool.Remove(other.Hash)
+	require.Nil(t, pool.Get(other.Hash))
+	pool.Restore([]*evmrpc.PooledUserOp{other})
+	require.Equal(t, other, pool.Get(other.Hash))
+}
+
+func TestUserOpPoolReputation(t *testing.T) {
+	pool := evmrpc.NewUserOpPool()
+	paymaster := common.HexToAddress("0x300")
+	require.Equal(t, evmrpc.ReputationOK, pool.Reputation(paymaster))
+
+	// every operation counts as seen; without inclusions the paymaster gets throttled
+	seen := (evmrpc.ThrottlingSlack + 1) * evmrpc.MinInclusionDenominator
+	for i := 0; i < seen; i++ {
+		op := pooledUserOp(common.BigToAddress(big.NewInt(int64(0x1000+i))), 0, 10, &paymaster)
+		require.Nil(t, pool.Add(op))
+		pool.Remove(op.Hash)
+	}
+	require.Equal(t, evmrpc.ReputationThrottled, pool.Reputation(paymaster))
+
+	// throttled entities can only have a few operations in the pool
+	for i := 0; i < evmrpc.ThrottledEntityMempoolCount; i++ {
+		require.Nil(t, pool.Add(pooledUserOp(common.BigToAddress(big.NewInt(int64(0x5000+i))), 0, 10, &paymaster)))
+	}
+	require.NotNil(t, pool.Add(pooledUserOp(common.HexToAddress("0x6000"), 0, 10, &paymaster)))
+
+	// inclusions restore the reputation
+	included := pooledUserOp(common.HexToAddress("0x7000"), 0, 10, &paymaster)
+	for i := 0; i < 2; i++ {
+		pool.Included(included.UserOp)
+	}
+	require.Equal(t, evmrpc.ReputationOK, pool.Reputation(paymaster))
+
+	// penalized entities are banned and their operations dropped
+	pool.Penalize(paymaster)
+	require.Equal(t, evmrpc.ReputationBanned, pool.Reputation(paymaster))
+	require.Empty(t, pool.Pending(common.HexToAddress("0x1")))
+	require.NotNil(t, pool.Add(pooledUserOp(common.HexToAddress("0x8000"), 0, 10, &paymaster)))
+
+	// reputation decays back over time
+	pool.DecayReputation(time.Now().Add(100 * evmrpc.ReputationDecayInterval))
+	require.Equal(t, evmrpc.ReputationOK, pool.Reputation(paymaster))
+}
@@ -0,0 +1,281 @@
+// Package verify verifies the state proofs returned by eth_getProof against the app hash of a
+// Tendermint header, so that light clients and bridges can check Kii EVM state without trusting
+// the RPC node.
+//
+// The state of height H is committed in the app hash of the header at height H+1. Every proof
+// is an IAVL proof of a key in a module store followed by a simple merkle proof of the module
+// store root in the multistore, whose root is the app hash.
+package verify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/kiichain/kiichain/x/evm/state"
+	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// ProofResult is the result of eth_getProof.
+// This differs from the EIP-1186 AccountResult in a few ways:
+//  1. Proofs are IAVL and multistore proofs against the app hash, not trie proofs
+//  2. Account fields are proven individually in accountProof since there is no per-account root
+//  3. storageHash is the root of the EVM module store, shared by all accounts
+type ProofResult struct {
+	Address      common.Address     `json:"address"`
+	Height       hexutil.Uint64     `json:"height"`
+	Balance      *hexutil.Big       `json:"balance"`
+	Nonce        hexutil.Uint64     `json:"nonce"`
+	CodeHash     common.Hash        `json:"codeHash"`
+	StorageHash  common.Hash        `json:"storageHash"`
+	AccountProof *AccountProof      `json:"accountProof"`
+	HexValues    []string           `json:"hexValues"`
+	StorageProof []*crypto.ProofOps `json:"storageProof"`
+}
+
+// AccountProof proves the store entries the account fields of an EVM address are derived from.
+// The balance is the ukii bank balance of the Kii address the EVM address is associated with (or
+// the EVM address itself if it isn't associated), including coins locked by vesting.
+type AccountProof struct {
+	Association *Proof `json:"association"`
+	Balance     *Proof `json:"balance"`
+	WeiBalance  *Proof `json:"weiBalance"`
+	Nonce       *Proof `json:"nonce"`
+	CodeHash    *Proof `json:"codeHash"`
+}
+
+// Proof proves the value of a key of a module store, or its absence if the value is empty
+type Proof struct {
+	Store string           `json:"store"`
+	Key   hexutil.Bytes    `json:"key"`
+	Value hexutil.Bytes    `json:"value"`
+	Proof *crypto.ProofOps `json:"proof"`
+}
+
+// Account holds the account fields of an EVM address
+type Account struct {
+	KiiAddress sdk.AccAddress
+	Balance    *big.Int
+	Nonce      uint64
+	CodeHash   common.Hash
+}
+
+// StorageKey returns the EVM store key of a hex storage key passed to eth_getProof, decoded
+// like the keys of eth_getStorageAt
+func StorageKey(address common.Address, key string) ([]byte, error) {
+	slot, _, err := DecodeHash(key)
+	if err != nil {
+		return nil, err
+	}
+	return append(types.StateKey(address), slot[:]...), nil
+}
+
+// DecodeHash parses a hex-encoded 32-byte hash. The input may optionally
+// be prefixed by 0x and can have a byte length up to 32.
+func DecodeHash(s string) (h common.Hash, inputLength int, err error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	if (len(s) & 1) > 0 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Hash{}, 0, errors.New("hex string invalid")
+	}
+	if len(b) > 32 {
+		return common.Hash{}, len(b), errors.New("hex string too long, want at most 32 bytes")
+	}
+	return common.BytesToHash(b), len(b), nil
+}
+
+// Verify checks that the proof is a valid proof of its value, or of the key being absent, in
+// the state whose app hash is appHash
+func (p *Proof) Verify(appHash []byte) error {
+	if p == nil || p.Proof == nil {
+		return errors.New("missing proof")
+	}
+	keyPath := merkle.KeyPath{}.AppendKey([]byte(p.Store), merkle.KeyEncodingURL).AppendKey(p.Key, merkle.KeyEncodingHex).String()
+	if len(p.Value) == 0 {
+		return rootmulti.DefaultProofRuntime().VerifyAbsence(p.Proof, appHash, keyPath)
+	}
+	return rootmulti.DefaultProofRuntime().VerifyValue(p.Proof, appHash, keyPath, p.Value)
+}
+
+// StoreRoot returns the root of the module store the proof is for
+func (p *Proof) StoreRoot() (common.Hash, error) {
+	if p == nil || p.Proof == nil || len(p.Proof.Ops) == 0 {
+		return common.Hash{}, errors.New("missing proof")
+	}
+	op, err := rootmulti.DefaultProofRuntime().Decode(p.Proof.Ops[0])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	var args [][]byte
+	if len(p.Value) > 0 {
+		args = [][]byte{p.Value}
+	}
+	root, err := op.Run(args)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(root[0]), nil
+}
+
+// Account decodes the account fields of address from the proven values, checking that every
+// proof is for the key the field is stored at. It doesn't verify the proofs themselves.
+func (p *AccountProof) Account(address common.Address) (*Account, error) {
+	if p == nil || p.Association == nil || p.Balance == nil || p.WeiBalance == nil || p.Nonce == nil || p.CodeHash == nil {
+		return nil, errors.New("incomplete account proof")
+	}
+	if err := checkKey(p.Association, types.StoreKey, types.EVMAddressToKiiAddressKey(address)); err != nil {
+		return nil, err
+	}
+	account := &Account{KiiAddress: sdk.AccAddress(address[:])}
+	if len(p.Association.Value) > 0 {
+		account.KiiAddress = sdk.AccAddress(p.Association.Value)
+	}
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(account.KiiAddress), []byte(keeper.BaseDenom)...)
+	if err := checkKey(p.Balance, banktypes.StoreKey, balanceKey); err != nil {
+		return nil, err
+	}
+	ukii := sdk.ZeroInt()
+	if len(p.Balance.Value) > 0 {
+		var coin sdk.Coin
+		if err := coin.Unmarshal(p.Balance.Value); err != nil {
+			return nil, err
+		}
+		if coin.Denom != keeper.BaseDenom {
+			return nil, fmt.Errorf("balance proof holds %s instead of %s", coin.Denom, keeper.BaseDenom)
+		}
+		ukii = coin.Amount
+	}
+	if err := checkKey(p.WeiBalance, banktypes.StoreKey, append(append([]byte{}, banktypes.WeiBalancesPrefix...), account.KiiAddress...)); err != nil {
+		return nil, err
+	}
+	wei := sdk.ZeroInt()
+	if len(p.WeiBalance.Value) > 0 {
+		if err := wei.Unmarshal(p.WeiBalance.Value); err != nil {
+			return nil, err
+		}
+	}
+	account.Balance = ukii.Mul(state.SdkUkiiToSweiMultiplier).Add(wei).BigInt()
+
+	if err := checkKey(p.Nonce, types.StoreKey, append(append([]byte{}, types.NonceKeyPrefix...), address[:]...)); err != nil {
+		return nil, err
+	}
+	if len(p.Nonce.Value) > 0 {
+		if len(p.Nonce.Value) != 8 {
+			return nil, errors.New("invalid nonce value")
+		}
+		account.Nonce = binary.BigEndian.Uint64(p.Nonce.Value)
+	}
+
+	if err := checkKey(p.CodeHash, types.StoreKey, append(append([]byte{}, types.CodeHashKeyPrefix...), address[:]...)); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(p.CodeHash.Value) > 0:
+		account.CodeHash = common.BytesToHash(p.CodeHash.Value)
+	case account.Balance.Sign() > 0:
+		// per Ethereum behavior, accounts with balance but no code have the empty code hash
+		account.CodeHash = ethtypes.EmptyCodeHash
+	}
+	return account, nil
+}
+
+// VerifyProof verifies every proof of res against appHash and checks that the account fields
+// and storage values of res are the proven ones. storageKeys are the storage keys that were
+// passed to eth_getProof.
+func VerifyProof(res *ProofResult, storageKeys []string, appHash []byte) error {
+	if res == nil {
+		return errors.New("missing proof result")
+	}
+	account, err := res.AccountProof.Account(res.Address)
+	if err != nil {
+		return err
+	}
+	for _, field := range []struct {
+		name  string
+		proof *Proof
+	}{
+		{"association", res.AccountProof.Association},
+		{"balance", res.AccountProof.Balance},
+		{"wei balance", res.AccountProof.WeiBalance},
+		{"nonce", res.AccountProof.Nonce},
+		{"code hash", res.AccountProof.CodeHash},
+	} {
+		if err := field.proof.Verify(appHash); err != nil {
+			return fmt.Errorf("invalid %s proof: %w", field.name, err)
+		}
+	}
+	if res.Balance == nil || res.Balance.ToInt().Cmp(account.Balance) != 0 {
+		return fmt.Errorf("balance doesn't match the proven balance %s", account.Balance)
+	}
+	if uint64(res.Nonce) != account.Nonce {
+		return fmt.Errorf("nonce doesn't match the proven nonce %d", account.Nonce)
+	}
+	if res.CodeHash != account.CodeHash {
+		return fmt.Errorf("code hash doesn't match the proven code hash %s", account.CodeHash.Hex())
+	}
+	storageHash, err := res.AccountProof.Nonce.StoreRoot()
+	if err != nil {
+		return err
+	}
+	if res.StorageHash != storageHash {
+		return fmt.Errorf("storage hash doesn't match the proven EVM store root %s", storageHash.Hex())
+	}
+
+	if len(storageKeys) != len(res.StorageProof) || len(storageKeys) != len(res.HexValues) {
+		return errors.New("storage proofs don't match the storage keys")
+	}
+	for i, key := range storageKeys {
+		value, err := hex.DecodeString(res.HexValues[i])
+		if err != nil {
+			return err
+		}
+		storageKey, err := StorageKey(res.Address, key)
+		if err != nil {
+			return fmt.Errorf("invalid storage key %s: %w", key, err)
+		}
+		proof := &Proof{Store: types.StoreKey, Key: storageKey, Value: value, Proof: res.StorageProof[i]}
+		if err := proof.Verify(appHash); err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// VerifyProofWithHeader verifies res against a header trusted by a Tendermint light client. The
+// header must be the one right after the height of the proofs since it commits their app hash.
+func VerifyProofWithHeader(res *ProofResult, storageKeys []string, header *tmtypes.Header) error {
+	if res == nil || header == nil {
+		return errors.New("missing proof result or header")
+	}
+	if header.Height != int64(res.Height)+1 {
+		return fmt.Errorf("proofs of height %d must be verified against the header of height %d, got %d", res.Height, res.Height+1, header.Height)
+	}
+	return VerifyProof(res, storageKeys, header.AppHash)
+}
+
+func checkKey(p *Proof, store string, key []byte) error {
+	if p.Store != store || !bytes.Equal(p.Key, key) {
+		return fmt.Errorf("proof is for %s/%X instead of %s/%X", p.Store, []byte(p.Key), store, key)
+	}
+	return nil
+}
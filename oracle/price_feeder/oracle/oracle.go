@@ -2,6 +2,8 @@ package oracle
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -36,6 +38,7 @@ type Oracle struct {
 	providerPairs      map[string][]types.CurrencyPair
 	chainDenomMapping  map[string]string // map with the chain-denom by base name
 	previousVotePeriod float64
+	previousPrevote    *PreviousPrevote
 	priceProviders     map[string]provider.Provider
 	failedProviders    map[string]error
 	oracleClient       client.OracleClient
@@ -52,6 +55,14 @@ type Oracle struct {
 	mockSetPrices   func(ctx context.Context) error // used for testing
 }
 
+// PreviousPrevote stores the data of the last broadcasted prevote, it is revealed
+// with a vote on the next vote period
+type PreviousPrevote struct {
+	ExchangeRates    string
+	Salt             string
+	SubmitVotePeriod float64
+}
+
 // createMappingsFromPairs is a helper function to initialize maps from currencyPairs
 // this is used to by test cases to initialize the oracle client
 func createMappingsFromPairs(currencyPairs []config.CurrencyPair) (map[string]string, map[string][]types.CurrencyPair) {
@@ -654,27 +665,55 @@ func (o *Oracle) tick(
 	// convert rates to string (sorted string)
 	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
 
-	// prepate voting message
-	voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
-		ExchangeRates: exchangeRatesStr,
-		Feeder:        o.oracleClient.OracleAddrString,
-		Validator:     valAddr.String(),
+	// prepare the prevote message with the hash of the current prices
+	salt, err := GenerateSalt(32)
+	if err != nil {
+		return err
+	}
+
+	voteHash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
+	prevoteMsg := &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      voteHash.String(),
+		Feeder:    o.oracleClient.OracleAddrString,
+		Validator: valAddr.String(),
 	}
 
 	o.logger.Debug().
 		Str("exchange_rates", GenerateExchangeRatesString(prices)).
 		Msg("pre-filtered prices")
 
+	// prepare voting message revealing the prevote of the previous vote period
+	// the vote must go before the new prevote because it consumes the stored prevote
+	msgs := []sdk.Msg{}
+	if o.previousPrevote != nil && o.previousPrevote.SubmitVotePeriod == currentVotePeriod-1 {
+		voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
+			Salt:          o.previousPrevote.Salt,
+			ExchangeRates: o.previousPrevote.ExchangeRates,
+			Feeder:        o.oracleClient.OracleAddrString,
+			Validator:     valAddr.String(),
+		}
+		msgs = append(msgs, voteMsg)
+
+		o.logger.Info().
+			Str("exchange_rates", voteMsg.ExchangeRates).
+			Str("validator", voteMsg.Validator).
+			Str("feeder", voteMsg.Feeder).
+			Float64("vote_period", currentVotePeriod).
+			Int64("tick_duration", time.Since(startTime).Milliseconds()).
+			Msg("Going to broadcast vote")
+	}
+	msgs = append(msgs, prevoteMsg)
+
 	o.logger.Info().
-		Str("exchange_rates", voteMsg.ExchangeRates).
-		Str("validator", voteMsg.Validator).
-		Str("feeder", voteMsg.Feeder).
+		Str("hash", prevoteMsg.Hash).
+		Str("validator", prevoteMsg.Validator).
+		Str("feeder", prevoteMsg.Feeder).
 		Float64("vote_period", currentVotePeriod).
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
-		Msg("Going to broadcast vote")
+		Msg("Going to broadcast prevote")
 
 	// broadcast transaction
-	resp, err := o.oracleClient.BroadcastTx(clientCtx, voteMsg)
+	resp, err := o.oracleClient.BroadcastTx(clientCtx, msgs...)
 	if err != nil {
 		o.logResponseError(err, resp, startTime, blockHeight)
 		telemetry.IncrCounter(1, "failure", "broadcast")
@@ -689,8 +728,13 @@ func (o *Oracle) tick(
 		Msg(fmt.Sprintf("broadcasted for height %d", blockHeight))
 	telemetry.IncrCounter(1, "success", "broadcast")
 
-	// update the vote period voted
+	// update the vote period voted and keep the prevote data to reveal it on the next vote period
 	o.previousVotePeriod = currentVotePeriod
+	o.previousPrevote = &PreviousPrevote{
+		ExchangeRates:    exchangeRatesStr,
+		Salt:             salt,
+		SubmitVotePeriod: currentVotePeriod,
+	}
 
 	// validate the health endpoints
 	o.healthchecksPing()
@@ -741,3 +785,18 @@ func GenerateExchangeRatesString(prices sdk.DecCoins) string {
 	prices.Sort()
 	return prices.String()
 }
+
+// GenerateSalt generates a random hex salt of the given length in bytes, it is used
+// to build the prevote hash
+func GenerateSalt(length int) (string, error) {
+	if length == 0 {
+		return "", fmt.Errorf("failed to generate salt: zero length")
+	}
+
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
@@ -560,6 +560,7 @@ func TestTickScenarios(t *testing.T) {
 		whitelist          oracletypes.DenomList
 		blockHeight        int64
 		previousVotePeriod float64
+		previousPrevote    *PreviousPrevote
 		votePeriod         uint64
 		mockBroadcastErr   error
 
@@ -590,6 +591,34 @@ func TestTickScenarios(t *testing.T) {
 				Validator:     validatorAddr,
 			},
 		},
+		{
+			name:               "Previous prevote, should reveal it with a vote and broadcast a new prevote",
+			isJailed:           false,
+			blockHeight:        1,
+			previousVotePeriod: 1,
+			previousPrevote: &PreviousPrevote{
+				ExchangeRates:    "2.000000000000000000ubtc,3.000000000000000000ueth,1.000000000000000000uusdt",
+				Salt:             "abcd",
+				SubmitVotePeriod: 1,
+			},
+			votePeriod: 1,
+			pairs: []config.CurrencyPair{
+				{Base: "USDT", ChainDenom: "uusdt", Quote: "USD"},
+				{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"},
+				{Base: "ETH", ChainDenom: "ueth", Quote: "USD"},
+			},
+			prices: map[string]sdk.Dec{
+				"USDT": sdk.MustNewDecFromStr("1.1"),
+				"BTC":  sdk.MustNewDecFromStr("2.2"),
+				"ETH":  sdk.MustNewDecFromStr("3.3"),
+			},
+			whitelist: denomList("uusdt", "ubtc", "ueth"),
+			expectedVoteMsg: &oracletypes.MsgAggregateExchangeRateVote{
+				ExchangeRates: "2.200000000000000000ubtc,3.300000000000000000ueth,1.100000000000000000uusdt",
+				Feeder:        feederAddr,
+				Validator:     validatorAddr,
+			},
+		},
 		{
 			name:               "Filtered prices, should broadcast only whitelisted entries",
 			isJailed:           false,
@@ -668,6 +697,7 @@ func TestTickScenarios(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var setPriceCount int
 			var broadcastCount int
+			var prevoteHash string
 			// Create the oracle instance
 			oracle := &Oracle{
 				jailCache: JailCache{
@@ -678,6 +708,7 @@ func TestTickScenarios(t *testing.T) {
 					return nil
 				},
 				previousVotePeriod: test.previousVotePeriod,
+				previousPrevote:    test.previousPrevote,
 				chainDenomMapping:  cdm,
 				prices:             test.prices,
 				paramCache: ParamCache{
@@ -690,17 +721,31 @@ func TestTickScenarios(t *testing.T) {
 					OracleAddrString:    feederAddr,
 					ValidatorAddrString: validatorAddr,
 					MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
-						// Assert that there's only one message
-						require.Equal(t, 1, len(msgs))
+						// Assert the vote is only sent when there is a prevote to reveal
+						if test.previousPrevote != nil {
+							require.Equal(t, 2, len(msgs))
+
+							// Extract the message of type MsgAggregateExchangeRateVote
+							voteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRateVote)
+							require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRateVote")
+
+							// Assert the vote reveals the previous prevote
+							require.Equal(t, test.previousPrevote.ExchangeRates, voteMsg.ExchangeRates, test.name)
+							require.Equal(t, test.previousPrevote.Salt, voteMsg.Salt, test.name)
+							require.Equal(t, test.expectedVoteMsg.Feeder, voteMsg.Feeder, test.name)
+							require.Equal(t, test.expectedVoteMsg.Validator, voteMsg.Validator, test.name)
+						} else {
+							require.Equal(t, 1, len(msgs))
+						}
 
-						// Extract the message of type MsgAggregateExchangeRateVote
-						voteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRateVote)
-						require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRateVote")
+						// Extract the message of type MsgAggregateExchangeRatePrevote (always the last one)
+						prevoteMsg, ok := msgs[len(msgs)-1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+						require.True(t, ok, "Expected message type *oracletypes.MsgAggregateExchangeRatePrevote")
 
-						// Assert the expected values in the voteMsg
-						require.Equal(t, test.expectedVoteMsg.ExchangeRates, voteMsg.ExchangeRates, test.name)
-						require.Equal(t, test.expectedVoteMsg.Feeder, voteMsg.Feeder, test.name)
-						require.Equal(t, test.expectedVoteMsg.Validator, voteMsg.Validator, test.name)
+						// Assert the expected values in the prevoteMsg
+						require.Equal(t, test.expectedVoteMsg.Feeder, prevoteMsg.Feeder, test.name)
+						require.Equal(t, test.expectedVoteMsg.Validator, prevoteMsg.Validator, test.name)
+						prevoteHash = prevoteMsg.Hash
 
 						broadcastCount++
 
@@ -726,6 +771,14 @@ func TestTickScenarios(t *testing.T) {
 				require.Equal(t, 1, broadcastCount, test.name)
 				require.Equal(t, 1, setPriceCount, test.name)
 			}
+			if test.expectedVoteMsg != nil && test.expectedErr == nil {
+				// the broadcasted prevote must be the hash of the filtered prices
+				require.Equal(t, test.expectedVoteMsg.ExchangeRates, oracle.previousPrevote.ExchangeRates, test.name)
+				valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+				require.NoError(t, err)
+				expectedHash := oracletypes.GetAggregateVoteHash(oracle.previousPrevote.Salt, test.expectedVoteMsg.ExchangeRates, valAddr)
+				require.Equal(t, expectedHash.String(), prevoteHash, test.name)
+			}
 			if test.expectedVoteMsg == nil {
 				// should not call broadcast
 				require.Equal(t, 0, broadcastCount, test.name)
@@ -8,6 +8,9 @@ IAddr constant ADDR_CONTRACT = IAddr(
 );
 
 interface IAddr {
+    // Events
+    event AddressAssociated(address indexed evmAddr, string kiiAddr);
+
     // Transactions
     function associate(
         string memory v,
@@ -34,6 +34,10 @@ const (
 	AssociatePubKey     = "associatePubKey"
 )
 
+const (
+	AddressAssociatedEvent = "AddressAssociated"
+)
+
 const (
 	AddrAddress = "0x0000000000000000000000000000000000001004"
 )
@@ -47,6 +51,8 @@ type PrecompileExecutor struct {
 	evmKeeper     pcommon.EVMKeeper
 	bankKeeper    pcommon.BankKeeper
 	accountKeeper pcommon.AccountKeeper
+	address       common.Address
+	events        map[string]abi.Event
 
 	GetKiiAddressID   []byte
 	GetEvmAddressID   []byte
@@ -62,6 +68,8 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, a
 		evmKeeper:     evmKeeper,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		address:       common.HexToAddress(AddrAddress),
+		events:        newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -77,7 +85,7 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, a
 		}
 	}
 
-	return pcommon.NewPrecompile(newAbi, p, common.HexToAddress(AddrAddress), "addr"), nil
+	return pcommon.NewPrecompile(newAbi, p, p.address, "addr"), nil
 }
 
 // RequiredGas returns the required bare minimum gas to execute the precompile.
@@ -88,7 +96,7 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 	return pcommon.DefaultGasCost(input, p.IsTransaction(method.Name))
 }
 
-func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, _ common.Address, _ common.Address, args []interface{}, value *big.Int, readOnly bool, _ *vm.EVM) (bz []byte, err error) {
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, _ common.Address, _ common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (bz []byte, err error) {
 	switch method.Name {
 	case GetKiiAddressMethod:
 		return p.getKiiAddr(ctx, method, args, value)
@@ -98,12 +106,12 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, _ commo
 		if readOnly {
 			return nil, errors.New("cannot call associate precompile from staticcall")
 		}
-		return p.associate(ctx, method, args, value)
+		return p.associate(ctx, method, args, value, evm)
 	case AssociatePubKey:
 		if readOnly {
 			return nil, errors.New("cannot call associate pub key precompile from staticcall")
 		}
-		return p.associatePublicKey(ctx, method, args, value)
+		return p.associatePublicKey(ctx, method, args, value, evm)
 	}
 	return
 }
@@ -147,7 +155,7 @@ func (p PrecompileExecutor) getEvmAddr(ctx sdk.Context, method *abi.Method, args
 	return method.Outputs.Pack(evmAddr)
 }
 
-func (p PrecompileExecutor) associate(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) associate(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		return nil, err
 	}
@@ -189,10 +197,10 @@ func (p PrecompileExecutor) associate(ctx sdk.Context, method *abi.Method, args
 		return nil, err
 	}
 
-	return p.associateAddresses(ctx, method, evmAddr, kiiAddr, pubkey)
+	return p.associateAddresses(ctx, method, evm, evmAddr, kiiAddr, pubkey)
 }
 
-func (p PrecompileExecutor) associatePublicKey(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) associatePublicKey(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		return nil, err
 	}
@@ -223,10 +231,10 @@ func (p PrecompileExecutor) associatePublicKey(ctx sdk.Context, method *abi.Meth
 		return nil, err
 	}
 
-	return p.associateAddresses(ctx, method, evmAddr, kiiAddr, pubkey)
+	return p.associateAddresses(ctx, method, evm, evmAddr, kiiAddr, pubkey)
 }
 
-func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, method *abi.Method, evmAddr common.Address, kiiAddr sdk.AccAddress, pubkey cryptotypes.PubKey) ([]byte, error) {
+func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, method *abi.Method, evm *vm.EVM, evmAddr common.Address, kiiAddr sdk.AccAddress, pubkey cryptotypes.PubKey) ([]byte, error) {
 	// Check that address is not already associated
 	_, found := p.evmKeeper.GetEVMAddress(ctx, kiiAddr)
 	if found {
@@ -239,6 +247,9 @@ func (p PrecompileExecutor) associateAddresses(ctx sdk.Context, method *abi.Meth
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[AddressAssociatedEvent], evmAddr, kiiAddr.String()); err != nil {
+		return nil, err
+	}
 
 	return method.Outputs.Pack(kiiAddr.String(), evmAddr)
 }
@@ -8,6 +8,20 @@ IBank constant BANK_CONTRACT = IBank(
 );
 
 interface IBank {
+    // Events
+    event Send(
+        address indexed sender,
+        address indexed recipient,
+        string denom,
+        uint256 amount
+    );
+
+    event SendNative(
+        address indexed sender,
+        string recipient,
+        uint256 amount
+    );
+
     // Transactions
     function send(
         address fromAddress,
@@ -27,6 +27,11 @@ const (
 	SupplyMethod      = "supply"
 )
 
+const (
+	SendEvent       = "Send"
+	SendNativeEvent = "SendNative"
+)
+
 const (
 	BankAddress = "0x0000000000000000000000000000000000001001"
 )
@@ -41,6 +46,7 @@ type PrecompileExecutor struct {
 	bankKeeper    pcommon.BankKeeper
 	evmKeeper     pcommon.EVMKeeper
 	address       common.Address
+	events        map[string]abi.Event
 
 	SendID        []byte
 	SendNativeID  []byte
@@ -64,6 +70,7 @@ func NewPrecompile(bankKeeper pcommon.BankKeeper, evmKeeper pcommon.EVMKeeper, a
 		evmKeeper:     evmKeeper,
 		accountKeeper: accountKeeper,
 		address:       common.HexToAddress(BankAddress),
+		events:        newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -98,9 +105,9 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (bz []byte, err error) {
 	switch method.Name {
 	case SendMethod:
-		return p.send(ctx, caller, method, args, value, readOnly)
+		return p.send(ctx, caller, method, args, value, readOnly, evm)
 	case SendNativeMethod:
-		return p.sendNative(ctx, method, args, caller, callingContract, value, readOnly)
+		return p.sendNative(ctx, method, args, caller, callingContract, value, readOnly, evm)
 	case BalanceMethod:
 		return p.balance(ctx, method, args, value)
 	case AllBalancesMethod:
@@ -117,7 +124,7 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 	return
 }
 
-func (p PrecompileExecutor) send(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}, value *big.Int, readOnly bool) ([]byte, error) {
+func (p PrecompileExecutor) send(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) ([]byte, error) {
 	if readOnly {
 		return nil, errors.New("cannot call send from staticcall")
 	}
@@ -153,11 +160,14 @@ func (p PrecompileExecutor) send(ctx sdk.Context, caller common.Address, method
 	if err := p.bankKeeper.SendCoins(ctx, senderKiiAddr, receiverKiiAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)))); err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[SendEvent], args[0], args[1], denom, amount); err != nil {
+		return nil, err
+	}
 
 	return method.Outputs.Pack(true)
 }
 
-func (p PrecompileExecutor) sendNative(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address, callingContract common.Address, value *big.Int, readOnly bool) ([]byte, error) {
+func (p PrecompileExecutor) sendNative(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address, callingContract common.Address, value *big.Int, readOnly bool, evm *vm.EVM) ([]byte, error) {
 	if readOnly {
 		return nil, errors.New("cannot call sendNative from staticcall")
 	}
@@ -199,6 +209,9 @@ func (p PrecompileExecutor) sendNative(ctx sdk.Context, method *abi.Method, args
 		defer telemetry.IncrCounter(1, "new", "account")
 		p.accountKeeper.SetAccount(ctx, p.accountKeeper.NewAccountWithAddress(ctx, receiverKiiAddr))
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[SendNativeEvent], caller, receiverAddr, value); err != nil {
+		return nil, err
+	}
 
 	return method.Outputs.Pack(true)
 }
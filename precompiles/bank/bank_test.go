@@ -142,6 +142,7 @@ func TestRun(t *testing.T) {
 	res, err := msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), req)
 	require.Nil(t, err)
 	require.Empty(t, res.VmError)
+	fmt.Println("GASUSED", res.GasUsed)
 
 	evts := ctx.EventManager().ABCIEvents()
 
@@ -179,9 +180,9 @@ func TestRun(t *testing.T) {
 			sdk.NewAttribute(banktypes.AttributeKeySender, senderAddr.String()),
 		),
 		// gas refund to the sender
-		banktypes.NewCoinReceivedEvent(senderAddr, sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(41916)))),
+		banktypes.NewCoinReceivedEvent(senderAddr, sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(45523)))),
 		// tip is paid to the validator
-		banktypes.NewCoinReceivedEvent(sdk.MustAccAddressFromBech32("kii1v4mx6hmrda5kucnpwdjsqqqqqqqqqqqqttjmaj"), sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(27944)))),
+		banktypes.NewCoinReceivedEvent(sdk.MustAccAddressFromBech32("kii1v4mx6hmrda5kucnpwdjsqqqqqqqqqqqqttjmaj"), sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(30349)))),
 	}
 
 	require.EqualValues(t, expectedEvts.ToABCIEvents(), evts)
@@ -231,7 +232,7 @@ func TestRun(t *testing.T) {
 		Denom:  "ufoo",
 	}, bank.CoinBalance(parsedBalances[0]))
 	require.Equal(t, bank.CoinBalance{
-		Amount: big.NewInt(9841905),
+		Amount: big.NewInt(9845512),
 		Denom:  "ukii",
 	}, bank.CoinBalance(parsedBalances[1]))
 
@@ -19,6 +19,7 @@ import (
 	"github.com/kiichain/kiichain/utils"
 
 	oracletypes "github.com/kiichain/kiichain/x/oracle/types"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
 )
 
 type BankKeeper interface {
@@ -59,6 +60,7 @@ type EVMKeeper interface {
 	) (contractAddr common.Address, err error)
 	GetEVMGasLimitFromCtx(ctx sdk.Context) uint64
 	GetCosmosGasLimitFromEVMGas(ctx sdk.Context, evmGas uint64) uint64
+	IsPrecompileLogsActive(ctx sdk.Context) bool
 }
 
 type AccountKeeper interface {
@@ -90,6 +92,8 @@ type WasmdViewKeeper interface {
 }
 
 type StakingKeeper interface {
+	CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error)
+	EditValidator(goCtx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error)
 	Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error)
 	BeginRedelegate(goCtx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error)
 	Undelegate(goCtx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error)
@@ -97,11 +101,31 @@ type StakingKeeper interface {
 
 type StakingQuerier interface {
 	Delegation(c context.Context, req *stakingtypes.QueryDelegationRequest) (*stakingtypes.QueryDelegationResponse, error)
+	Validator(c context.Context, req *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error)
+	Validators(c context.Context, req *stakingtypes.QueryValidatorsRequest) (*stakingtypes.QueryValidatorsResponse, error)
+	UnbondingDelegation(c context.Context, req *stakingtypes.QueryUnbondingDelegationRequest) (*stakingtypes.QueryUnbondingDelegationResponse, error)
+	Redelegations(c context.Context, req *stakingtypes.QueryRedelegationsRequest) (*stakingtypes.QueryRedelegationsResponse, error)
+	DelegatorDelegations(c context.Context, req *stakingtypes.QueryDelegatorDelegationsRequest) (*stakingtypes.QueryDelegatorDelegationsResponse, error)
+}
+
+// StakingUnbondingKeeper exposes the staking store operations needed to cancel
+// an unbonding delegation, which has no message in this version of the SDK.
+type StakingUnbondingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.UnbondingDelegation, bool)
+	SetUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
+	RemoveUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error)
 }
 
 type GovKeeper interface {
 	AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options govtypes.WeightedVoteOptions) error
 	AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error)
+	SubmitProposalWithExpedite(ctx sdk.Context, content govtypes.Content, isExpedited bool) (govtypes.Proposal, error)
+	Proposal(c context.Context, req *govtypes.QueryProposalRequest) (*govtypes.QueryProposalResponse, error)
+	Proposals(c context.Context, req *govtypes.QueryProposalsRequest) (*govtypes.QueryProposalsResponse, error)
+	TallyResult(c context.Context, req *govtypes.QueryTallyResultRequest) (*govtypes.QueryTallyResultResponse, error)
+	Deposit(c context.Context, req *govtypes.QueryDepositRequest) (*govtypes.QueryDepositResponse, error)
 }
 
 type DistributionKeeper interface {
@@ -126,3 +150,18 @@ type ConnectionKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (types.Channel, bool)
 }
+
+type TokenFactoryKeeper interface {
+	CreateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgCreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error)
+	UpdateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgUpdateDenom) (*tokenfactorytypes.MsgUpdateDenomResponse, error)
+	Mint(goCtx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error)
+	Burn(goCtx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error)
+	ChangeAdmin(goCtx context.Context, msg *tokenfactorytypes.MsgChangeAdmin) (*tokenfactorytypes.MsgChangeAdminResponse, error)
+	SetDenomMetadata(goCtx context.Context, msg *tokenfactorytypes.MsgSetDenomMetadata) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error)
+}
+
+type TokenFactoryQuerier interface {
+	DenomAuthorityMetadata(ctx context.Context, req *tokenfactorytypes.QueryDenomAuthorityMetadataRequest) (*tokenfactorytypes.QueryDenomAuthorityMetadataResponse, error)
+	DenomsFromCreator(ctx context.Context, req *tokenfactorytypes.QueryDenomsFromCreatorRequest) (*tokenfactorytypes.QueryDenomsFromCreatorResponse, error)
+	Params(ctx context.Context, req *tokenfactorytypes.QueryParamsRequest) (*tokenfactorytypes.QueryParamsResponse, error)
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MaxPageLimit caps the page size of paginated precompile queries, since
+// precompiles charge a flat gas cost regardless of how much is read.
+const MaxPageLimit = 100
+
+// PageRequest is the ABI representation of query.PageRequest.
+type PageRequest struct {
+	Key        []byte `json:"key"`
+	Offset     uint64 `json:"offset"`
+	Limit      uint64 `json:"limit"`
+	CountTotal bool   `json:"countTotal"`
+	Reverse    bool   `json:"reverse"`
+}
+
+// PageResponse is the ABI representation of query.PageResponse.
+type PageResponse struct {
+	NextKey []byte
+	Total   uint64
+}
+
+// ParsePageRequest converts an ABI-decoded PageRequest tuple into the SDK
+// pagination request used by module queriers.
+func ParsePageRequest(arg interface{}) (*query.PageRequest, error) {
+	pageRequest := PageRequest(arg.(struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	}))
+	if pageRequest.Limit > MaxPageLimit {
+		return nil, fmt.Errorf("page limit must not exceed %d", MaxPageLimit)
+	}
+	return &query.PageRequest{
+		Key:        pageRequest.Key,
+		Offset:     pageRequest.Offset,
+		Limit:      pageRequest.Limit,
+		CountTotal: pageRequest.CountTotal,
+		Reverse:    pageRequest.Reverse,
+	}, nil
+}
+
+// NewPageResponse converts an SDK pagination response into its ABI representation.
+func NewPageResponse(pageResponse *query.PageResponse) PageResponse {
+	if pageResponse == nil {
+		return PageResponse{}
+	}
+	return PageResponse{NextKey: pageResponse.NextKey, Total: pageResponse.Total}
+}
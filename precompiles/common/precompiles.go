@@ -12,7 +12,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/kiichain/kiichain/utils"
 	"github.com/kiichain/kiichain/utils/metrics"
 	"github.com/kiichain/kiichain/x/evm/state"
 	"github.com/kiichain/kiichain/x/evm/types"
@@ -37,6 +40,7 @@ type Precompile struct {
 }
 
 var _ vm.PrecompiledContract = &Precompile{}
+var _ vm.DynamicGasPrecompiledContract = &Precompile{}
 
 func NewPrecompile(a abi.ABI, executor PrecompileExecutor, address common.Address, name string) *Precompile {
 	return &Precompile{ABI: a, executor: executor, address: address, name: name}
@@ -85,6 +89,47 @@ func (p Precompile) Run(evm *vm.EVM, caller common.Address, callingContract comm
 	return bz, err
 }
 
+// RunAndCalculateGas runs the precompile like Run once RequiredGas is paid, and charges the logs the
+// precompile emitted on top of it
+func (p Precompile) RunAndCalculateGas(evm *vm.EVM, caller common.Address, callingContract common.Address, input []byte, suppliedGas uint64, value *big.Int, hooks *tracing.Hooks, readOnly bool, isFromDelegateCall bool) (ret []byte, remainingGas uint64, err error) {
+	gasCost := p.RequiredGas(input)
+	if suppliedGas < gasCost {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	if hooks != nil && hooks.OnGasChange != nil {
+		hooks.OnGasChange(suppliedGas, suppliedGas-gasCost, tracing.GasChangeCallPrecompiledContract)
+	}
+	remainingGas = suppliedGas - gasCost
+
+	logsCount := len(evm.StateDB.GetLogs(common.Hash{}, 0, common.Hash{}))
+	ret, err = p.Run(evm, caller, callingContract, input, value, readOnly, isFromDelegateCall)
+	if err != nil {
+		return ret, remainingGas, err
+	}
+	logsGas := logsGas(evm, p.address, logsCount)
+	if remainingGas < logsGas {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	return ret, remainingGas - logsGas, nil
+}
+
+// logsGas returns the gas of the logs emitted by the precompile at address after the first
+// logsCount logs of the transaction, priced like the LOG opcodes
+func logsGas(evm *vm.EVM, address common.Address, logsCount int) uint64 {
+	logs := evm.StateDB.GetLogs(common.Hash{}, 0, common.Hash{})
+	if len(logs) <= logsCount {
+		return 0
+	}
+	gas := uint64(0)
+	for _, log := range logs[logsCount:] {
+		if log.Address != address {
+			continue
+		}
+		gas += params.LogGas + params.LogTopicGas*uint64(len(log.Topics)) + params.LogDataGas*uint64(len(log.Data))
+	}
+	return gas
+}
+
 func HandlePrecompileError(err error, evm *vm.EVM, operation string) {
 	if err != nil {
 		evm.StateDB.(*state.DBImpl).SetPrecompileError(err)
@@ -166,10 +211,16 @@ func (d DynamicGasPrecompile) RunAndCalculateGas(evm *vm.EVM, caller common.Addr
 	em := ctx.EventManager()
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ctx = ctx.WithEVMPrecompileCalledFromDelegateCall(isFromDelegateCall)
+	logsCount := len(evm.StateDB.GetLogs(common.Hash{}, 0, common.Hash{}))
 	ret, remainingGas, err = d.executor.Execute(ctx, method, caller, callingContract, args, value, readOnly, evm, suppliedGas)
 	if err != nil {
 		return ret, remainingGas, err
 	}
+	logsGas := logsGas(evm, d.address, logsCount)
+	if remainingGas < logsGas {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	remainingGas -= logsGas
 	events := ctx.EventManager().Events()
 	if len(events) > 0 {
 		em.EmitEvents(ctx.EventManager().Events())
@@ -197,6 +248,50 @@ func ValidateNonPayable(value *big.Int) error {
 	return nil
 }
 
+// EmitEvent adds a log of an event declared in the precompile's interface to the StateDB, so that it
+// lands in the receipt and bloom of the transaction like the logs of any other contract. Args are
+// given in the order of the event's inputs. The log is dropped along with the rest of the state
+// changes if the call reverts, and is charged like the LOG opcodes once the call returns (see logsGas).
+// Nothing is emitted before the upgrade activating precompile logs.
+func EmitEvent(ctx sdk.Context, evmKeeper EVMKeeper, evm *vm.EVM, address common.Address, event abi.Event, args ...interface{}) error {
+	// the activation check is not charged, so precompile calls cost the same before the upgrade
+	if !evmKeeper.IsPrecompileLogsActive(ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))) {
+		return nil
+	}
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("event %s expects %d arguments but got %d", event.Name, len(event.Inputs), len(args))
+	}
+	indexed := []interface{}{}
+	nonIndexed := []interface{}{}
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, args[i])
+		} else {
+			nonIndexed = append(nonIndexed, args[i])
+		}
+	}
+	topics := []common.Hash{event.ID}
+	if len(indexed) > 0 {
+		indexedTopics, err := abi.MakeTopics(utils.Map(indexed, func(arg interface{}) []interface{} { return []interface{}{arg} })...)
+		if err != nil {
+			return err
+		}
+		for _, topic := range indexedTopics {
+			topics = append(topics, topic[0])
+		}
+	}
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return err
+	}
+	log := &ethtypes.Log{Address: address, Topics: topics, Data: data}
+	if evm.Context.BlockNumber != nil {
+		log.BlockNumber = evm.Context.BlockNumber.Uint64()
+	}
+	evm.StateDB.AddLog(log)
+	return nil
+}
+
 func HandlePaymentUkii(ctx sdk.Context, precompileAddr sdk.AccAddress, payer sdk.AccAddress, value *big.Int, bankKeeper BankKeeper) (sdk.Coin, error) {
 	ukii, wei := state.SplitUkiiWeiAmount(value)
 	if !wei.IsZero() {
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kiichain/kiichain/x/evm/state"
@@ -130,3 +131,80 @@ func TestDynamicGasPrecompileRun(t *testing.T) {
 	// should not emit any event
 	require.Empty(t, stateDB.Ctx().EventManager().Events())
 }
+
+func TestEmitEvent(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).WithBlockTime(time.Now())
+	abiBz, err := os.ReadFile("erc20_abi.json")
+	require.Nil(t, err)
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	require.Nil(t, err)
+	stateDB := state.NewDBImpl(ctx, k, false)
+	evm := &vm.EVM{StateDB: stateDB, Context: vm.BlockContext{BlockNumber: big.NewInt(5)}}
+	address := ethcommon.HexToAddress("0x1001")
+	from := ethcommon.HexToAddress("0x1")
+	to := ethcommon.HexToAddress("0x2")
+	require.NotNil(t, common.EmitEvent(ctx, k, evm, address, newAbi.Events["Transfer"], from, to))
+	require.Nil(t, common.EmitEvent(ctx, k, evm, address, newAbi.Events["Transfer"], from, to, big.NewInt(10)))
+
+	logs := stateDB.GetAllLogs()
+	require.Len(t, logs, 1)
+	require.Equal(t, address, logs[0].Address)
+	require.Equal(t, []ethcommon.Hash{newAbi.Events["Transfer"].ID, ethcommon.BytesToHash(from.Bytes()), ethcommon.BytesToHash(to.Bytes())}, logs[0].Topics)
+	require.Equal(t, ethcommon.BigToHash(big.NewInt(10)).Bytes(), logs[0].Data)
+	require.Equal(t, uint64(5), logs[0].BlockNumber)
+
+	// logs are reverted along with the rest of the call
+	snapshot := stateDB.Snapshot()
+	require.Nil(t, common.EmitEvent(ctx, k, evm, address, newAbi.Events["Transfer"], from, to, big.NewInt(20)))
+	require.Len(t, stateDB.GetAllLogs(), 2)
+	stateDB.RevertToSnapshot(snapshot)
+	require.Len(t, stateDB.GetAllLogs(), 1)
+
+	// nothing is emitted before the upgrade activating precompile logs
+	ctx, _ = ctx.CacheContext()
+	k.SetPrecompileLogsTime(ctx, ctx.BlockTime().Unix()+1)
+	require.Nil(t, common.EmitEvent(ctx, k, evm, address, newAbi.Events["Transfer"], from, to, big.NewInt(30)))
+	require.Len(t, stateDB.GetAllLogs(), 1)
+}
+
+type MockLogPrecompileExecutor struct {
+	event     abi.Event
+	evmKeeper common.EVMKeeper
+}
+
+func (e *MockLogPrecompileExecutor) RequiredGas([]byte, *abi.Method) uint64 {
+	return 1000
+}
+
+func (e *MockLogPrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller ethcommon.Address, callingContract ethcommon.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) ([]byte, error) {
+	if err := common.EmitEvent(ctx, e.evmKeeper, evm, ethcommon.HexToAddress("0x1001"), e.event, caller, callingContract, big.NewInt(10)); err != nil {
+		return nil, err
+	}
+	return []byte("success"), nil
+}
+
+func TestPrecompileLogsGas(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).WithBlockTime(time.Now())
+	abiBz, err := os.ReadFile("erc20_abi.json")
+	require.Nil(t, err)
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	require.Nil(t, err)
+	input, err := newAbi.Pack("decimals")
+	require.Nil(t, err)
+	executor := &MockLogPrecompileExecutor{event: newAbi.Events["Transfer"], evmKeeper: k}
+	precompile := common.NewPrecompile(newAbi, executor, ethcommon.HexToAddress("0x1001"), "test")
+	stateDB := state.NewDBImpl(ctx, k, false)
+
+	// the log costs 375 + 3 topics * 375 + 32 bytes * 8 on top of the required gas
+	res, remainingGas, err := precompile.RunAndCalculateGas(&vm.EVM{StateDB: stateDB}, ethcommon.Address{}, ethcommon.Address{}, input, 10000, big.NewInt(0), nil, false, false)
+	require.Nil(t, err)
+	require.Equal(t, []byte("success"), res)
+	require.Equal(t, uint64(10000-1000-1756), remainingGas)
+	require.Len(t, stateDB.GetAllLogs(), 1)
+
+	_, remainingGas, err = precompile.RunAndCalculateGas(&vm.EVM{StateDB: stateDB}, ethcommon.Address{}, ethcommon.Address{}, input, 2000, big.NewInt(0), nil, false, false)
+	require.Equal(t, vm.ErrOutOfGas, err)
+	require.Zero(t, remainingGas)
+}
@@ -8,6 +8,12 @@ IDistr constant DISTR_CONTRACT = IDistr(
 );
 
 interface IDistr {
+    // Events
+    event SetWithdrawAddress(address indexed delegator, address withdrawAddress);
+
+    // amount is the withdrawn ukii, one event is emitted per validator
+    event WithdrawDelegationRewards(address indexed delegator, string validator, uint256 amount);
+
     // Transactions
     function setWithdrawAddress(address withdrawAddr) external returns (bool success);
 
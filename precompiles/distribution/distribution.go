@@ -24,6 +24,11 @@ const (
 	RewardsMethod                           = "rewards"
 )
 
+const (
+	SetWithdrawAddressEvent        = "SetWithdrawAddress"
+	WithdrawDelegationRewardsEvent = "WithdrawDelegationRewards"
+)
+
 const (
 	DistrAddress = "0x0000000000000000000000000000000000001007"
 )
@@ -37,6 +42,7 @@ type PrecompileExecutor struct {
 	distrKeeper pcommon.DistributionKeeper
 	evmKeeper   pcommon.EVMKeeper
 	address     common.Address
+	events      map[string]abi.Event
 
 	SetWithdrawAddrID                   []byte
 	WithdrawDelegationRewardsID         []byte
@@ -51,6 +57,7 @@ func NewPrecompile(distrKeeper pcommon.DistributionKeeper, evmKeeper pcommon.EVM
 		distrKeeper: distrKeeper,
 		evmKeeper:   evmKeeper,
 		address:     common.HexToAddress(DistrAddress),
+		events:      newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -78,17 +85,17 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 		if readOnly {
 			return nil, 0, errors.New("cannot call distr precompile from staticcall")
 		}
-		return p.setWithdrawAddress(ctx, method, caller, args, value)
+		return p.setWithdrawAddress(ctx, method, caller, args, value, evm)
 	case WithdrawDelegationRewardsMethod:
 		if readOnly {
 			return nil, 0, errors.New("cannot call distr precompile from staticcall")
 		}
-		return p.withdrawDelegationRewards(ctx, method, caller, args, value)
+		return p.withdrawDelegationRewards(ctx, method, caller, args, value, evm)
 	case WithdrawMultipleDelegationRewardsMethod:
 		if readOnly {
 			return nil, 0, errors.New("cannot call distr precompile from staticcall")
 		}
-		return p.withdrawMultipleDelegationRewards(ctx, method, caller, args, value)
+		return p.withdrawMultipleDelegationRewards(ctx, method, caller, args, value, evm)
 	case RewardsMethod:
 		return p.rewards(ctx, method, args)
 	}
@@ -99,7 +106,7 @@ func (p PrecompileExecutor) EVMKeeper() pcommon.EVMKeeper {
 	return p.evmKeeper
 }
 
-func (p PrecompileExecutor) setWithdrawAddress(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) setWithdrawAddress(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -132,12 +139,16 @@ func (p PrecompileExecutor) setWithdrawAddress(ctx sdk.Context, method *abi.Meth
 		rerr = err
 		return
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[SetWithdrawAddressEvent], caller, args[0]); err != nil {
+		rerr = err
+		return
+	}
 	ret, rerr = method.Outputs.Pack(true)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
 }
 
-func (p PrecompileExecutor) withdrawDelegationRewards(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) withdrawDelegationRewards(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -157,8 +168,7 @@ func (p PrecompileExecutor) withdrawDelegationRewards(ctx sdk.Context, method *a
 		rerr = err
 		return
 	}
-	_, err = p.withdraw(ctx, delegator, args[0].(string))
-	if err != nil {
+	if err = p.withdraw(ctx, evm, caller, delegator, args[0].(string)); err != nil {
 		rerr = err
 		return
 	}
@@ -179,12 +189,16 @@ func (p PrecompileExecutor) validateInput(value *big.Int, args []interface{}, ex
 	return nil
 }
 
-func (p PrecompileExecutor) withdraw(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string) (sdk.Coins, error) {
+func (p PrecompileExecutor) withdraw(ctx sdk.Context, evm *vm.EVM, caller common.Address, delegator sdk.AccAddress, validatorAddress string) error {
 	validator, err := sdk.ValAddressFromBech32(validatorAddress)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	rewards, err := p.distrKeeper.WithdrawDelegationRewards(ctx, delegator, validator)
+	if err != nil {
+		return err
 	}
-	return p.distrKeeper.WithdrawDelegationRewards(ctx, delegator, validator)
+	return pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[WithdrawDelegationRewardsEvent], caller, validatorAddress, rewards.AmountOf(sdk.MustGetBaseDenom()).BigInt())
 }
 
 func (p PrecompileExecutor) getDelegator(ctx sdk.Context, caller common.Address) (sdk.AccAddress, error) {
@@ -196,7 +210,7 @@ func (p PrecompileExecutor) getDelegator(ctx sdk.Context, caller common.Address)
 	return delegator, nil
 }
 
-func (p PrecompileExecutor) withdrawMultipleDelegationRewards(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) withdrawMultipleDelegationRewards(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -218,8 +232,7 @@ func (p PrecompileExecutor) withdrawMultipleDelegationRewards(ctx sdk.Context, m
 	}
 	validators := args[0].([]string)
 	for _, valAddr := range validators {
-		_, err := p.withdraw(ctx, delegator, valAddr)
-		if err != nil {
+		if err := p.withdraw(ctx, evm, caller, delegator, valAddr); err != nil {
 			rerr = err
 			return
 		}
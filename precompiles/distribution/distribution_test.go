@@ -148,7 +148,7 @@ func TestWithdraw(t *testing.T) {
 	res, err = msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), req)
 	require.Nil(t, err)
 	require.Empty(t, res.VmError)
-	require.Equal(t, uint64(64124), res.GasUsed)
+	require.Equal(t, uint64(66529), res.GasUsed)
 
 	// reinitialized
 	d, found = testApp.StakingKeeper.GetDelegation(ctx, kiiAddr, val)
@@ -301,7 +301,7 @@ func setWithdrawAddressAndWithdraw(
 	res, err = msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), r)
 	require.Nil(t, err)
 	require.Empty(t, res.VmError)
-	require.Equal(t, uint64(148290), res.GasUsed)
+	require.Equal(t, uint64(155505), res.GasUsed)
 
 	// reinitialized
 	for _, val := range vals {
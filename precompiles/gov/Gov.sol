@@ -8,6 +8,32 @@ IGov constant GOV_CONTRACT = IGov(
 );
 
 interface IGov {
+    // Events
+    event Vote(
+        uint64 indexed proposalID,
+        address indexed voter,
+        int32 option
+    );
+
+    event Deposit(
+        uint64 indexed proposalID,
+        address indexed depositor,
+        uint256 amount
+    );
+
+    event VoteWeighted(
+        uint64 indexed proposalID,
+        address indexed voter,
+        WeightedVoteOption[] options
+    );
+
+    event SubmitProposal(
+        uint64 indexed proposalID,
+        address indexed proposer,
+        string proposalType,
+        uint256 deposit
+    );
+
     // Transactions
     function vote(
         uint64 proposalID,
@@ -17,4 +43,95 @@ interface IGov {
     function deposit(
         uint64 proposalID
     ) payable external returns (bool success);
+
+    // Weights are decimal strings, e.g. "0.5", and must add up to 1.
+    function voteWeighted(
+        uint64 proposalID,
+        WeightedVoteOption[] memory options
+    ) external returns (bool success);
+
+    // proposal is a JSON object whose "type" selects the content: "Text",
+    // "ParameterChange" or one of the x/evm pointer proposals, e.g.
+    // {"type":"Text","title":"...","description":"...","is_expedited":false}
+    // msg.value, if any, is deposited on the new proposal.
+    function submitProposal(
+        string memory proposal
+    ) payable external returns (uint64 proposalID);
+
+    // Queries
+    function proposal(
+        uint64 proposalID
+    ) external view returns (Proposal proposal);
+
+    // status 0 returns proposals of any status
+    function proposals(
+        int32 status,
+        PageRequest memory pagination
+    ) external view returns (ProposalsResponse response);
+
+    function tally(
+        uint64 proposalID
+    ) external view returns (TallyResult tally);
+
+    function deposit(
+        uint64 proposalID,
+        address depositor
+    ) external view returns (Deposit deposit);
+
+    struct WeightedVoteOption {
+        int32 option;
+        string weight;
+    }
+
+    struct Coin {
+        uint256 amount;
+        string denom;
+    }
+
+    struct TallyResult {
+        uint256 yes;
+        uint256 abstain;
+        uint256 no;
+        uint256 noWithVeto;
+    }
+
+    struct Proposal {
+        uint64 proposalID;
+        string title;
+        string description;
+        string proposalType;
+        int32 status;
+        TallyResult finalTallyResult;
+        int64 submitTime;
+        int64 depositEndTime;
+        Coin[] totalDeposit;
+        int64 votingStartTime;
+        int64 votingEndTime;
+        bool isExpedited;
+    }
+
+    struct ProposalsResponse {
+        Proposal[] proposals;
+        PageResponse pagination;
+    }
+
+    struct Deposit {
+        uint64 proposalID;
+        string depositor;
+        Coin[] amount;
+    }
+
+    // limit is capped at 100, a zero limit uses the default page size of 100
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
 }
\ No newline at end of file
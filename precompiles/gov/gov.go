@@ -3,11 +3,14 @@ package gov
 import (
 	"bytes"
 	"embed"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -16,8 +19,20 @@ import (
 )
 
 const (
-	VoteMethod    = "vote"
-	DepositMethod = "deposit"
+	VoteMethod           = "vote"
+	VoteWeightedMethod   = "voteWeighted"
+	DepositMethod        = "deposit"
+	SubmitProposalMethod = "submitProposal"
+	ProposalMethod       = "proposal"
+	ProposalsMethod      = "proposals"
+	TallyMethod          = "tally"
+)
+
+const (
+	VoteEvent           = "Vote"
+	VoteWeightedEvent   = "VoteWeighted"
+	DepositEvent        = "Deposit"
+	SubmitProposalEvent = "SubmitProposal"
 )
 
 const (
@@ -34,9 +49,31 @@ type PrecompileExecutor struct {
 	evmKeeper  pcommon.EVMKeeper
 	bankKeeper pcommon.BankKeeper
 	address    common.Address
+	events     map[string]abi.Event
+
+	VoteID           []byte
+	VoteWeightedID   []byte
+	DepositID        []byte
+	SubmitProposalID []byte
+	ProposalID       []byte
+	ProposalsID      []byte
+	TallyID          []byte
+	// DepositQueryID is the `deposit(proposalID, depositor)` view, an overload
+	// of the payable `deposit(proposalID)`.
+	DepositQueryID []byte
+}
 
-	VoteID    []byte
-	DepositID []byte
+// proposalContents maps the proposal types accepted by submitProposal to the
+// content they are decoded into.
+var proposalContents = map[string]func() govtypes.Content{
+	govtypes.ProposalTypeText:               func() govtypes.Content { return &govtypes.TextProposal{} },
+	paramsproposal.ProposalTypeChange:       func() govtypes.Content { return &paramsproposal.ParameterChangeProposal{} },
+	types.ProposalTypeAddERCNativePointer:   func() govtypes.Content { return &types.AddERCNativePointerProposal{} },
+	types.ProposalTypeAddERCCW20Pointer:     func() govtypes.Content { return &types.AddERCCW20PointerProposal{} },
+	types.ProposalTypeAddERCCW721Pointer:    func() govtypes.Content { return &types.AddERCCW721PointerProposal{} },
+	types.ProposalTypeAddCWERC20Pointer:     func() govtypes.Content { return &types.AddCWERC20PointerProposal{} },
+	types.ProposalTypeAddCWERC721Pointer:    func() govtypes.Content { return &types.AddCWERC721PointerProposal{} },
+	types.ProposalTypeAddERCNativePointerV2: func() govtypes.Content { return &types.AddERCNativePointerProposalV2{} },
 }
 
 func NewPrecompile(govKeeper pcommon.GovKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper) (*pcommon.Precompile, error) {
@@ -47,14 +84,29 @@ func NewPrecompile(govKeeper pcommon.GovKeeper, evmKeeper pcommon.EVMKeeper, ban
 		evmKeeper:  evmKeeper,
 		address:    common.HexToAddress(GovAddress),
 		bankKeeper: bankKeeper,
+		events:     newAbi.Events,
 	}
 
-	for name, m := range newAbi.Methods {
-		switch name {
+	for _, m := range newAbi.Methods {
+		switch m.RawName {
 		case VoteMethod:
 			p.VoteID = m.ID
+		case VoteWeightedMethod:
+			p.VoteWeightedID = m.ID
 		case DepositMethod:
-			p.DepositID = m.ID
+			if len(m.Inputs) == 1 {
+				p.DepositID = m.ID
+			} else {
+				p.DepositQueryID = m.ID
+			}
+		case SubmitProposalMethod:
+			p.SubmitProposalID = m.ID
+		case ProposalMethod:
+			p.ProposalID = m.ID
+		case ProposalsMethod:
+			p.ProposalsID = m.ID
+		case TallyMethod:
+			p.TallyID = m.ID
 		}
 	}
 
@@ -65,8 +117,12 @@ func NewPrecompile(govKeeper pcommon.GovKeeper, evmKeeper pcommon.EVMKeeper, ban
 func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64 {
 	if bytes.Equal(method.ID, p.VoteID) {
 		return 30000
+	} else if bytes.Equal(method.ID, p.VoteWeightedID) {
+		return 30000
 	} else if bytes.Equal(method.ID, p.DepositID) {
 		return 30000
+	} else if bytes.Equal(method.ID, p.SubmitProposalID) {
+		return 50000
 	}
 
 	// This should never happen since this is going to fail during Run
@@ -74,23 +130,45 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 }
 
 func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (bz []byte, err error) {
-	if readOnly {
-		return nil, errors.New("cannot call gov precompile from staticcall")
-	}
 	if ctx.EVMPrecompileCalledFromDelegateCall() {
 		return nil, errors.New("cannot delegatecall gov")
 	}
+	if bytes.Equal(method.ID, p.DepositQueryID) {
+		return p.depositQuery(ctx, method, args, value)
+	}
 
 	switch method.Name {
 	case VoteMethod:
-		return p.vote(ctx, method, caller, args, value)
+		if readOnly {
+			return nil, errors.New("cannot call gov precompile from staticcall")
+		}
+		return p.vote(ctx, method, caller, args, value, evm)
+	case VoteWeightedMethod:
+		if readOnly {
+			return nil, errors.New("cannot call gov precompile from staticcall")
+		}
+		return p.voteWeighted(ctx, method, caller, args, value, evm)
 	case DepositMethod:
-		return p.deposit(ctx, method, caller, args, value)
+		if readOnly {
+			return nil, errors.New("cannot call gov precompile from staticcall")
+		}
+		return p.deposit(ctx, method, caller, args, value, evm)
+	case SubmitProposalMethod:
+		if readOnly {
+			return nil, errors.New("cannot call gov precompile from staticcall")
+		}
+		return p.submitProposal(ctx, method, caller, args, value, evm)
+	case ProposalMethod:
+		return p.proposal(ctx, method, args, value)
+	case ProposalsMethod:
+		return p.proposals(ctx, method, args, value)
+	case TallyMethod:
+		return p.tally(ctx, method, args, value)
 	}
 	return
 }
 
-func (p PrecompileExecutor) vote(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) vote(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		return nil, err
 	}
@@ -108,10 +186,13 @@ func (p PrecompileExecutor) vote(ctx sdk.Context, method *abi.Method, caller com
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[VoteEvent], proposalID, caller, voteOption); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
 
-func (p PrecompileExecutor) deposit(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) deposit(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
 		return nil, err
 	}
@@ -131,5 +212,279 @@ func (p PrecompileExecutor) deposit(ctx sdk.Context, method *abi.Method, caller
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[DepositEvent], proposalID, caller, coin.Amount.BigInt()); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(res)
 }
+
+type WeightedVoteOption struct {
+	Option int32  `json:"option"`
+	Weight string `json:"weight"`
+}
+
+// voteWeighted splits the caller's vote across options. Weights are decimal
+// strings that must add up to 1.
+func (p PrecompileExecutor) voteWeighted(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	voter, found := p.evmKeeper.GetKiiAddress(ctx, caller)
+	if !found {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	proposalID := args[0].(uint64)
+	weightedOptions := args[1].([]struct {
+		Option int32  `json:"option"`
+		Weight string `json:"weight"`
+	})
+	options := make(govtypes.WeightedVoteOptions, 0, len(weightedOptions))
+	eventOptions := make([]WeightedVoteOption, 0, len(weightedOptions))
+	for _, o := range weightedOptions {
+		weight, err := sdk.NewDecFromStr(o.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %s: %w", o.Weight, err)
+		}
+		options = append(options, govtypes.WeightedVoteOption{Option: govtypes.VoteOption(o.Option), Weight: weight})
+		eventOptions = append(eventOptions, WeightedVoteOption(o))
+	}
+	if err := govtypes.NewMsgVoteWeighted(voter, proposalID, options).ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := p.govKeeper.AddVote(ctx, proposalID, voter, options); err != nil {
+		return nil, err
+	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[VoteWeightedEvent], proposalID, caller, eventOptions); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// submitProposal submits the JSON encoded proposal and deposits `value` on it.
+// The `type` field selects the content, e.g.
+// {"type":"Text","title":"...","description":"...","is_expedited":false} or
+// {"type":"ParameterChange","title":"...","description":"...","changes":[{"subspace":"...","key":"...","value":"..."}]}.
+func (p PrecompileExecutor) submitProposal(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	proposer, found := p.evmKeeper.GetKiiAddress(ctx, caller)
+	if !found {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	proposalJSON := []byte(args[0].(string))
+	header := struct {
+		Type        string `json:"type"`
+		IsExpedited bool   `json:"is_expedited"`
+	}{}
+	if err := json.Unmarshal(proposalJSON, &header); err != nil {
+		return nil, fmt.Errorf("invalid proposal: %w", err)
+	}
+	newContent, ok := proposalContents[header.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported proposal type %s", header.Type)
+	}
+	content := newContent()
+	if err := json.Unmarshal(proposalJSON, content); err != nil {
+		return nil, fmt.Errorf("invalid %s proposal: %w", header.Type, err)
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	proposal, err := p.govKeeper.SubmitProposalWithExpedite(ctx, content, header.IsExpedited)
+	if err != nil {
+		return nil, err
+	}
+	deposit := sdk.NewCoin(p.evmKeeper.GetBaseDenom(ctx), sdk.ZeroInt())
+	if value != nil && value.Sign() > 0 {
+		deposit, err = pcommon.HandlePaymentUkii(ctx, p.evmKeeper.GetKiiAddressOrDefault(ctx, p.address), proposer, value, p.bankKeeper)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.govKeeper.AddDeposit(ctx, proposal.ProposalId, proposer, sdk.NewCoins(deposit)); err != nil {
+			return nil, err
+		}
+	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[SubmitProposalEvent], proposal.ProposalId, caller, content.ProposalType(), deposit.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(proposal.ProposalId)
+}
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type TallyResult struct {
+	Yes        *big.Int
+	Abstain    *big.Int
+	No         *big.Int
+	NoWithVeto *big.Int
+}
+
+type Proposal struct {
+	ProposalID       uint64
+	Title            string
+	Description      string
+	ProposalType     string
+	Status           int32
+	FinalTallyResult TallyResult
+	SubmitTime       int64
+	DepositEndTime   int64
+	TotalDeposit     []Coin
+	VotingStartTime  int64
+	VotingEndTime    int64
+	IsExpedited      bool
+}
+
+type ProposalsResponse struct {
+	Proposals  []Proposal
+	Pagination pcommon.PageResponse
+}
+
+type Deposit struct {
+	ProposalID uint64
+	Depositor  string
+	Amount     []Coin
+}
+
+func (p PrecompileExecutor) proposal(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+
+	proposalResponse, err := p.govKeeper.Proposal(sdk.WrapSDKContext(ctx), &govtypes.QueryProposalRequest{
+		ProposalId: args[0].(uint64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(convertProposal(proposalResponse.Proposal))
+}
+
+func (p PrecompileExecutor) proposals(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+
+	pagination, err := pcommon.ParsePageRequest(args[1])
+	if err != nil {
+		return nil, err
+	}
+	proposalsResponse, err := p.govKeeper.Proposals(sdk.WrapSDKContext(ctx), &govtypes.QueryProposalsRequest{
+		ProposalStatus: govtypes.ProposalStatus(args[0].(int32)),
+		Pagination:     pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := ProposalsResponse{
+		Proposals:  make([]Proposal, 0, len(proposalsResponse.Proposals)),
+		Pagination: pcommon.NewPageResponse(proposalsResponse.Pagination),
+	}
+	for _, proposal := range proposalsResponse.Proposals {
+		response.Proposals = append(response.Proposals, convertProposal(proposal))
+	}
+
+	return method.Outputs.Pack(response)
+}
+
+func (p PrecompileExecutor) tally(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+
+	tallyResponse, err := p.govKeeper.TallyResult(sdk.WrapSDKContext(ctx), &govtypes.QueryTallyResultRequest{
+		ProposalId: args[0].(uint64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(convertTallyResult(tallyResponse.Tally))
+}
+
+func (p PrecompileExecutor) depositQuery(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+
+	depositor, err := pcommon.GetKiiAddressFromArg(ctx, args[1], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	depositResponse, err := p.govKeeper.Deposit(sdk.WrapSDKContext(ctx), &govtypes.QueryDepositRequest{
+		ProposalId: args[0].(uint64),
+		Depositor:  depositor.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(Deposit{
+		ProposalID: depositResponse.Deposit.ProposalId,
+		Depositor:  depositResponse.Deposit.Depositor,
+		Amount:     convertCoins(depositResponse.Deposit.Amount),
+	})
+}
+
+func convertProposal(proposal govtypes.Proposal) Proposal {
+	res := Proposal{
+		ProposalID:       proposal.ProposalId,
+		Status:           int32(proposal.Status),
+		FinalTallyResult: convertTallyResult(proposal.FinalTallyResult),
+		SubmitTime:       proposal.SubmitTime.Unix(),
+		DepositEndTime:   proposal.DepositEndTime.Unix(),
+		TotalDeposit:     convertCoins(proposal.TotalDeposit),
+		VotingStartTime:  proposal.VotingStartTime.Unix(),
+		VotingEndTime:    proposal.VotingEndTime.Unix(),
+		IsExpedited:      proposal.IsExpedited,
+	}
+	if content := proposal.GetContent(); content != nil {
+		res.Title = content.GetTitle()
+		res.Description = content.GetDescription()
+		res.ProposalType = content.ProposalType()
+	}
+	return res
+}
+
+func convertTallyResult(tally govtypes.TallyResult) TallyResult {
+	return TallyResult{
+		Yes:        tally.Yes.BigInt(),
+		Abstain:    tally.Abstain.BigInt(),
+		No:         tally.No.BigInt(),
+		NoWithVeto: tally.NoWithVeto.BigInt(),
+	}
+}
+
+func convertCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, Coin{Amount: coin.Amount.BigInt(), Denom: coin.Denom})
+	}
+	return res
+}
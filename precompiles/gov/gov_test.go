@@ -3,13 +3,16 @@ package gov_test
 import (
 	"embed"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -19,6 +22,7 @@ import (
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/kiichain/kiichain/x/evm/ante"
 	"github.com/kiichain/kiichain/x/evm/keeper"
+	"github.com/kiichain/kiichain/x/evm/state"
 	evmtypes "github.com/kiichain/kiichain/x/evm/types"
 	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 )
@@ -54,6 +58,7 @@ func TestGovPrecompile(t *testing.T) {
 		setup          func(ctx sdk.Context, k *keeper.Keeper, evmAddr common.Address, kiiAddr sdk.AccAddress)
 		verify         func(t *testing.T, ctx sdk.Context, kiiAddr sdk.AccAddress, proposalID uint64)
 		wantErr        bool
+		wantEvent      string
 		avoidAssociate bool
 	}{
 		{
@@ -72,7 +77,8 @@ func TestGovPrecompile(t *testing.T) {
 				proposal, _ := testApp.GovKeeper.GetProposal(ctx, proposalID)
 				require.Equal(t, govtypes.StatusVotingPeriod, proposal.Status)
 			},
-			wantErr: false,
+			wantErr:   false,
+			wantEvent: gov.DepositEvent,
 		},
 		{
 			name: "successful vote yes",
@@ -94,7 +100,8 @@ func TestGovPrecompile(t *testing.T) {
 				require.Equal(t, govtypes.OptionYes, v.Options[0].Option)
 				require.Equal(t, sdk.OneDec(), v.Options[0].Weight)
 			},
-			wantErr: false,
+			wantErr:   false,
+			wantEvent: gov.VoteEvent,
 		},
 		{
 			name: "association missing for vote",
@@ -188,7 +195,148 @@ func TestGovPrecompile(t *testing.T) {
 				require.Nil(t, err)
 				require.Empty(t, res.VmError)
 				tt.verify(t, ctx, kiiAddr, tt.args.proposal)
+				require.Len(t, res.Logs, 1)
+				require.Equal(t, addr.Hex(), res.Logs[0].Address)
+				require.Equal(t, []string{
+					abi.Events[tt.wantEvent].ID.Hex(),
+					common.BigToHash(new(big.Int).SetUint64(tt.args.proposal)).Hex(),
+					common.BytesToHash(evmAddr.Bytes()).Hex(),
+				}, res.Logs[0].Topics)
 			}
 		})
 	}
 }
+
+func TestGovPrecompileSubmitAndQuery(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	abi := pcommon.MustGetABI(f, "abi.json")
+
+	privKey := testkeeper.MockPrivateKey()
+	key, err := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	require.Nil(t, err)
+	kiiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(20000000000000000)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, evmtypes.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, kiiAddr, amt))
+
+	// a text proposal with a deposit paid from msg.value
+	args, err := abi.Pack("submitProposal", `{"type":"Text","title":"title","description":"description"}`)
+	require.Nil(t, err)
+	res := testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(1_000_000_000_000_000), 0)
+	require.Empty(t, res.VmError)
+	out, err := abi.Unpack("submitProposal", res.ReturnData)
+	require.Nil(t, err)
+	textProposalID := out[0].(uint64)
+	proposal, found := testApp.GovKeeper.GetProposal(ctx, textProposalID)
+	require.True(t, found)
+	require.Equal(t, "title", proposal.GetTitle())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(1000))), proposal.TotalDeposit)
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, []string{
+		abi.Events["SubmitProposal"].ID.Hex(),
+		common.BigToHash(new(big.Int).SetUint64(textProposalID)).Hex(),
+		common.BytesToHash(evmAddr.Bytes()).Hex(),
+	}, res.Logs[0].Topics)
+
+	// a parameter change proposal without a deposit
+	args, err = abi.Pack("submitProposal", `{"type":"ParameterChange","title":"title","description":"description","changes":[{"subspace":"staking","key":"MaxValidators","value":"105"}]}`)
+	require.Nil(t, err)
+	res = testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(0), 1)
+	require.Empty(t, res.VmError)
+	out, err = abi.Unpack("submitProposal", res.ReturnData)
+	require.Nil(t, err)
+	paramProposalID := out[0].(uint64)
+	proposal, _ = testApp.GovKeeper.GetProposal(ctx, paramProposalID)
+	require.Equal(t, "ParameterChange", proposal.ProposalType())
+	require.True(t, proposal.TotalDeposit.Empty())
+
+	// unknown types and invalid contents are rejected
+	args, err = abi.Pack("submitProposal", `{"type":"SoftwareUpgrade","title":"title","description":"description"}`)
+	require.Nil(t, err)
+	require.NotEmpty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(0), 2).VmError)
+	args, err = abi.Pack("submitProposal", `{"type":"AddERCCW20Pointer","title":"title","description":"description","pointee":"kii1","pointer":"not-hex"}`)
+	require.Nil(t, err)
+	require.NotEmpty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(0), 3).VmError)
+
+	// weighted vote on a proposal in its voting period
+	testApp.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	type weightedVoteOption = struct {
+		Option int32  `json:"option"`
+		Weight string `json:"weight"`
+	}
+	args, err = abi.Pack("voteWeighted", paramProposalID, []weightedVoteOption{
+		{Option: int32(govtypes.OptionYes), Weight: "0.7"},
+		{Option: int32(govtypes.OptionAbstain), Weight: "0.2"},
+	})
+	require.Nil(t, err)
+	require.NotEmpty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(0), 4).VmError, "weights must add up to 1")
+	args, err = abi.Pack("voteWeighted", paramProposalID, []weightedVoteOption{
+		{Option: int32(govtypes.OptionYes), Weight: "0.7"},
+		{Option: int32(govtypes.OptionAbstain), Weight: "0.3"},
+	})
+	require.Nil(t, err)
+	res = testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(gov.GovAddress), args, big.NewInt(0), 5)
+	require.Empty(t, res.VmError)
+	vote, found := testApp.GovKeeper.GetVote(ctx, paramProposalID, kiiAddr)
+	require.True(t, found)
+	require.Equal(t, []govtypes.WeightedVoteOption{
+		{Option: govtypes.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: govtypes.OptionAbstain, Weight: sdk.NewDecWithPrec(3, 1)},
+	}, vote.Options)
+	require.Len(t, res.Logs, 1)
+	require.Equal(t, abi.Events["VoteWeighted"].ID.Hex(), res.Logs[0].Topics[0])
+
+	// queries
+	p, err := gov.NewPrecompile(testApp.GovKeeper, k, nil)
+	require.Nil(t, err)
+	evm := vm.EVM{StateDB: state.NewDBImpl(ctx, k, true)}
+	query := func(method string, args ...interface{}) interface{} {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false)
+		require.Nil(t, err, string(ret))
+		out, err := p.ABI.Methods[method].Outputs.Unpack(ret)
+		require.Nil(t, err)
+		return out[0]
+	}
+
+	gotProposal := *ethabi.ConvertType(query("proposal", textProposalID), new(gov.Proposal)).(*gov.Proposal)
+	require.Equal(t, textProposalID, gotProposal.ProposalID)
+	require.Equal(t, "title", gotProposal.Title)
+	require.Equal(t, govtypes.ProposalTypeText, gotProposal.ProposalType)
+	require.Equal(t, int32(govtypes.StatusDepositPeriod), gotProposal.Status)
+	require.Equal(t, []gov.Coin{{Amount: big.NewInt(1000), Denom: k.GetBaseDenom(ctx)}}, gotProposal.TotalDeposit)
+
+	gotProposals := *ethabi.ConvertType(query("proposals", int32(govtypes.StatusVotingPeriod), pcommon.PageRequest{CountTotal: true}), new(gov.ProposalsResponse)).(*gov.ProposalsResponse)
+	require.NotEmpty(t, gotProposals.Proposals)
+	require.Equal(t, uint64(len(gotProposals.Proposals)), gotProposals.Pagination.Total)
+	for _, proposal := range gotProposals.Proposals {
+		require.Equal(t, int32(govtypes.StatusVotingPeriod), proposal.Status)
+	}
+
+	gotTally := *ethabi.ConvertType(query("tally", paramProposalID), new(gov.TallyResult)).(*gov.TallyResult)
+	require.Equal(t, 0, gotTally.NoWithVeto.Sign())
+
+	depositQuery := p.ABI.Methods["deposit0"]
+	require.Equal(t, "deposit", depositQuery.RawName)
+	require.Len(t, depositQuery.Inputs, 2)
+	gotDeposit := *ethabi.ConvertType(query("deposit0", textProposalID, evmAddr), new(gov.Deposit)).(*gov.Deposit)
+	require.Equal(t, kiiAddr.String(), gotDeposit.Depositor)
+	require.Equal(t, []gov.Coin{{Amount: big.NewInt(1000), Denom: k.GetBaseDenom(ctx)}}, gotDeposit.Amount)
+
+	input, err := p.ABI.Pack("proposals", int32(0), pcommon.PageRequest{Limit: pcommon.MaxPageLimit + 1})
+	require.Nil(t, err)
+	ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false)
+	require.Equal(t, vm.ErrExecutionReverted, err)
+	require.Equal(t, fmt.Sprintf("page limit must not exceed %d", pcommon.MaxPageLimit), string(ret))
+
+	// transactions are still rejected from a static call
+	input, err = p.ABI.Pack("voteWeighted", paramProposalID, []weightedVoteOption{{Option: int32(govtypes.OptionYes), Weight: "1"}})
+	require.Nil(t, err)
+	ret, err = p.Run(&evm, evmAddr, evmAddr, input, nil, true, false)
+	require.Equal(t, vm.ErrExecutionReverted, err)
+	require.Equal(t, "cannot call gov precompile from staticcall", string(ret))
+}
@@ -8,6 +8,17 @@ IBC constant IBC_CONTRACT = IBC(
 );
 
 interface IBC {
+    // Events
+    event IBCTransfer(
+        address indexed sender,
+        string receiver,
+        string port,
+        string channel,
+        string denom,
+        uint256 amount,
+        uint64 sequence
+    );
+
     // Transactions
     function transfer(
         string toAddress,
@@ -13,6 +13,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	pcommon "github.com/kiichain/kiichain/precompiles/common"
@@ -24,6 +25,10 @@ const (
 	TransferWithDefaultTimeoutMethod = "transferWithDefaultTimeout"
 )
 
+const (
+	IBCTransferEvent = "IBCTransfer"
+)
+
 const (
 	IBCAddress = "0x0000000000000000000000000000000000001009"
 )
@@ -39,6 +44,8 @@ type PrecompileExecutor struct {
 	clientKeeper     pcommon.ClientKeeper
 	connectionKeeper pcommon.ConnectionKeeper
 	channelKeeper    pcommon.ChannelKeeper
+	address          common.Address
+	events           map[string]abi.Event
 
 	TransferID                   []byte
 	TransferWithDefaultTimeoutID []byte
@@ -58,6 +65,8 @@ func NewPrecompile(
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
 		channelKeeper:    channelKeeper,
+		address:          common.HexToAddress(IBCAddress),
+		events:           newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -69,7 +78,7 @@ func NewPrecompile(
 		}
 	}
 
-	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(IBCAddress), "ibc"), nil
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, p.address, "ibc"), nil
 }
 
 func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64) (ret []byte, remainingGas uint64, err error) {
@@ -82,9 +91,9 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 
 	switch method.Name {
 	case TransferMethod:
-		return p.transfer(ctx, method, args, caller)
+		return p.transfer(ctx, method, args, caller, evm)
 	case TransferWithDefaultTimeoutMethod:
-		return p.transferWithDefaultTimeout(ctx, method, args, caller)
+		return p.transferWithDefaultTimeout(ctx, method, args, caller, evm)
 	}
 	return
 }
@@ -93,7 +102,7 @@ func (p PrecompileExecutor) EVMKeeper() pcommon.EVMKeeper {
 	return p.evmKeeper
 }
 
-func (p PrecompileExecutor) transfer(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) transfer(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -166,18 +175,22 @@ func (p PrecompileExecutor) transfer(ctx sdk.Context, method *abi.Method, args [
 		return
 	}
 
-	_, err = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
+	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
 
 	if err != nil {
 		rerr = err
 		return
 	}
+	if err = p.emitTransfer(ctx, evm, caller, msg, res.GetSequence()); err != nil {
+		rerr = err
+		return
+	}
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	ret, rerr = method.Outputs.Pack(true)
 	return
 }
 
-func (p PrecompileExecutor) transferWithDefaultTimeout(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) transferWithDefaultTimeout(ctx sdk.Context, method *abi.Method, args []interface{}, caller common.Address, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -252,17 +265,25 @@ func (p PrecompileExecutor) transferWithDefaultTimeout(ctx sdk.Context, method *
 		return
 	}
 
-	_, err = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
+	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
 
 	if err != nil {
 		rerr = err
 		return
 	}
+	if err = p.emitTransfer(ctx, evm, caller, msg, res.GetSequence()); err != nil {
+		rerr = err
+		return
+	}
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	ret, rerr = method.Outputs.Pack(true)
 	return
 }
 
+func (p PrecompileExecutor) emitTransfer(ctx sdk.Context, evm *vm.EVM, caller common.Address, msg types.MsgTransfer, sequence uint64) error {
+	return pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[IBCTransferEvent], caller, msg.Receiver, msg.SourcePort, msg.SourceChannel, msg.Token.Denom, msg.Token.Amount.BigInt(), sequence)
+}
+
 func (p PrecompileExecutor) accAddressFromArg(ctx sdk.Context, arg interface{}) (sdk.AccAddress, error) {
 	addr := arg.(common.Address)
 	if addr == (common.Address{}) {
@@ -348,7 +369,12 @@ type ValidatedArgs struct {
 func (p PrecompileExecutor) validateCommonArgs(ctx sdk.Context, args []interface{}, caller common.Address) (*ValidatedArgs, error) {
 	senderKiiAddr, ok := p.evmKeeper.GetKiiAddress(ctx, caller)
 	if !ok {
-		return nil, errors.New("caller is not a valid KII address")
+		// contracts can't associate, they send from their cast address so that
+		// the ibc hooks can deliver their packet callbacks
+		if codeHash := p.evmKeeper.GetCodeHash(ctx, caller); codeHash == (common.Hash{}) || codeHash == ethtypes.EmptyCodeHash {
+			return nil, errors.New("caller is not a valid KII address")
+		}
+		senderKiiAddr = p.evmKeeper.GetKiiAddressOrDefault(ctx, caller)
 	}
 
 	receiverAddressString, ok := args[0].(string)
@@ -107,7 +107,7 @@ func TestPrecompile_Run(t *testing.T) {
 			fields:           fields{transferKeeper: &MockTransferKeeper{}},
 			args:             commonArgs,
 			wantBz:           packedTrue,
-			wantRemainingGas: 998877,
+			wantRemainingGas: 993912,
 			wantErr:          false,
 		},
 		{
@@ -236,7 +236,7 @@ func TestPrecompile_Run(t *testing.T) {
 				value:       nil,
 			},
 			wantBz:           packedTrue,
-			wantRemainingGas: 998877,
+			wantRemainingGas: 993912,
 			wantErr:          false,
 		},
 		{
@@ -256,7 +256,7 @@ func TestPrecompile_Run(t *testing.T) {
 				value:       nil,
 			},
 			wantBz:           packedTrue,
-			wantRemainingGas: 998877,
+			wantRemainingGas: 993912,
 			wantErr:          false,
 		},
 	}
@@ -662,3 +662,48 @@ func TestPrecompile_GetAdjustedTimestamp(t *testing.T) {
 		})
 	}
 }
+
+type MockSenderTransferKeeper struct {
+	sender string
+}
+
+func (tk *MockSenderTransferKeeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	tk.sender = msg.Sender
+	return nil, nil
+}
+
+func TestPrecompile_RunFromContract(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	_, contractAddr := testkeeper.MockAddressPair()
+
+	transferKeeper := &MockSenderTransferKeeper{}
+	p, _ := ibc.NewPrecompile(transferKeeper, k, nil, nil, nil)
+	executor := p.GetExecutor().(*ibc.PrecompileExecutor)
+	transfer, err := p.ABI.MethodById(executor.TransferID)
+	require.Nil(t, err)
+	inputs, err := transfer.Inputs.Pack("cosmos1yykwxjzr2tv4mhx5tsf8090sdg96f2ax8fydk2",
+		"transfer", "channel-0", "ukii", big.NewInt(100), uint64(1), uint64(1), uint64(1), "")
+	require.Nil(t, err)
+
+	run := func() ([]byte, error) {
+		evm := vm.EVM{
+			StateDB:   state.NewDBImpl(ctx, k, true),
+			TxContext: vm.TxContext{Origin: contractAddr},
+		}
+		bz, _, err := p.RunAndCalculateGas(&evm, contractAddr, contractAddr, append(executor.TransferID, inputs...), 1000000, nil, nil, false, false)
+		return bz, err
+	}
+
+	// an unassociated account can't send
+	bz, err := run()
+	require.Equal(t, vm.ErrExecutionReverted, err)
+	require.Equal(t, "caller is not a valid KII address", string(bz))
+
+	// a contract sends from its cast address
+	k.SetCode(ctx, contractAddr, []byte{0x60})
+	_, err = run()
+	require.Nil(t, err)
+	require.Equal(t, k.GetKiiAddressOrDefault(ctx, contractAddr).String(), transferKeeper.sender)
+}
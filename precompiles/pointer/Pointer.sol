@@ -6,6 +6,13 @@ address constant POINTER_PRECOMPILE_ADDRESS = 0x00000000000000000000000000000000
 IPointer constant POINTER_CONTRACT = IPointer(POINTER_PRECOMPILE_ADDRESS);
 
 interface IPointer {
+    // pointerType is one of "native", "cw20" or "cw721"
+    event PointerRegistered(
+        address indexed pointer,
+        string pointerType,
+        string pointee
+    );
+
     function addNativePointer(
         string memory token
     ) external returns (address ret);
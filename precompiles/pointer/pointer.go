@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +22,14 @@ const (
 	AddCW721Pointer  = "addCW721Pointer"
 )
 
+const (
+	PointerRegisteredEvent = "PointerRegistered"
+
+	NativePointerType = "native"
+	CW20PointerType   = "cw20"
+	CW721PointerType  = "cw721"
+)
+
 const PointerAddress = "0x000000000000000000000000000000000000100b"
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -34,6 +41,8 @@ type PrecompileExecutor struct {
 	evmKeeper   pcommon.EVMKeeper
 	bankKeeper  pcommon.BankKeeper
 	wasmdKeeper pcommon.WasmdViewKeeper
+	address     common.Address
+	events      map[string]ethabi.Event
 
 	AddNativePointerID []byte
 	AddCW20PointerID   []byte
@@ -47,6 +56,8 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, w
 		evmKeeper:   evmKeeper,
 		bankKeeper:  bankKeeper,
 		wasmdKeeper: wasmdKeeper,
+		address:     common.HexToAddress(PointerAddress),
+		events:      newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -60,7 +71,7 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, w
 		}
 	}
 
-	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(PointerAddress), PrecompileName), nil
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, p.address, PrecompileName), nil
 }
 
 func (p PrecompileExecutor) Execute(ctx sdk.Context, method *ethabi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64) (ret []byte, remainingGas uint64, err error) {
@@ -100,23 +111,13 @@ func (p PrecompileExecutor) AddNative(ctx sdk.Context, method *ethabi.Method, ca
 	if !metadataExists {
 		return nil, 0, fmt.Errorf("denom %s does not have metadata stored and thus can only have its pointer set through gov proposal", token)
 	}
-	name := metadata.Name
-	symbol := metadata.Symbol
-	var decimals uint8
-	for _, denomUnit := range metadata.DenomUnits {
-		if denomUnit.Exponent > uint32(decimals) && denomUnit.Exponent <= math.MaxUint8 {
-			decimals = uint8(denomUnit.Exponent)
-			name = denomUnit.Denom
-			symbol = denomUnit.Denom
-			if len(denomUnit.Aliases) > 0 {
-				name = denomUnit.Aliases[0]
-			}
-		}
-	}
-	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, utils.ERCMetadata{Name: name, Symbol: symbol, Decimals: decimals})
+	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, utils.ERCMetadataFromDenomMetadata(metadata))
 	if err != nil {
 		return nil, 0, err
 	}
+	if err = p.emitPointerRegistered(ctx, evm, contractAddr, NativePointerType, token); err != nil {
+		return nil, 0, err
+	}
 	ret, err = method.Outputs.Pack(contractAddr)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
@@ -148,6 +149,9 @@ func (p PrecompileExecutor) AddCW20(ctx sdk.Context, method *ethabi.Method, call
 	if err != nil {
 		return nil, 0, err
 	}
+	if err = p.emitPointerRegistered(ctx, evm, contractAddr, CW20PointerType, cwAddr); err != nil {
+		return nil, 0, err
+	}
 	ret, err = method.Outputs.Pack(contractAddr)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
@@ -179,7 +183,14 @@ func (p PrecompileExecutor) AddCW721(ctx sdk.Context, method *ethabi.Method, cal
 	if err != nil {
 		return nil, 0, err
 	}
+	if err = p.emitPointerRegistered(ctx, evm, contractAddr, CW721PointerType, cwAddr); err != nil {
+		return nil, 0, err
+	}
 	ret, err = method.Outputs.Pack(contractAddr)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
 }
+
+func (p PrecompileExecutor) emitPointerRegistered(ctx sdk.Context, evm *vm.EVM, pointer common.Address, pointerType string, pointee string) error {
+	return pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[PointerRegisteredEvent], pointer, pointerType, pointee)
+}
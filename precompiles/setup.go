@@ -17,6 +17,7 @@ import (
 	"github.com/kiichain/kiichain/precompiles/pointer"
 	"github.com/kiichain/kiichain/precompiles/pointerview"
 	"github.com/kiichain/kiichain/precompiles/staking"
+	"github.com/kiichain/kiichain/precompiles/tokenfactory"
 	"github.com/kiichain/kiichain/precompiles/wasmd"
 )
 
@@ -46,6 +47,7 @@ func InitializePrecompiles(
 	wasmdViewKeeper common.WasmdViewKeeper,
 	stakingKeeper common.StakingKeeper,
 	stakingQuerier common.StakingQuerier,
+	stakingUnbondingKeeper common.StakingUnbondingKeeper,
 	govKeeper common.GovKeeper,
 	distrKeeper common.DistributionKeeper,
 	transferKeeper common.TransferKeeper,
@@ -54,6 +56,8 @@ func InitializePrecompiles(
 	channelKeeper common.ChannelKeeper,
 	accountKeeper common.AccountKeeper,
 	oracleKeeper common.OracleKeeper,
+	tokenFactoryKeeper common.TokenFactoryKeeper,
+	tokenFactoryQuerier common.TokenFactoryQuerier,
 ) error {
 	SetupMtx.Lock()
 	defer SetupMtx.Unlock()
@@ -76,7 +80,7 @@ func InitializePrecompiles(
 	if err != nil {
 		return err
 	}
-	stakingp, err := staking.NewPrecompile(stakingKeeper, stakingQuerier, evmKeeper, bankKeeper)
+	stakingp, err := staking.NewPrecompile(stakingKeeper, stakingQuerier, stakingUnbondingKeeper, evmKeeper, bankKeeper)
 	if err != nil {
 		return err
 	}
@@ -104,6 +108,10 @@ func InitializePrecompiles(
 	if err != nil {
 		return err
 	}
+	tokenfactoryp, err := tokenfactory.NewPrecompile(tokenFactoryKeeper, tokenFactoryQuerier, evmKeeper)
+	if err != nil {
+		return err
+	}
 
 	PrecompileNamesToInfo[bankp.GetName()] = PrecompileInfo{ABI: bankp.GetABI(), Address: bankp.Address()}
 	PrecompileNamesToInfo[wasmdp.GetName()] = PrecompileInfo{ABI: wasmdp.GetABI(), Address: wasmdp.Address()}
@@ -116,6 +124,7 @@ func InitializePrecompiles(
 	PrecompileNamesToInfo[pointerp.GetName()] = PrecompileInfo{ABI: pointerp.GetABI(), Address: pointerp.Address()}
 	PrecompileNamesToInfo[pointerviewp.GetName()] = PrecompileInfo{ABI: pointerviewp.GetABI(), Address: pointerviewp.Address()}
 	PrecompileNamesToInfo[oraclep.GetName()] = PrecompileInfo{ABI: oraclep.GetABI(), Address: oraclep.Address()}
+	PrecompileNamesToInfo[tokenfactoryp.GetName()] = PrecompileInfo{ABI: tokenfactoryp.GetABI(), Address: tokenfactoryp.Address()}
 	if !dryRun {
 		addPrecompileToVM(bankp)
 		addPrecompileToVM(wasmdp)
@@ -128,6 +137,7 @@ func InitializePrecompiles(
 		addPrecompileToVM(pointerp)
 		addPrecompileToVM(pointerviewp)
 		addPrecompileToVM(oraclep)
+		addPrecompileToVM(tokenfactoryp)
 		Initialized = true
 	}
 	return nil
@@ -136,7 +146,7 @@ func InitializePrecompiles(
 func GetPrecompileInfo(name string) PrecompileInfo {
 	if !Initialized {
 		// Precompile Info does not require any keeper state
-		_ = InitializePrecompiles(true, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_ = InitializePrecompiles(true, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	}
 	i, ok := PrecompileNamesToInfo[name]
 	if !ok {
@@ -8,6 +8,44 @@ IStaking constant STAKING_CONTRACT = IStaking(
 );
 
 interface IStaking {
+    // Events
+    event Delegate(
+        address indexed delegator,
+        string validator,
+        uint256 amount
+    );
+
+    event Redelegate(
+        address indexed delegator,
+        string srcValidator,
+        string dstValidator,
+        uint256 amount
+    );
+
+    event Undelegate(
+        address indexed delegator,
+        string validator,
+        uint256 amount
+    );
+
+    event CancelUnbondingDelegation(
+        address indexed delegator,
+        string validator,
+        uint256 amount,
+        int64 creationHeight
+    );
+
+    event ValidatorCreated(
+        address indexed creator,
+        string validator,
+        uint256 amount
+    );
+
+    event ValidatorEdited(
+        address indexed editor,
+        string validator
+    );
+
     // Transactions
     function delegate(
         string memory valAddress
@@ -24,12 +62,65 @@ interface IStaking {
         uint256 amount
     ) external returns (bool success);
 
+    function cancelUnbondingDelegation(
+        string memory valAddress,
+        uint256 amount,
+        int64 creationHeight
+    ) external returns (bool success);
+
+    // Commission rates are decimal strings, e.g. "0.05" for 5%.
+    // pubKeyHex is the hex-encoded ed25519 consensus public key.
+    function createValidator(
+        string memory pubKeyHex,
+        string memory moniker,
+        string memory commissionRate,
+        string memory commissionMaxRate,
+        string memory commissionMaxChangeRate,
+        uint256 minSelfDelegation
+    ) payable external returns (bool success);
+
+    // Empty strings and a zero minSelfDelegation leave the field unchanged.
+    function editValidator(
+        string memory moniker,
+        string memory commissionRate,
+        uint256 minSelfDelegation
+    ) external returns (bool success);
+
     // Queries
     function delegation(
         address delegator,
         string memory valAddress
     ) external view returns (Delegation delegation);
 
+    function validator(
+        string memory valAddress
+    ) external view returns (Validator validator);
+
+    // status is one of "BOND_STATUS_BONDED", "BOND_STATUS_UNBONDING",
+    // "BOND_STATUS_UNBONDED" or empty for all validators.
+    function validators(
+        string memory status,
+        PageRequest memory pagination
+    ) external view returns (ValidatorsResponse response);
+
+    function unbondingDelegation(
+        address delegator,
+        string memory valAddress
+    ) external view returns (UnbondingDelegation unbondingDelegation);
+
+    // Leave both validator addresses empty to list all redelegations of the delegator.
+    function redelegations(
+        address delegator,
+        string memory srcValAddress,
+        string memory dstValAddress,
+        PageRequest memory pagination
+    ) external view returns (RedelegationsResponse response);
+
+    function delegatorDelegations(
+        address delegator,
+        PageRequest memory pagination
+    ) external view returns (DelegationsResponse response);
+
     struct Delegation {
         Balance balance;
         DelegationDetails delegation;
@@ -46,4 +137,78 @@ interface IStaking {
         uint256 decimals;
         string validator_address;
     }
+
+    // limit is capped at 100, a zero limit uses the default page size of 100
+    struct PageRequest {
+        bytes key;
+        uint64 offset;
+        uint64 limit;
+        bool countTotal;
+        bool reverse;
+    }
+
+    struct PageResponse {
+        bytes nextKey;
+        uint64 total;
+    }
+
+    struct Validator {
+        string operatorAddress;
+        bytes consensusPubKey;
+        bool jailed;
+        string status;
+        uint256 tokens;
+        string delegatorShares;
+        string moniker;
+        int64 unbondingHeight;
+        int64 unbondingTime;
+        string commissionRate;
+        string commissionMaxRate;
+        string commissionMaxChangeRate;
+        int64 commissionUpdateTime;
+        uint256 minSelfDelegation;
+    }
+
+    struct ValidatorsResponse {
+        Validator[] validators;
+        PageResponse pagination;
+    }
+
+    struct UnbondingDelegation {
+        string delegatorAddress;
+        string validatorAddress;
+        UnbondingDelegationEntry[] entries;
+    }
+
+    struct UnbondingDelegationEntry {
+        int64 creationHeight;
+        int64 completionTime;
+        uint256 initialBalance;
+        uint256 balance;
+    }
+
+    struct Redelegation {
+        string delegatorAddress;
+        string validatorSrcAddress;
+        string validatorDstAddress;
+        RedelegationEntry[] entries;
+    }
+
+    struct RedelegationEntry {
+        int64 creationHeight;
+        int64 completionTime;
+        uint256 initialBalance;
+        string sharesDst;
+        uint256 balance;
+    }
+
+    struct RedelegationsResponse {
+        Redelegation[] redelegations;
+        PageResponse pagination;
+    }
+
+    struct DelegationsResponse {
+        Delegation[] delegations;
+        PageResponse pagination;
+    }
 }
\ No newline at end of file
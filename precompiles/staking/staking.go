@@ -3,9 +3,13 @@ package staking
 import (
 	"bytes"
 	"embed"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -16,10 +20,27 @@ import (
 )
 
 const (
-	DelegateMethod   = "delegate"
-	RedelegateMethod = "redelegate"
-	UndelegateMethod = "undelegate"
-	DelegationMethod = "delegation"
+	DelegateMethod                  = "delegate"
+	RedelegateMethod                = "redelegate"
+	UndelegateMethod                = "undelegate"
+	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	CreateValidatorMethod           = "createValidator"
+	EditValidatorMethod             = "editValidator"
+	DelegationMethod                = "delegation"
+	ValidatorMethod                 = "validator"
+	ValidatorsMethod                = "validators"
+	UnbondingDelegationMethod       = "unbondingDelegation"
+	RedelegationsMethod             = "redelegations"
+	DelegatorDelegationsMethod      = "delegatorDelegations"
+)
+
+const (
+	DelegateEvent                  = "Delegate"
+	RedelegateEvent                = "Redelegate"
+	UndelegateEvent                = "Undelegate"
+	CancelUnbondingDelegationEvent = "CancelUnbondingDelegation"
+	ValidatorCreatedEvent          = "ValidatorCreated"
+	ValidatorEditedEvent           = "ValidatorEdited"
 )
 
 const (
@@ -32,27 +53,39 @@ const (
 var f embed.FS
 
 type PrecompileExecutor struct {
-	stakingKeeper  pcommon.StakingKeeper
-	stakingQuerier pcommon.StakingQuerier
-	evmKeeper      pcommon.EVMKeeper
-	bankKeeper     pcommon.BankKeeper
-	address        common.Address
+	stakingKeeper          pcommon.StakingKeeper
+	stakingQuerier         pcommon.StakingQuerier
+	stakingUnbondingKeeper pcommon.StakingUnbondingKeeper
+	evmKeeper              pcommon.EVMKeeper
+	bankKeeper             pcommon.BankKeeper
+	address                common.Address
+	events                 map[string]abi.Event
 
-	DelegateID   []byte
-	RedelegateID []byte
-	UndelegateID []byte
-	DelegationID []byte
+	DelegateID                  []byte
+	RedelegateID                []byte
+	UndelegateID                []byte
+	CancelUnbondingDelegationID []byte
+	CreateValidatorID           []byte
+	EditValidatorID             []byte
+	DelegationID                []byte
+	ValidatorID                 []byte
+	ValidatorsID                []byte
+	UnbondingDelegationID       []byte
+	RedelegationsID             []byte
+	DelegatorDelegationsID      []byte
 }
 
-func NewPrecompile(stakingKeeper pcommon.StakingKeeper, stakingQuerier pcommon.StakingQuerier, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper) (*pcommon.Precompile, error) {
+func NewPrecompile(stakingKeeper pcommon.StakingKeeper, stakingQuerier pcommon.StakingQuerier, stakingUnbondingKeeper pcommon.StakingUnbondingKeeper, evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper) (*pcommon.Precompile, error) {
 	newAbi := pcommon.MustGetABI(f, "abi.json")
 
 	p := &PrecompileExecutor{
-		stakingKeeper:  stakingKeeper,
-		stakingQuerier: stakingQuerier,
-		evmKeeper:      evmKeeper,
-		bankKeeper:     bankKeeper,
-		address:        common.HexToAddress(StakingAddress),
+		stakingKeeper:          stakingKeeper,
+		stakingQuerier:         stakingQuerier,
+		stakingUnbondingKeeper: stakingUnbondingKeeper,
+		evmKeeper:              evmKeeper,
+		bankKeeper:             bankKeeper,
+		address:                common.HexToAddress(StakingAddress),
+		events:                 newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -63,8 +96,24 @@ func NewPrecompile(stakingKeeper pcommon.StakingKeeper, stakingQuerier pcommon.S
 			p.RedelegateID = m.ID
 		case UndelegateMethod:
 			p.UndelegateID = m.ID
+		case CancelUnbondingDelegationMethod:
+			p.CancelUnbondingDelegationID = m.ID
+		case CreateValidatorMethod:
+			p.CreateValidatorID = m.ID
+		case EditValidatorMethod:
+			p.EditValidatorID = m.ID
 		case DelegationMethod:
 			p.DelegationID = m.ID
+		case ValidatorMethod:
+			p.ValidatorID = m.ID
+		case ValidatorsMethod:
+			p.ValidatorsID = m.ID
+		case UnbondingDelegationMethod:
+			p.UnbondingDelegationID = m.ID
+		case RedelegationsMethod:
+			p.RedelegationsID = m.ID
+		case DelegatorDelegationsMethod:
+			p.DelegatorDelegationsID = m.ID
 		}
 	}
 
@@ -79,6 +128,12 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 		return 70000
 	} else if bytes.Equal(method.ID, p.UndelegateID) {
 		return 50000
+	} else if bytes.Equal(method.ID, p.CancelUnbondingDelegationID) {
+		return 50000
+	} else if bytes.Equal(method.ID, p.CreateValidatorID) {
+		return 100000
+	} else if bytes.Equal(method.ID, p.EditValidatorID) {
+		return 50000
 	}
 
 	// This should never happen since this is going to fail during Run
@@ -94,24 +149,49 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 		if readOnly {
 			return nil, errors.New("cannot call staking precompile from staticcall")
 		}
-		return p.delegate(ctx, method, caller, args, value)
+		return p.delegate(ctx, method, caller, args, value, evm)
 	case RedelegateMethod:
 		if readOnly {
 			return nil, errors.New("cannot call staking precompile from staticcall")
 		}
-		return p.redelegate(ctx, method, caller, args, value)
+		return p.redelegate(ctx, method, caller, args, value, evm)
 	case UndelegateMethod:
 		if readOnly {
 			return nil, errors.New("cannot call staking precompile from staticcall")
 		}
-		return p.undelegate(ctx, method, caller, args, value)
+		return p.undelegate(ctx, method, caller, args, value, evm)
+	case CancelUnbondingDelegationMethod:
+		if readOnly {
+			return nil, errors.New("cannot call staking precompile from staticcall")
+		}
+		return p.cancelUnbondingDelegation(ctx, method, caller, args, value, evm)
+	case CreateValidatorMethod:
+		if readOnly {
+			return nil, errors.New("cannot call staking precompile from staticcall")
+		}
+		return p.createValidator(ctx, method, caller, args, value, evm)
+	case EditValidatorMethod:
+		if readOnly {
+			return nil, errors.New("cannot call staking precompile from staticcall")
+		}
+		return p.editValidator(ctx, method, caller, args, value, evm)
 	case DelegationMethod:
 		return p.delegation(ctx, method, args, value)
+	case ValidatorMethod:
+		return p.validator(ctx, method, args, value)
+	case ValidatorsMethod:
+		return p.validators(ctx, method, args, value)
+	case UnbondingDelegationMethod:
+		return p.unbondingDelegation(ctx, method, args, value)
+	case RedelegationsMethod:
+		return p.redelegations(ctx, method, args, value)
+	case DelegatorDelegationsMethod:
+		return p.delegatorDelegations(ctx, method, args, value)
 	}
 	return
 }
 
-func (p PrecompileExecutor) delegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) delegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
 		return nil, err
 	}
@@ -138,10 +218,13 @@ func (p PrecompileExecutor) delegate(ctx sdk.Context, method *abi.Method, caller
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[DelegateEvent], caller, validatorBech32, coin.Amount.BigInt()); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
 
-func (p PrecompileExecutor) redelegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) redelegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		return nil, err
 	}
@@ -165,10 +248,13 @@ func (p PrecompileExecutor) redelegate(ctx sdk.Context, method *abi.Method, call
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[RedelegateEvent], caller, srcValidatorBech32, dstValidatorBech32, amount); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
 
-func (p PrecompileExecutor) undelegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) ([]byte, error) {
+func (p PrecompileExecutor) undelegate(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
 	if err := pcommon.ValidateNonPayable(value); err != nil {
 		return nil, err
 	}
@@ -190,6 +276,208 @@ func (p PrecompileExecutor) undelegate(ctx sdk.Context, method *abi.Method, call
 	if err != nil {
 		return nil, err
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[UndelegateEvent], caller, validatorBech32, amount); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// cancelUnbondingDelegation moves `amount` of the unbonding entry created at
+// `creationHeight` back into a delegation to the same validator. It mirrors
+// MsgCancelUnbondingDelegation from later SDK versions.
+func (p PrecompileExecutor) cancelUnbondingDelegation(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	delegator, associated := p.evmKeeper.GetKiiAddress(ctx, caller)
+	if !associated {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	validatorBech32 := args[0].(string)
+	amount := args[1].(*big.Int)
+	creationHeight := args[2].(int64)
+	if amount.Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validatorBech32)
+	if err != nil {
+		return nil, err
+	}
+	validator, found := p.stakingUnbondingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	if validator.InvalidExRate() {
+		return nil, stakingtypes.ErrDelegatorShareExRateInvalid
+	}
+	if validator.IsJailed() {
+		return nil, stakingtypes.ErrValidatorJailed
+	}
+
+	ubd, found := p.stakingUnbondingKeeper.GetUnbondingDelegation(ctx, delegator, valAddr)
+	if !found {
+		return nil, fmt.Errorf("unbonding delegation with delegator %s not found for validator %s", delegator.String(), validatorBech32)
+	}
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return nil, fmt.Errorf("unbonding delegation entry is not found at block height %d", creationHeight)
+	}
+	entry := ubd.Entries[entryIndex]
+	cancelAmount := sdk.NewIntFromBigInt(amount)
+	if entry.Balance.LT(cancelAmount) {
+		return nil, errors.New("amount is greater than the unbonding delegation entry balance")
+	}
+	if entry.CompletionTime.Before(ctx.BlockTime()) {
+		return nil, errors.New("unbonding delegation is already processed")
+	}
+
+	// the unbonding tokens are still held by the not-bonded pool, so they are
+	// delegated back without touching the delegator's balance
+	if _, err := p.stakingUnbondingKeeper.Delegate(ctx, delegator, cancelAmount, stakingtypes.Unbonding, validator, false); err != nil {
+		return nil, err
+	}
+	if remaining := entry.Balance.Sub(cancelAmount); remaining.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = remaining
+		entry.InitialBalance = entry.InitialBalance.Sub(cancelAmount)
+		ubd.Entries[entryIndex] = entry
+	}
+	if len(ubd.Entries) == 0 {
+		p.stakingUnbondingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		p.stakingUnbondingKeeper.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[CancelUnbondingDelegationEvent], caller, validatorBech32, amount, creationHeight); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// createValidator creates a validator operated by the caller, self-delegating
+// the `value` sent along with the call. Commission rates are decimal strings
+// (e.g. "0.05") and the consensus key is a hex-encoded ed25519 public key.
+func (p PrecompileExecutor) createValidator(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
+	if err := pcommon.ValidateArgsLength(args, 6); err != nil {
+		return nil, err
+	}
+	operator, associated := p.evmKeeper.GetKiiAddress(ctx, caller)
+	if !associated {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	pubKeyBz, err := hex.DecodeString(strings.TrimPrefix(args[0].(string), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key hex: %w", err)
+	}
+	if len(pubKeyBz) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes, got %d", ed25519.PubKeySize, len(pubKeyBz))
+	}
+	moniker := args[1].(string)
+	rate, err := sdk.NewDecFromStr(args[2].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid commission rate: %w", err)
+	}
+	maxRate, err := sdk.NewDecFromStr(args[3].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid commission max rate: %w", err)
+	}
+	maxChangeRate, err := sdk.NewDecFromStr(args[4].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid commission max change rate: %w", err)
+	}
+	minSelfDelegation := args[5].(*big.Int)
+	if value == nil || value.Sign() == 0 {
+		return nil, errors.New("set `value` field to non-zero to send self delegation fund")
+	}
+	coin, err := pcommon.HandlePaymentUkii(ctx, p.evmKeeper.GetKiiAddressOrDefault(ctx, p.address), operator, value, p.bankKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr := sdk.ValAddress(operator)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr,
+		&ed25519.PubKey{Key: pubKeyBz},
+		coin,
+		stakingtypes.Description{Moniker: moniker},
+		stakingtypes.NewCommissionRates(rate, maxRate, maxChangeRate),
+		sdk.NewIntFromBigInt(minSelfDelegation),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := p.stakingKeeper.CreateValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[ValidatorCreatedEvent], caller, valAddr.String(), coin.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// editValidator updates the caller's validator. An empty moniker or commission
+// rate and a zero min self delegation leave the respective field unchanged.
+func (p PrecompileExecutor) editValidator(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	operator, associated := p.evmKeeper.GetKiiAddress(ctx, caller)
+	if !associated {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	description := stakingtypes.NewDescription(
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+		stakingtypes.DoNotModifyDesc,
+	)
+	if moniker := args[0].(string); moniker != "" {
+		description.Moniker = moniker
+	}
+	var newRate *sdk.Dec
+	if rateStr := args[1].(string); rateStr != "" {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commission rate: %w", err)
+		}
+		newRate = &rate
+	}
+	var newMinSelfDelegation *sdk.Int
+	if minSelfDelegation := args[2].(*big.Int); minSelfDelegation.Sign() != 0 {
+		m := sdk.NewIntFromBigInt(minSelfDelegation)
+		newMinSelfDelegation = &m
+	}
+
+	valAddr := sdk.ValAddress(operator)
+	msg := stakingtypes.NewMsgEditValidator(valAddr, description, newRate, newMinSelfDelegation)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := p.stakingKeeper.EditValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[ValidatorEditedEvent], caller, valAddr.String()); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
 
@@ -250,3 +538,287 @@ func (p PrecompileExecutor) delegation(ctx sdk.Context, method *abi.Method, args
 
 	return method.Outputs.Pack(delegation)
 }
+
+type Validator struct {
+	OperatorAddress         string
+	ConsensusPubKey         []byte
+	Jailed                  bool
+	Status                  string
+	Tokens                  *big.Int
+	DelegatorShares         string
+	Moniker                 string
+	UnbondingHeight         int64
+	UnbondingTime           int64
+	CommissionRate          string
+	CommissionMaxRate       string
+	CommissionMaxChangeRate string
+	CommissionUpdateTime    int64
+	MinSelfDelegation       *big.Int
+}
+
+type ValidatorsResponse struct {
+	Validators []Validator
+	Pagination pcommon.PageResponse
+}
+
+type UnbondingDelegation struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Entries          []UnbondingDelegationEntry
+}
+
+type UnbondingDelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	Balance        *big.Int
+}
+
+type Redelegation struct {
+	DelegatorAddress    string
+	ValidatorSrcAddress string
+	ValidatorDstAddress string
+	Entries             []RedelegationEntry
+}
+
+type RedelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	SharesDst      string
+	Balance        *big.Int
+}
+
+type RedelegationsResponse struct {
+	Redelegations []Redelegation
+	Pagination    pcommon.PageResponse
+}
+
+type DelegationsResponse struct {
+	Delegations []Delegation
+	Pagination  pcommon.PageResponse
+}
+
+func (p PrecompileExecutor) validator(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+
+	validatorResponse, err := p.stakingQuerier.Validator(sdk.WrapSDKContext(ctx), &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: args[0].(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+	validator, err := convertValidator(validatorResponse.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(validator)
+}
+
+func (p PrecompileExecutor) validators(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+
+	pagination, err := pcommon.ParsePageRequest(args[1])
+	if err != nil {
+		return nil, err
+	}
+	validatorsResponse, err := p.stakingQuerier.Validators(sdk.WrapSDKContext(ctx), &stakingtypes.QueryValidatorsRequest{
+		Status:     args[0].(string),
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := ValidatorsResponse{
+		Validators: make([]Validator, 0, len(validatorsResponse.Validators)),
+		Pagination: pcommon.NewPageResponse(validatorsResponse.Pagination),
+	}
+	for _, v := range validatorsResponse.Validators {
+		validator, err := convertValidator(v)
+		if err != nil {
+			return nil, err
+		}
+		response.Validators = append(response.Validators, validator)
+	}
+
+	return method.Outputs.Pack(response)
+}
+
+func (p PrecompileExecutor) unbondingDelegation(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+
+	kiiDelegatorAddress, err := pcommon.GetKiiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingResponse, err := p.stakingQuerier.UnbondingDelegation(sdk.WrapSDKContext(ctx), &stakingtypes.QueryUnbondingDelegationRequest{
+		DelegatorAddr: kiiDelegatorAddress.String(),
+		ValidatorAddr: args[1].(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ubd := unbondingResponse.Unbond
+	unbondingDelegation := UnbondingDelegation{
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		Entries:          make([]UnbondingDelegationEntry, 0, len(ubd.Entries)),
+	}
+	for _, entry := range ubd.Entries {
+		unbondingDelegation.Entries = append(unbondingDelegation.Entries, UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime.Unix(),
+			InitialBalance: entry.InitialBalance.BigInt(),
+			Balance:        entry.Balance.BigInt(),
+		})
+	}
+
+	return method.Outputs.Pack(unbondingDelegation)
+}
+
+func (p PrecompileExecutor) redelegations(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 4); err != nil {
+		return nil, err
+	}
+
+	kiiDelegatorAddress, err := pcommon.GetKiiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	pagination, err := pcommon.ParsePageRequest(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	redelegationsResponse, err := p.stakingQuerier.Redelegations(sdk.WrapSDKContext(ctx), &stakingtypes.QueryRedelegationsRequest{
+		DelegatorAddr:    kiiDelegatorAddress.String(),
+		SrcValidatorAddr: args[1].(string),
+		DstValidatorAddr: args[2].(string),
+		Pagination:       pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := RedelegationsResponse{
+		Redelegations: make([]Redelegation, 0, len(redelegationsResponse.RedelegationResponses)),
+		Pagination:    pcommon.NewPageResponse(redelegationsResponse.Pagination),
+	}
+	for _, red := range redelegationsResponse.RedelegationResponses {
+		redelegation := Redelegation{
+			DelegatorAddress:    red.Redelegation.DelegatorAddress,
+			ValidatorSrcAddress: red.Redelegation.ValidatorSrcAddress,
+			ValidatorDstAddress: red.Redelegation.ValidatorDstAddress,
+			Entries:             make([]RedelegationEntry, 0, len(red.Entries)),
+		}
+		for _, entry := range red.Entries {
+			redelegation.Entries = append(redelegation.Entries, RedelegationEntry{
+				CreationHeight: entry.RedelegationEntry.CreationHeight,
+				CompletionTime: entry.RedelegationEntry.CompletionTime.Unix(),
+				InitialBalance: entry.RedelegationEntry.InitialBalance.BigInt(),
+				SharesDst:      entry.RedelegationEntry.SharesDst.String(),
+				Balance:        entry.Balance.BigInt(),
+			})
+		}
+		response.Redelegations = append(response.Redelegations, redelegation)
+	}
+
+	return method.Outputs.Pack(response)
+}
+
+func (p PrecompileExecutor) delegatorDelegations(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+
+	kiiDelegatorAddress, err := pcommon.GetKiiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	pagination, err := pcommon.ParsePageRequest(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	delegationsResponse, err := p.stakingQuerier.DelegatorDelegations(sdk.WrapSDKContext(ctx), &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: kiiDelegatorAddress.String(),
+		Pagination:    pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := DelegationsResponse{
+		Delegations: make([]Delegation, 0, len(delegationsResponse.DelegationResponses)),
+		Pagination:  pcommon.NewPageResponse(delegationsResponse.Pagination),
+	}
+	for _, d := range delegationsResponse.DelegationResponses {
+		response.Delegations = append(response.Delegations, Delegation{
+			Balance: Balance{
+				Amount: d.Balance.Amount.BigInt(),
+				Denom:  d.Balance.Denom,
+			},
+			Delegation: DelegationDetails{
+				DelegatorAddress: d.Delegation.DelegatorAddress,
+				Shares:           d.Delegation.Shares.BigInt(),
+				Decimals:         big.NewInt(sdk.Precision),
+				ValidatorAddress: d.Delegation.ValidatorAddress,
+			},
+		})
+	}
+
+	return method.Outputs.Pack(response)
+}
+
+func convertValidator(v stakingtypes.Validator) (Validator, error) {
+	consPubKey, err := v.ConsPubKey()
+	if err != nil {
+		return Validator{}, err
+	}
+	return Validator{
+		OperatorAddress:         v.OperatorAddress,
+		ConsensusPubKey:         consPubKey.Bytes(),
+		Jailed:                  v.Jailed,
+		Status:                  v.Status.String(),
+		Tokens:                  v.Tokens.BigInt(),
+		DelegatorShares:         v.DelegatorShares.String(),
+		Moniker:                 v.Description.Moniker,
+		UnbondingHeight:         v.UnbondingHeight,
+		UnbondingTime:           v.UnbondingTime.Unix(),
+		CommissionRate:          v.Commission.Rate.String(),
+		CommissionMaxRate:       v.Commission.MaxRate.String(),
+		CommissionMaxChangeRate: v.Commission.MaxChangeRate.String(),
+		CommissionUpdateTime:    v.Commission.UpdateTime.Unix(),
+		MinSelfDelegation:       v.MinSelfDelegation.BigInt(),
+	}, nil
+}
@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"embed"
 	"encoding/hex"
 	"fmt"
@@ -10,12 +11,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	crptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -92,6 +96,19 @@ func TestStaking(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, int64(100), d.Shares.RoundInt().Int64())
 
+	// the delegation is logged from the precompile address and lands in the receipt and its bloom
+	receipt, err := k.GetTransientReceipt(ctx, tx.Hash())
+	require.Nil(t, err)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, addr.Hex(), receipt.Logs[0].Address)
+	require.Equal(t, []string{abi.Events["Delegate"].ID.Hex(), common.BytesToHash(evmAddr.Bytes()).Hex()}, receipt.Logs[0].Topics)
+	data, err := abi.Events["Delegate"].Inputs.NonIndexed().Unpack(receipt.Logs[0].Data)
+	require.Nil(t, err)
+	require.Equal(t, val.String(), data[0])
+	require.Equal(t, big.NewInt(100), data[1])
+	require.True(t, ethtypes.BytesToBloom(receipt.LogsBloom).Test(addr.Bytes()))
+	require.True(t, ethtypes.BytesToBloom(receipt.LogsBloom).Test(abi.Events["Delegate"].ID.Bytes()))
+
 	// redelegate
 	args, err = abi.Pack("redelegate", val.String(), val2.String(), big.NewInt(50))
 	require.Nil(t, err)
@@ -266,12 +283,32 @@ func (tq *TestStakingQuerier) Delegation(c context.Context, _ *stakingtypes.Quer
 	return tq.Response, tq.Err
 }
 
+func (tq *TestStakingQuerier) Validator(c context.Context, _ *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error) {
+	return nil, tq.Err
+}
+
+func (tq *TestStakingQuerier) Validators(c context.Context, _ *stakingtypes.QueryValidatorsRequest) (*stakingtypes.QueryValidatorsResponse, error) {
+	return nil, tq.Err
+}
+
+func (tq *TestStakingQuerier) UnbondingDelegation(c context.Context, _ *stakingtypes.QueryUnbondingDelegationRequest) (*stakingtypes.QueryUnbondingDelegationResponse, error) {
+	return nil, tq.Err
+}
+
+func (tq *TestStakingQuerier) Redelegations(c context.Context, _ *stakingtypes.QueryRedelegationsRequest) (*stakingtypes.QueryRedelegationsResponse, error) {
+	return nil, tq.Err
+}
+
+func (tq *TestStakingQuerier) DelegatorDelegations(c context.Context, _ *stakingtypes.QueryDelegatorDelegationsRequest) (*stakingtypes.QueryDelegatorDelegationsResponse, error) {
+	return nil, tq.Err
+}
+
 func TestPrecompile_Run_Delegation(t *testing.T) {
 	callerKiiAddress, callerEvmAddress := testkeeper.MockAddressPair()
 	_, unassociatedEvmAddress := testkeeper.MockAddressPair()
 	_, contractEvmAddress := testkeeper.MockAddressPair()
 	validatorAddress := "kiivaloper134ykhqrkyda72uq7f463ne77e4tn99steprmz7"
-	pre, _ := staking.NewPrecompile(nil, nil, nil, nil)
+	pre, _ := staking.NewPrecompile(nil, nil, nil, nil, nil)
 	delegationMethod, _ := pre.ABI.MethodById(pre.GetExecutor().(*staking.PrecompileExecutor).DelegationID)
 	shares := 100
 	delegationResponse := &stakingtypes.QueryDelegationResponse{
@@ -453,7 +490,7 @@ func TestPrecompile_Run_Delegation(t *testing.T) {
 				StateDB:   stateDb,
 				TxContext: vm.TxContext{Origin: callerEvmAddress},
 			}
-			p, _ := staking.NewPrecompile(tt.fields.stakingKeeper, tt.fields.stakingQuerier, k, nil)
+			p, _ := staking.NewPrecompile(tt.fields.stakingKeeper, tt.fields.stakingQuerier, nil, k, nil)
 			delegation, err := p.ABI.MethodById(p.GetExecutor().(*staking.PrecompileExecutor).DelegationID)
 			require.Nil(t, err)
 			inputs, err := delegation.Inputs.Pack(tt.args.delegatorAddress, tt.args.validatorAddress)
@@ -472,3 +509,202 @@ func TestPrecompile_Run_Delegation(t *testing.T) {
 		})
 	}
 }
+
+func fundedStakingAccount(t *testing.T, ctx sdk.Context, k *keeper.Keeper) (*ecdsa.PrivateKey, sdk.AccAddress, common.Address) {
+	privKey := testkeeper.MockPrivateKey()
+	key, err := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	require.Nil(t, err)
+	kiiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(200000000)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, evmtypes.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, kiiAddr, amt))
+	return key, kiiAddr, evmAddr
+}
+
+func TestStakingValidatorLifecycle(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	key, kiiAddr, evmAddr := fundedStakingAccount(t, ctx, k)
+	abi := pcommon.MustGetABI(f, "abi.json")
+
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	args, err := abi.Pack("createValidator", hex.EncodeToString(consPubKey.Bytes()), "evm-validator", "0.1", "0.2", "0.01", big.NewInt(1))
+	require.Nil(t, err)
+	res := testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 0)
+	require.Empty(t, res.VmError)
+
+	valAddr := sdk.ValAddress(kiiAddr)
+	val, found := testApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, "evm-validator", val.Description.Moniker)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), val.Commission.Rate)
+	require.Equal(t, int64(100), val.Tokens.Int64())
+	receipt, err := k.GetTransientReceipt(ctx, common.HexToHash(res.Hash))
+	require.Nil(t, err)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, []string{abi.Events["ValidatorCreated"].ID.Hex(), common.BytesToHash(evmAddr.Bytes()).Hex()}, receipt.Logs[0].Topics)
+
+	// only the moniker changes, the commission can't be changed within 24h anyway
+	args, err = abi.Pack("editValidator", "renamed-validator", "", big.NewInt(0))
+	require.Nil(t, err)
+	res = testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 1)
+	require.Empty(t, res.VmError)
+	val, _ = testApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, "renamed-validator", val.Description.Moniker)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), val.Commission.Rate)
+	require.Equal(t, int64(1), val.MinSelfDelegation.Int64())
+
+	// a second validator for the same operator is rejected
+	args, err = abi.Pack("createValidator", hex.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()), "dup", "0.1", "0.2", "0.01", big.NewInt(1))
+	require.Nil(t, err)
+	res = testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 2)
+	require.NotEmpty(t, res.VmError)
+
+	// malformed consensus keys are rejected
+	args, err = abi.Pack("createValidator", "abcd", "bad", "0.1", "0.2", "0.01", big.NewInt(1))
+	require.Nil(t, err)
+	res = testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 3)
+	require.NotEmpty(t, res.VmError)
+}
+
+func TestStakingCancelUnbondingDelegation(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	val := setupValidator(t, ctx, testApp, stakingtypes.Unbonded, secp256k1.GenPrivKey().PubKey())
+	key, kiiAddr, evmAddr := fundedStakingAccount(t, ctx, k)
+	abi := pcommon.MustGetABI(f, "abi.json")
+
+	args, err := abi.Pack("delegate", val.String())
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 0).VmError)
+	args, err = abi.Pack("undelegate", val.String(), big.NewInt(30))
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 1).VmError)
+
+	// more than the entry balance
+	args, err = abi.Pack("cancelUnbondingDelegation", val.String(), big.NewInt(31), ctx.BlockHeight())
+	require.Nil(t, err)
+	require.NotEmpty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 2).VmError)
+
+	// no entry at that height
+	args, err = abi.Pack("cancelUnbondingDelegation", val.String(), big.NewInt(10), ctx.BlockHeight()+1)
+	require.Nil(t, err)
+	require.NotEmpty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 3).VmError)
+
+	args, err = abi.Pack("cancelUnbondingDelegation", val.String(), big.NewInt(10), ctx.BlockHeight())
+	require.Nil(t, err)
+	res := testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 4)
+	require.Empty(t, res.VmError)
+
+	d, found := testApp.StakingKeeper.GetDelegation(ctx, kiiAddr, val)
+	require.True(t, found)
+	require.Equal(t, int64(80), d.Shares.RoundInt().Int64())
+	ubd, found := testApp.StakingKeeper.GetUnbondingDelegation(ctx, kiiAddr, val)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(20), ubd.Entries[0].Balance.Int64())
+	require.Equal(t, int64(20), ubd.Entries[0].InitialBalance.Int64())
+	receipt, err := k.GetTransientReceipt(ctx, common.HexToHash(res.Hash))
+	require.Nil(t, err)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, []string{abi.Events["CancelUnbondingDelegation"].ID.Hex(), common.BytesToHash(evmAddr.Bytes()).Hex()}, receipt.Logs[0].Topics)
+	data, err := abi.Events["CancelUnbondingDelegation"].Inputs.NonIndexed().Unpack(receipt.Logs[0].Data)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{val.String(), big.NewInt(10), ctx.BlockHeight()}, data)
+
+	// cancelling the remaining balance removes the unbonding delegation
+	args, err = abi.Pack("cancelUnbondingDelegation", val.String(), big.NewInt(20), ctx.BlockHeight())
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 5).VmError)
+	_, found = testApp.StakingKeeper.GetUnbondingDelegation(ctx, kiiAddr, val)
+	require.False(t, found)
+	d, _ = testApp.StakingKeeper.GetDelegation(ctx, kiiAddr, val)
+	require.Equal(t, int64(100), d.Shares.RoundInt().Int64())
+}
+
+func TestStakingQueries(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	val := setupValidator(t, ctx, testApp, stakingtypes.Bonded, secp256k1.GenPrivKey().PubKey())
+	val2 := setupValidator(t, ctx, testApp, stakingtypes.Bonded, secp256k1.GenPrivKey().PubKey())
+	key, kiiAddr, evmAddr := fundedStakingAccount(t, ctx, k)
+	abi := pcommon.MustGetABI(f, "abi.json")
+
+	args, err := abi.Pack("delegate", val.String())
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 0).VmError)
+	args, err = abi.Pack("delegate", val2.String())
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(100_000_000_000_000), 1).VmError)
+	args, err = abi.Pack("undelegate", val.String(), big.NewInt(30))
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 2).VmError)
+	args, err = abi.Pack("redelegate", val.String(), val2.String(), big.NewInt(20))
+	require.Nil(t, err)
+	require.Empty(t, testkeeper.SendEVMTx(t, ctx, k, key, common.HexToAddress(staking.StakingAddress), args, big.NewInt(0), 3).VmError)
+
+	p, err := staking.NewPrecompile(nil, stakingkeeper.Querier{Keeper: testApp.StakingKeeper}, testApp.StakingKeeper, k, nil)
+	require.Nil(t, err)
+	evm := vm.EVM{StateDB: state.NewDBImpl(ctx, k, true)}
+	query := func(method string, args ...interface{}) []interface{} {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false)
+		require.Nil(t, err, string(ret))
+		out, err := p.ABI.Unpack(method, ret)
+		require.Nil(t, err)
+		return out
+	}
+	type pageRequest = struct {
+		Key        []byte `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"countTotal"`
+		Reverse    bool   `json:"reverse"`
+	}
+
+	out := query("validator", val.String())
+	validator := *ethabi.ConvertType(out[0], new(staking.Validator)).(*staking.Validator)
+	require.Equal(t, val.String(), validator.OperatorAddress)
+	require.Equal(t, stakingtypes.Bonded.String(), validator.Status)
+	require.Equal(t, big.NewInt(150), validator.Tokens)
+	require.Equal(t, "0.050000000000000000", validator.CommissionRate)
+
+	out = query("validators", stakingtypes.Bonded.String(), pageRequest{Limit: 1, CountTotal: true})
+	validators := *ethabi.ConvertType(out[0], new(staking.ValidatorsResponse)).(*staking.ValidatorsResponse)
+	require.Len(t, validators.Validators, 1)
+	require.NotEmpty(t, validators.Pagination.NextKey)
+	require.GreaterOrEqual(t, validators.Pagination.Total, uint64(2))
+	for _, v := range validators.Validators {
+		require.Equal(t, stakingtypes.Bonded.String(), v.Status)
+	}
+
+	out = query("unbondingDelegation", evmAddr, val.String())
+	ubd := *ethabi.ConvertType(out[0], new(staking.UnbondingDelegation)).(*staking.UnbondingDelegation)
+	require.Equal(t, kiiAddr.String(), ubd.DelegatorAddress)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, ctx.BlockHeight(), ubd.Entries[0].CreationHeight)
+	require.Equal(t, big.NewInt(30), ubd.Entries[0].Balance)
+
+	out = query("redelegations", evmAddr, "", "", pageRequest{})
+	redelegations := *ethabi.ConvertType(out[0], new(staking.RedelegationsResponse)).(*staking.RedelegationsResponse)
+	require.Len(t, redelegations.Redelegations, 1)
+	require.Equal(t, val.String(), redelegations.Redelegations[0].ValidatorSrcAddress)
+	require.Equal(t, val2.String(), redelegations.Redelegations[0].ValidatorDstAddress)
+	require.Equal(t, big.NewInt(20), redelegations.Redelegations[0].Entries[0].Balance)
+
+	out = query("delegatorDelegations", evmAddr, pageRequest{CountTotal: true})
+	delegations := *ethabi.ConvertType(out[0], new(staking.DelegationsResponse)).(*staking.DelegationsResponse)
+	require.Len(t, delegations.Delegations, 2)
+	require.Equal(t, uint64(2), delegations.Pagination.Total)
+
+	input, err := p.ABI.Pack("validators", "", pageRequest{Limit: pcommon.MaxPageLimit + 1})
+	require.Nil(t, err)
+	ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false)
+	require.Equal(t, vm.ErrExecutionReverted, err)
+	require.Equal(t, fmt.Sprintf("page limit must not exceed %d", pcommon.MaxPageLimit), string(ret))
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000100C;
+
+ITokenFactory constant TOKENFACTORY_CONTRACT = ITokenFactory(
+    TOKENFACTORY_PRECOMPILE_ADDRESS
+);
+
+interface ITokenFactory {
+    // Transactions
+    // createDenom creates factory/{caller}/{subdenom} with the caller as admin,
+    // an empty allowList leaves the denom unrestricted
+    function createDenom(
+        string memory subdenom,
+        string[] memory allowList,
+        bool registerPointer
+    ) external returns (string memory denom, address pointer);
+
+    function updateDenom(
+        string memory denom,
+        string[] memory allowList
+    ) external returns (bool success);
+
+    function mint(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    function burn(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    function changeAdmin(
+        string memory denom,
+        string memory newAdmin
+    ) external returns (bool success);
+
+    function setMetadata(
+        string memory denom,
+        string memory name,
+        string memory symbol,
+        string memory description,
+        uint8 decimals
+    ) external returns (bool success);
+
+    // Queries
+    function getAdmin(
+        string memory denom
+    ) external view returns (string memory admin);
+
+    function getDenomsFromCreator(
+        string memory creator
+    ) external view returns (string[] memory denoms);
+}
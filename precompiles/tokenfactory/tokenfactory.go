@@ -0,0 +1,408 @@
+package tokenfactory
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	pcommon "github.com/kiichain/kiichain/precompiles/common"
+	"github.com/kiichain/kiichain/utils"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
+)
+
+const (
+	PrecompileName = "tokenfactory"
+
+	CreateDenomMethod          = "createDenom"
+	UpdateDenomMethod          = "updateDenom"
+	MintMethod                 = "mint"
+	BurnMethod                 = "burn"
+	ChangeAdminMethod          = "changeAdmin"
+	SetMetadataMethod          = "setMetadata"
+	GetAdminMethod             = "getAdmin"
+	GetDenomsFromCreatorMethod = "getDenomsFromCreator"
+)
+
+const TokenFactoryAddress = "0x000000000000000000000000000000000000100C"
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// PrecompileExecutor exposes the tokenfactory module to EVM contracts, all the denoms
+// created through it are owned by the Kii address associated with the EVM caller
+type PrecompileExecutor struct {
+	tokenFactoryKeeper  pcommon.TokenFactoryKeeper
+	tokenFactoryQuerier pcommon.TokenFactoryQuerier
+	evmKeeper           pcommon.EVMKeeper
+
+	CreateDenomID          []byte
+	UpdateDenomID          []byte
+	MintID                 []byte
+	BurnID                 []byte
+	ChangeAdminID          []byte
+	SetMetadataID          []byte
+	GetAdminID             []byte
+	GetDenomsFromCreatorID []byte
+}
+
+// NewPrecompile returns a new tokenfactory precompile
+func NewPrecompile(tokenFactoryKeeper pcommon.TokenFactoryKeeper, tokenFactoryQuerier pcommon.TokenFactoryQuerier, evmKeeper pcommon.EVMKeeper) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		tokenFactoryKeeper:  tokenFactoryKeeper,
+		tokenFactoryQuerier: tokenFactoryQuerier,
+		evmKeeper:           evmKeeper,
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case CreateDenomMethod:
+			p.CreateDenomID = m.ID
+		case UpdateDenomMethod:
+			p.UpdateDenomID = m.ID
+		case MintMethod:
+			p.MintID = m.ID
+		case BurnMethod:
+			p.BurnID = m.ID
+		case ChangeAdminMethod:
+			p.ChangeAdminID = m.ID
+		case SetMetadataMethod:
+			p.SetMetadataID = m.ID
+		case GetAdminMethod:
+			p.GetAdminID = m.ID
+		case GetDenomsFromCreatorMethod:
+			p.GetDenomsFromCreatorID = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(TokenFactoryAddress), PrecompileName), nil
+}
+
+// Execute routes the call to the method handler
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64) (ret []byte, remainingGas uint64, err error) {
+	if ctx.EVMPrecompileCalledFromDelegateCall() {
+		return nil, 0, errors.New("cannot delegatecall tokenfactory")
+	}
+
+	// Queries can be called from anywhere
+	switch method.Name {
+	case GetAdminMethod:
+		return p.getAdmin(ctx, method, args, value)
+	case GetDenomsFromCreatorMethod:
+		return p.getDenomsFromCreator(ctx, method, args, value)
+	}
+
+	// Everything else changes state
+	if readOnly {
+		return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+	}
+	switch method.Name {
+	case CreateDenomMethod:
+		return p.createDenom(ctx, method, caller, args, value, evm)
+	case UpdateDenomMethod:
+		return p.updateDenom(ctx, method, caller, args, value)
+	case MintMethod:
+		return p.mint(ctx, method, caller, args, value)
+	case BurnMethod:
+		return p.burn(ctx, method, caller, args, value)
+	case ChangeAdminMethod:
+		return p.changeAdmin(ctx, method, caller, args, value)
+	case SetMetadataMethod:
+		return p.setMetadata(ctx, method, caller, args, value, evm)
+	default:
+		err = fmt.Errorf("unknown method %s", method.Name)
+	}
+	return
+}
+
+func (p PrecompileExecutor) EVMKeeper() pcommon.EVMKeeper {
+	return p.evmKeeper
+}
+
+// createDenom creates a new denom administered by the caller, optionally with an allow list
+// and a native ERC20 pointer
+func (p PrecompileExecutor) createDenom(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	subdenom := args[0].(string)
+	allowList := args[1].([]string)
+	registerPointer := args[2].(bool)
+
+	// Build and validate the message the same way a regular tx would be
+	msg := tokenfactorytypes.NewMsgCreateDenom(sender.String(), subdenom)
+	if len(allowList) > 0 {
+		msg.AllowList = &banktypes.AllowList{Addresses: allowList}
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	res, err := p.tokenFactoryKeeper.CreateDenom(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The tokenfactory module leaves the pointers of EVM callers to the precompile, so that they
+	// are deployed with the EVM of the caller. The new denom metadata uses the full denom as name
+	// and symbol with no decimals
+	params, err := p.tokenFactoryQuerier.Params(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, 0, err
+	}
+	pointerAddr, _, pointerExists := p.evmKeeper.GetERC20NativePointer(ctx, res.NewTokenDenom)
+	if (registerPointer || params.Params.AutoRegisterErc20Pointer) && !pointerExists {
+		pointerAddr, err = p.evmKeeper.UpsertERCNativePointer(ctx, evm, res.NewTokenDenom, utils.ERCMetadata{
+			Name:   res.NewTokenDenom,
+			Symbol: res.NewTokenDenom,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	ret, err = method.Outputs.Pack(res.NewTokenDenom, pointerAddr)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// updateDenom replaces the allow list of a denom administered by the caller
+func (p PrecompileExecutor) updateDenom(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	denom := args[0].(string)
+	allowList := args[1].([]string)
+
+	msg := tokenfactorytypes.NewMsgUpdateDenom(sender.String(), denom, &banktypes.AllowList{Addresses: allowList})
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenFactoryKeeper.UpdateDenom(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// mint mints the amount of denom to the caller, the caller must be the denom admin
+func (p PrecompileExecutor) mint(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	amount, err := coinFromArgs(args[0], args[1])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	msg := tokenfactorytypes.NewMsgMint(sender.String(), amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenFactoryKeeper.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// burn burns the amount of denom from the caller, the caller must be the denom admin
+func (p PrecompileExecutor) burn(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	amount, err := coinFromArgs(args[0], args[1])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	msg := tokenfactorytypes.NewMsgBurn(sender.String(), amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenFactoryKeeper.Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// changeAdmin hands the denom administration over to a new Kii address
+func (p PrecompileExecutor) changeAdmin(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	denom := args[0].(string)
+	newAdmin := args[1].(string)
+
+	msg := tokenfactorytypes.NewMsgChangeAdmin(sender.String(), denom, newAdmin)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenFactoryKeeper.ChangeAdmin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// setMetadata sets the bank metadata of a denom administered by the caller
+func (p PrecompileExecutor) setMetadata(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 5); err != nil {
+		return nil, 0, err
+	}
+	sender, err := pcommon.GetKiiAddressByEvmAddress(ctx, caller, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	denom := args[0].(string)
+	name := args[1].(string)
+	symbol := args[2].(string)
+	description := args[3].(string)
+	decimals := args[4].(uint8)
+
+	// The base unit is always the denom itself, with decimals the symbol becomes the display unit
+	metadata := banktypes.Metadata{
+		Description: description,
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+		Base:    denom,
+		Display: denom,
+		Name:    name,
+		Symbol:  symbol,
+	}
+	if decimals > 0 {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    symbol,
+			Exponent: uint32(decimals),
+		})
+		metadata.Display = symbol
+	}
+
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(sender.String(), metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenFactoryKeeper.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+
+	// Keep the ERC20 pointer name, symbol and decimals in sync with the new metadata
+	if _, _, exists := p.evmKeeper.GetERC20NativePointer(ctx, denom); exists {
+		if _, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, denom, utils.ERCMetadataFromDenomMetadata(metadata)); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// getAdmin returns the admin of a denom
+func (p PrecompileExecutor) getAdmin(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	denom := args[0].(string)
+
+	res, err := p.tokenFactoryQuerier.DenomAuthorityMetadata(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(res.AuthorityMetadata.Admin)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// getDenomsFromCreator returns all the denoms created by a Kii address
+func (p PrecompileExecutor) getDenomsFromCreator(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	creator := args[0].(string)
+
+	res, err := p.tokenFactoryQuerier.DenomsFromCreator(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomsFromCreatorRequest{Creator: creator})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = method.Outputs.Pack(res.Denoms)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+// coinFromArgs builds a coin from the denom and uint256 amount arguments
+func coinFromArgs(denomArg interface{}, amountArg interface{}) (sdk.Coin, error) {
+	denom := denomArg.(string)
+	amount := amountArg.(*big.Int)
+	if amount.Sign() <= 0 {
+		return sdk.Coin{}, errors.New("amount must be positive")
+	}
+	// The denom is validated later by the message ValidateBasic
+	return sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)}, nil
+}
@@ -0,0 +1,140 @@
+package tokenfactory_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/kiichain/kiichain/precompiles/common"
+	"github.com/kiichain/kiichain/precompiles/tokenfactory"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/kiichain/kiichain/x/evm/state"
+	"github.com/kiichain/kiichain/x/evm/types"
+	tokenfactorykeeper "github.com/kiichain/kiichain/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/kiichain/kiichain/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+// run calls a precompile method and returns the unpacked outputs
+func run(t *testing.T, p *pcommon.DynamicGasPrecompile, evm *vm.EVM, caller common.Address, methodName string, readOnly bool, args ...interface{}) ([]interface{}, error) {
+	method := p.ABI.Methods[methodName]
+	input, err := method.Inputs.Pack(args...)
+	require.NoError(t, err)
+	ret, _, err := p.RunAndCalculateGas(evm, caller, caller, append(method.ID, input...), 10000000, nil, nil, readOnly, false)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := method.Outputs.Unpack(ret)
+	require.NoError(t, err)
+	return outputs, nil
+}
+
+func TestTokenFactory(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	// Precompiles run inside EVM transactions, where pointers are deployed with the EVM of the caller
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).WithIsEVM(true)
+	p, err := tokenfactory.NewPrecompile(
+		tokenfactorykeeper.NewMsgServerImpl(testApp.TokenFactoryKeeper),
+		testApp.TokenFactoryKeeper,
+		&testApp.EvmKeeper,
+	)
+	require.NoError(t, err)
+
+	// Prepare the admin with an association and a second account
+	kiiAddr, evmAddr := testkeeper.MockAddressPair()
+	testApp.EvmKeeper.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	otherKiiAddr, _ := testkeeper.MockAddressPair()
+	_, unassociatedEvmAddr := testkeeper.MockAddressPair()
+
+	cfg := types.DefaultChainConfig().EthereumConfig(testApp.EvmKeeper.ChainID(ctx))
+	statedb := state.NewDBImpl(ctx, &testApp.EvmKeeper, false)
+	blockCtx, _ := testApp.EvmKeeper.GetVMBlockContext(ctx, core.GasPool(10000000))
+	evm := vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{})
+
+	// Callers must be associated
+	_, err = run(t, p, evm, unassociatedEvmAddr, tokenfactory.CreateDenomMethod, false, "test", []string{}, false)
+	require.Error(t, err)
+
+	// State changing methods can't be called from staticcall
+	_, err = run(t, p, evm, evmAddr, tokenfactory.CreateDenomMethod, true, "test", []string{}, false)
+	require.Error(t, err)
+
+	// Create a denom with a pointer
+	outputs, err := run(t, p, evm, evmAddr, tokenfactory.CreateDenomMethod, false, "test", []string{}, true)
+	require.NoError(t, err)
+	denom := outputs[0].(string)
+	require.Equal(t, "factory/"+kiiAddr.String()+"/test", denom)
+	pointerAddr, _, exists := testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.True(t, exists)
+	require.Equal(t, pointerAddr, outputs[1].(common.Address))
+
+	// Create a denom without a pointer
+	outputs, err = run(t, p, evm, evmAddr, tokenfactory.CreateDenomMethod, false, "nopointer", []string{kiiAddr.String()}, false)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, outputs[1].(common.Address))
+	_, _, exists = testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), outputs[0].(string))
+	require.False(t, exists)
+
+	// Unless the tokenfactory module registers pointers for every denom
+	testApp.TokenFactoryKeeper.SetParams(statedb.Ctx(), tokenfactorytypes.NewParams(true))
+	outputs, err = run(t, p, evm, evmAddr, tokenfactory.CreateDenomMethod, false, "autopointer", []string{}, false)
+	require.NoError(t, err)
+	autoPointerAddr, _, exists := testApp.EvmKeeper.GetERC20NativePointer(statedb.Ctx(), outputs[0].(string))
+	require.True(t, exists)
+	require.Equal(t, autoPointerAddr, outputs[1].(common.Address))
+	testApp.TokenFactoryKeeper.SetParams(statedb.Ctx(), tokenfactorytypes.NewParams(false))
+
+	// Queries work from staticcall
+	outputs, err = run(t, p, evm, evmAddr, tokenfactory.GetAdminMethod, true, denom)
+	require.NoError(t, err)
+	require.Equal(t, kiiAddr.String(), outputs[0].(string))
+	outputs, err = run(t, p, evm, evmAddr, tokenfactory.GetDenomsFromCreatorMethod, true, kiiAddr.String())
+	require.NoError(t, err)
+	require.Len(t, outputs[0].([]string), 3)
+
+	// Mint and burn
+	_, err = run(t, p, evm, evmAddr, tokenfactory.MintMethod, false, denom, big.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), testApp.BankKeeper.GetBalance(statedb.Ctx(), kiiAddr, denom).Amount.Int64())
+	_, err = run(t, p, evm, evmAddr, tokenfactory.BurnMethod, false, denom, big.NewInt(400))
+	require.NoError(t, err)
+	require.Equal(t, int64(600), testApp.BankKeeper.GetBalance(statedb.Ctx(), kiiAddr, denom).Amount.Int64())
+	_, err = run(t, p, evm, evmAddr, tokenfactory.MintMethod, false, denom, big.NewInt(0))
+	require.Error(t, err)
+
+	// Update the allow list
+	_, err = run(t, p, evm, evmAddr, tokenfactory.UpdateDenomMethod, false, denom, []string{kiiAddr.String(), otherKiiAddr.String()})
+	require.NoError(t, err)
+	allowList := testApp.BankKeeper.GetDenomAllowList(statedb.Ctx(), denom)
+	require.Equal(t, []string{kiiAddr.String(), otherKiiAddr.String()}, allowList.Addresses)
+
+	// Set the metadata
+	_, err = run(t, p, evm, evmAddr, tokenfactory.SetMetadataMethod, false, denom, "Test Token", "TEST", "a test token", uint8(6))
+	require.NoError(t, err)
+	metadata, found := testApp.BankKeeper.GetDenomMetaData(statedb.Ctx(), denom)
+	require.True(t, found)
+	require.Equal(t, "Test Token", metadata.Name)
+	require.Equal(t, "TEST", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 2)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+	name, err := testApp.EvmKeeper.QueryERCSingleOutput(statedb.Ctx(), "native", pointerAddr, "name")
+	require.NoError(t, err)
+	require.Equal(t, "TEST", name.(string))
+	decimals, err := testApp.EvmKeeper.QueryERCSingleOutput(statedb.Ctx(), "native", pointerAddr, "decimals")
+	require.NoError(t, err)
+	require.Equal(t, uint8(6), decimals.(uint8))
+
+	// Change the admin, the caller can't mint anymore
+	_, err = run(t, p, evm, evmAddr, tokenfactory.ChangeAdminMethod, false, denom, otherKiiAddr.String())
+	require.NoError(t, err)
+	outputs, err = run(t, p, evm, evmAddr, tokenfactory.GetAdminMethod, true, denom)
+	require.NoError(t, err)
+	require.Equal(t, otherKiiAddr.String(), outputs[0].(string))
+	_, err = run(t, p, evm, evmAddr, tokenfactory.MintMethod, false, denom, big.NewInt(1000))
+	require.Error(t, err)
+}
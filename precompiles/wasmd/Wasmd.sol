@@ -8,6 +8,19 @@ IWasmd constant WASMD_CONTRACT = IWasmd(
 );
 
 interface IWasmd {
+    // Events
+    event Instantiate(
+        address indexed sender,
+        uint64 indexed codeID,
+        string contractAddress
+    );
+
+    // emitted for every message of a batch
+    event Execute(
+        address indexed sender,
+        string contractAddress
+    );
+
     // Transactions
     function instantiate(
         uint64 codeID,
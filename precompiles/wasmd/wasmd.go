@@ -25,6 +25,11 @@ const (
 	QueryMethod        = "query"
 )
 
+const (
+	InstantiateEvent = "Instantiate"
+	ExecuteEvent     = "Execute"
+)
+
 const WasmdAddress = "0x0000000000000000000000000000000000001002"
 
 var Address = common.HexToAddress(WasmdAddress)
@@ -40,6 +45,7 @@ type PrecompileExecutor struct {
 	wasmdKeeper     pcommon.WasmdKeeper
 	wasmdViewKeeper pcommon.WasmdViewKeeper
 	address         common.Address
+	events          map[string]abi.Event
 
 	InstantiateID  []byte
 	ExecuteID      []byte
@@ -66,6 +72,7 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, wasmdKeeper pcommon.WasmdKeeper,
 		evmKeeper:       evmKeeper,
 		bankKeeper:      bankKeeper,
 		address:         Address,
+		events:          newAbi.Events,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -89,11 +96,11 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 	}
 	switch method.Name {
 	case InstantiateMethod:
-		return p.instantiate(ctx, method, caller, callingContract, args, value, readOnly)
+		return p.instantiate(ctx, method, caller, callingContract, args, value, readOnly, evm)
 	case ExecuteMethod:
-		return p.execute(ctx, method, caller, callingContract, args, value, readOnly)
+		return p.execute(ctx, method, caller, callingContract, args, value, readOnly, evm)
 	case ExecuteBatchMethod:
-		return p.executeBatch(ctx, method, caller, callingContract, args, value, readOnly)
+		return p.executeBatch(ctx, method, caller, callingContract, args, value, readOnly, evm)
 	case QueryMethod:
 		return p.query(ctx, method, args, value)
 	}
@@ -104,7 +111,7 @@ func (p PrecompileExecutor) EVMKeeper() pcommon.EVMKeeper {
 	return p.evmKeeper
 }
 
-func (p PrecompileExecutor) instantiate(ctx sdk.Context, method *abi.Method, caller common.Address, _ common.Address, args []interface{}, value *big.Int, readOnly bool) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) instantiate(ctx sdk.Context, method *abi.Method, caller common.Address, _ common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -192,12 +199,16 @@ func (p PrecompileExecutor) instantiate(ctx sdk.Context, method *abi.Method, cal
 		rerr = err
 		return
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[InstantiateEvent], caller, codeID, addr.String()); err != nil {
+		rerr = err
+		return
+	}
 	ret, rerr = method.Outputs.Pack(addr.String(), data)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
 }
 
-func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -316,6 +327,10 @@ func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, ca
 			rerr = err
 			return
 		}
+		if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[ExecuteEvent], caller, contractAddrStr); err != nil {
+			rerr = err
+			return
+		}
 		responses = append(responses, res)
 	}
 	if valueCopy != nil && valueCopy.Sign() != 0 {
@@ -327,7 +342,7 @@ func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, ca
 	return
 }
 
-func (p PrecompileExecutor) execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool) (ret []byte, remainingGas uint64, rerr error) {
+func (p PrecompileExecutor) execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM) (ret []byte, remainingGas uint64, rerr error) {
 	defer func() {
 		if err := recover(); err != nil {
 			ret = nil
@@ -410,6 +425,10 @@ func (p PrecompileExecutor) execute(ctx sdk.Context, method *abi.Method, caller
 		rerr = err
 		return
 	}
+	if err := pcommon.EmitEvent(ctx, p.evmKeeper, evm, p.address, p.events[ExecuteEvent], caller, contractAddrStr); err != nil {
+		rerr = err
+		return
+	}
 	ret, rerr = method.Outputs.Pack(res)
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
@@ -4,13 +4,18 @@ import time
 import subprocess
 import requests
 import re
+import secrets
 from pathlib import Path
 import sys
 from price_fetcher import PriceFetcher
 
 CMD = "printf '{password}\n' | {binary}"
+PREVOTE_TMPL = (
+    " tx oracle aggregate-prevote {salt} {vote_prices} {val_addr} --from {key} "
+    "--chain-id={chain_id} -y --broadcast-mode=sync --node={node}"
+)
 VOTE_TMPL = (
-    " tx oracle aggregate-vote {vote_prices} {val_addr} --from {key} "
+    " tx oracle aggregate-vote {salt} {vote_prices} {val_addr} --from {key} "
     "--chain-id={chain_id} -y --broadcast-mode=sync --node={node}"
 )
 
@@ -42,14 +47,15 @@ class PriceFeeder:
         body = res.json()
         return int(body["result"]["last_height"]) // self.vote_period
 
-    def vote_for_period(self, vote_prices):
+    def submit_tx(self, tmpl, salt, vote_prices):
         result = subprocess.check_output(
             [
                 CMD.format(password=self.password, binary=self.binary) +
-                VOTE_TMPL.format(
+                tmpl.format(
                     key=self.key,
                     chain_id=self.chain_id,
                     val_addr=self.val_addr,
+                    salt=salt,
                     vote_prices=vote_prices,
                     node=self.node
                 )
@@ -60,10 +66,26 @@ class PriceFeeder:
 
         if re.search("code: \d{1,2}", result.decode("utf-8")).group(0) != "code: 0":
             print("Err: ", result)
-            print("Oracle price didn't submit successfully!!")
+            return False
+        return True
+
+    def vote_for_period(self, vote_prices, previous_prevote):
+        # reveal the prices committed on the previous vote period
+        if previous_prevote is not None:
+            salt, prices = previous_prevote
+            if not self.submit_tx(VOTE_TMPL, salt, prices):
+                print("Oracle price didn't submit successfully!!")
+
+        # commit the current prices, they will be revealed on the next vote period
+        salt = secrets.token_hex(32)
+        if not self.submit_tx(PREVOTE_TMPL, salt, vote_prices):
+            print("Oracle prevote didn't submit successfully!!")
+            return None
+        return (salt, vote_prices)
 
     def vote_loop(self, coins, interval=0.2):
         last_voted_period = -1
+        previous_prevote = None
         vote_loop_break = 0
         pf = PriceFetcher(self.api_key)
 
@@ -78,7 +100,10 @@ class PriceFeeder:
                     continue
 
                 print("submitting price feed ", vote_prices)
-                self.vote_for_period(vote_prices)
+                # a prevote can only be revealed on the next vote period
+                if last_voted_period != current_vote_period - 1:
+                    previous_prevote = None
+                previous_prevote = self.vote_for_period(vote_prices, previous_prevote)
                 last_voted_period = current_vote_period
                 vote_loop_break += 1
 
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
+	"math/big"
 	"sync"
+	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -10,11 +13,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kiichain/kiichain/app"
+	"github.com/kiichain/kiichain/x/evm/ante"
 	evmkeeper "github.com/kiichain/kiichain/x/evm/keeper"
 	evmtypes "github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 )
 
 var EVMTestApp = app.Setup(false, true)
@@ -104,3 +111,30 @@ func PrivateKeyToAddresses(privKey cryptotypes.PrivKey) (sdk.AccAddress, common.
 func UkiiCoins(amount int64) sdk.Coins {
 	return sdk.NewCoins(sdk.NewCoin(sdk.MustGetBaseDenom(), sdk.NewInt(amount)))
 }
+
+// SendEVMTx signs a call to the contract at to with key and runs it through
+// the EVM msg server.
+func SendEVMTx(t testing.TB, ctx sdk.Context, k *evmkeeper.Keeper, key *ecdsa.PrivateKey, to common.Address, data []byte, value *big.Int, nonce uint64) *evmtypes.MsgEVMTransactionResponse {
+	txData := ethtypes.LegacyTx{
+		GasPrice: big.NewInt(1000000000000),
+		Gas:      20000000,
+		To:       &to,
+		Value:    value,
+		Data:     data,
+		Nonce:    nonce,
+	}
+	chainCfg := evmtypes.DefaultChainConfig()
+	ethCfg := chainCfg.EthereumConfig(k.ChainID(ctx))
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&txData), signer, key)
+	require.Nil(t, err)
+	txwrapper, err := ethtx.NewLegacyTx(tx)
+	require.Nil(t, err)
+	req, err := evmtypes.NewMsgEVMTransaction(txwrapper)
+	require.Nil(t, err)
+
+	ante.Preprocess(ctx, req)
+	res, err := evmkeeper.NewMsgServerImpl(k).EVMTransaction(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	return res
+}
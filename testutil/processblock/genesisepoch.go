@@ -1,9 +1,13 @@
 package processblock
 
-import "time"
+import (
+	"time"
+
+	epochtypes "github.com/kiichain/kiichain/x/epoch/types"
+)
 
 func (a *App) FastEpoch() {
-	epoch := a.EpochKeeper.GetEpoch(a.Ctx())
+	epoch, _ := a.EpochKeeper.GetEpoch(a.Ctx(), epochtypes.DayEpochIdentifier)
 	epoch.EpochDuration = 5 * time.Second
 	a.EpochKeeper.SetEpoch(a.Ctx(), epoch)
 }
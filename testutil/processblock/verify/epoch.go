@@ -5,15 +5,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/kiichain/kiichain/testutil/processblock"
+	epochtypes "github.com/kiichain/kiichain/x/epoch/types"
 	"github.com/stretchr/testify/require"
 )
 
 func Epoch(t *testing.T, app *processblock.App, f BlockRunnable, _ []signing.Tx) BlockRunnable {
 	return func() []uint32 {
-		oldEpoch := app.EpochKeeper.GetEpoch(app.Ctx())
+		oldEpoch, _ := app.EpochKeeper.GetEpoch(app.Ctx(), epochtypes.DayEpochIdentifier)
 		res := f()
 		if app.Ctx().BlockTime().Sub(oldEpoch.CurrentEpochStartTime) > oldEpoch.EpochDuration {
-			newPoch := app.EpochKeeper.GetEpoch(app.Ctx())
+			newPoch, _ := app.EpochKeeper.GetEpoch(app.Ctx(), epochtypes.DayEpochIdentifier)
 			require.Equal(t, oldEpoch.CurrentEpoch+1, newPoch.CurrentEpoch)
 		}
 		return res
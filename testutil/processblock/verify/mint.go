@@ -13,7 +13,7 @@ import (
 func MintRelease(t *testing.T, app *processblock.App, f BlockRunnable, _ []signing.Tx) BlockRunnable {
 	return func() []uint32 {
 		oldMinter := app.MintKeeper.GetMinter(app.Ctx())
-		oldEpoch := app.EpochKeeper.GetEpoch(app.Ctx())
+		oldEpoch, _ := app.EpochKeeper.GetEpoch(app.Ctx(), minttypes.MintEpochIdentifier)
 		oldSupply := app.BankKeeper.GetSupply(app.Ctx(), "ukii")
 		res := f()
 		// if minter minted, it must be a new epoch, but not the other way around
@@ -21,7 +21,7 @@ func MintRelease(t *testing.T, app *processblock.App, f BlockRunnable, _ []signi
 		if newMinter.RemainingMintAmount == oldMinter.RemainingMintAmount {
 			return res
 		}
-		newPoch := app.EpochKeeper.GetEpoch(app.Ctx())
+		newPoch, _ := app.EpochKeeper.GetEpoch(app.Ctx(), minttypes.MintEpochIdentifier)
 		require.Equal(t, oldEpoch.CurrentEpoch+1, newPoch.CurrentEpoch)
 		startDate, err := time.Parse(minttypes.TokenReleaseDateFormat, oldMinter.StartDate)
 		if err != nil {
@@ -149,10 +149,11 @@ func SetOracleVotePenaltyCount(count uint64, valAddr string, penaltyType string)
 }
 
 // kii_epoch_new
-func SetEpochNew(epochNum uint64) {
-	metrics.SetGauge(
+func SetEpochNew(identifier string, epochNum uint64) {
+	metrics.SetGaugeWithLabels(
 		[]string{"kii", "epoch", "new"},
 		float32(epochNum),
+		[]metrics.Label{telemetry.NewLabel("identifier", identifier)},
 	)
 }
 
@@ -279,6 +280,22 @@ func IncrementRpcRequestCounter(endpoint string, connectionType string, success
 	)
 }
 
+// Counts RPC requests rejected by the rate limiter
+// Metric Name:
+//
+//	kii_rpc_throttled_counter
+func IncrementRpcThrottledCounter(tier string, connectionType string, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"kii", "rpc", "throttled", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("tier", tier),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+}
+
 func IncrementErrorMetrics(scenario string, err error) {
 	if err == nil {
 		return
@@ -55,6 +55,17 @@ func (qp QueryPlugin) HandleEpochQuery(ctx sdk.Context, queryData json.RawMessag
 			return nil, epochtypes.ErrEncodingEpoch
 		}
 
+		return bz, nil
+	case parsedQuery.Epochs != nil:
+		res, err := qp.epochHandler.GetEpochs(ctx, parsedQuery.Epochs)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, epochtypes.ErrEncodingEpoch
+		}
+
 		return bz, nil
 	default:
 		return nil, epochtypes.ErrUnknownKiiEpochQuery
@@ -60,6 +60,7 @@ func TestWasmGetEpoch(t *testing.T) {
 
 	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(45).WithBlockTime(time.Unix(12500, 0))
 	testWrapper.App.EpochKeeper.SetEpoch(testWrapper.Ctx, epochtypes.Epoch{
+		Identifier:            epochtypes.DayEpochIdentifier,
 		GenesisTime:           time.Unix(1000, 0).UTC(),
 		EpochDuration:         time.Minute,
 		CurrentEpoch:          uint64(69),
@@ -81,6 +82,50 @@ func TestWasmGetEpoch(t *testing.T) {
 	require.Equal(t, int64(40), epoch.CurrentEpochHeight)
 }
 
+func TestWasmGetEpochs(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := epochbinding.KiiEpochQuery{
+		Epochs: &epochtypes.QueryEpochsRequest{},
+	}
+
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.KiiQueryWrapper{Route: wasmbinding.EpochRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(45).WithBlockTime(time.Unix(12500, 0))
+	testWrapper.App.EpochKeeper.SetEpoch(testWrapper.Ctx, epochtypes.Epoch{
+		Identifier:            epochtypes.HourEpochIdentifier,
+		GenesisTime:           time.Unix(1000, 0).UTC(),
+		EpochDuration:         time.Hour,
+		CurrentEpoch:          uint64(3),
+		CurrentEpochStartTime: time.Unix(12345, 0).UTC(),
+		CurrentEpochHeight:    int64(40),
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes epochtypes.QueryEpochsResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+
+	// Look for the hour epoch
+	var hourEpoch *epochtypes.Epoch
+	for i, epoch := range parsedRes.Epochs {
+		if epoch.Identifier == epochtypes.HourEpochIdentifier {
+			hourEpoch = &parsedRes.Epochs[i]
+		}
+	}
+	require.NotNil(t, hourEpoch)
+	require.Equal(t, time.Hour, hourEpoch.EpochDuration)
+	require.Equal(t, uint64(3), hourEpoch.CurrentEpoch)
+	require.Equal(t, int64(40), hourEpoch.CurrentEpochHeight)
+}
+
 func TestWasmGetDenomAuthorityMetadata(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -11,15 +11,45 @@ import (
 
 func CmdQueryEpoch() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "epoch",
-		Short: "gets the current epoch",
+		Use:   "epoch [identifier]",
+		Short: "gets the current epoch by identifier, defaults to the day epoch",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Get the optional identifier
+			req := &types.QueryEpochRequest{}
+			if len(args) > 0 {
+				req.Identifier = args[0]
+			}
+
+			res, err := queryClient.Epoch(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Short: "gets all the current epochs",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Epoch(context.Background(), &types.QueryEpochRequest{})
+			res, err := queryClient.Epochs(context.Background(), &types.QueryEpochsRequest{})
 			if err != nil {
 				return err
 			}
@@ -3,6 +3,8 @@ package bindings
 import "github.com/kiichain/kiichain/x/epoch/types"
 
 type KiiEpochQuery struct {
-	// queries the current Epoch
+	// queries the current Epoch by identifier
 	Epoch *types.QueryEpochRequest `json:"epoch,omitempty"`
+	// queries all the current Epochs
+	Epochs *types.QueryEpochsRequest `json:"epochs,omitempty"`
 }
@@ -20,3 +20,8 @@ func (handler EpochWasmQueryHandler) GetEpoch(ctx sdk.Context, req *types.QueryE
 	c := sdk.WrapSDKContext(ctx)
 	return handler.epochKeeper.Epoch(c, req)
 }
+
+func (handler EpochWasmQueryHandler) GetEpochs(ctx sdk.Context, req *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	return handler.epochKeeper.Epochs(c, req)
+}
@@ -11,18 +11,16 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
-	k.SetEpoch(
-		ctx,
-		*genState.Epoch,
-	)
+	for _, epoch := range genState.Epochs {
+		k.SetEpoch(ctx, epoch)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
-	epoch := k.GetEpoch(ctx)
-	genesis.Epoch = &epoch
+	genesis.Epochs = k.AllEpochs(ctx)
 
 	return genesis
 }
@@ -15,12 +15,23 @@ func TestGenesis(t *testing.T) {
 	now := time.Now()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-		Epoch: &types.Epoch{
-			GenesisTime:           now,
-			EpochDuration:         time.Minute,
-			CurrentEpoch:          1,
-			CurrentEpochStartTime: now,
-			CurrentEpochHeight:    0,
+		Epochs: []types.Epoch{
+			{
+				Identifier:            types.DayEpochIdentifier,
+				GenesisTime:           now,
+				EpochDuration:         time.Hour * 24,
+				CurrentEpoch:          1,
+				CurrentEpochStartTime: now,
+				CurrentEpochHeight:    0,
+			},
+			{
+				Identifier:            types.HourEpochIdentifier,
+				GenesisTime:           now,
+				EpochDuration:         time.Hour,
+				CurrentEpoch:          5,
+				CurrentEpochStartTime: now,
+				CurrentEpochHeight:    0,
+			},
 		},
 	}
 
@@ -28,7 +39,13 @@ func TestGenesis(t *testing.T) {
 	epoch.InitGenesis(ctx, *k, genesisState)
 	got := epoch.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
-	require.Equal(t, got.Epoch.CurrentEpoch, genesisState.Epoch.CurrentEpoch)
+	require.Len(t, got.Epochs, 2)
+
+	// Epochs are exported ordered by identifier
+	require.Equal(t, genesisState.Epochs[0].CurrentEpoch, got.Epochs[0].CurrentEpoch)
+	require.Equal(t, genesisState.Epochs[0].Identifier, got.Epochs[0].Identifier)
+	require.Equal(t, genesisState.Epochs[1].CurrentEpoch, got.Epochs[1].CurrentEpoch)
+	require.Equal(t, genesisState.Epochs[1].Identifier, got.Epochs[1].Identifier)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
@@ -1,25 +1,78 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/kiichain/kiichain/x/epoch/types"
 )
 
-const EpochKey = "epoch"
-
+// SetEpoch stores the epoch under its identifier
 func (k Keeper) SetEpoch(ctx sdk.Context, epoch types.Epoch) {
 	store := ctx.KVStore(k.storeKey)
 	value, err := proto.Marshal(&epoch)
 	if err != nil {
 		panic(err)
 	}
-	store.Set([]byte(EpochKey), value)
+	store.Set(types.EpochKey(epoch.Identifier), value)
 }
 
-func (k Keeper) GetEpoch(ctx sdk.Context) (epoch types.Epoch) {
+// GetEpoch returns the epoch with the given identifier
+func (k Keeper) GetEpoch(ctx sdk.Context, identifier string) (epoch types.Epoch, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	b := store.Get([]byte(EpochKey))
+	b := store.Get(types.EpochKey(identifier))
+	if b == nil {
+		return epoch, false
+	}
 	k.cdc.MustUnmarshal(b, &epoch)
-	return epoch
+	return epoch, true
+}
+
+// DeleteEpoch removes the epoch with the given identifier
+func (k Keeper) DeleteEpoch(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochKey(identifier))
+}
+
+// IterateEpochs iterates over all the epochs ordered by identifier
+// the iteration stops when the handler returns true
+func (k Keeper) IterateEpochs(ctx sdk.Context, handler func(epoch types.Epoch) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.Epoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		if handler(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochs returns all the epochs ordered by identifier
+func (k Keeper) AllEpochs(ctx sdk.Context) []types.Epoch {
+	epochs := []types.Epoch{}
+	k.IterateEpochs(ctx, func(epoch types.Epoch) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+	return epochs
+}
+
+// GetLegacyEpoch returns the single epoch stored before named epochs were introduced
+func (k Keeper) GetLegacyEpoch(ctx sdk.Context) (epoch types.Epoch, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.LegacyEpochKey))
+	if b == nil {
+		return epoch, false
+	}
+	k.cdc.MustUnmarshal(b, &epoch)
+	return epoch, true
+}
+
+// DeleteLegacyEpoch removes the single epoch stored before named epochs were introduced
+func (k Keeper) DeleteLegacyEpoch(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.LegacyEpochKey))
 }
@@ -17,17 +17,46 @@ func TestEpochKeeper(t *testing.T) {
 	// Define an epoch
 	currentTime := time.Now().UTC()
 	epochIn := types.Epoch{
+		Identifier:            types.DayEpochIdentifier,
 		CurrentEpochStartTime: currentTime,
 		CurrentEpochHeight:    100,
 	}
 
 	// Verify that it's equal to what is set
 	app.EpochKeeper.SetEpoch(ctx, epochIn)
-	epochOut := app.EpochKeeper.GetEpoch(ctx)
+	epochOut, found := app.EpochKeeper.GetEpoch(ctx, types.DayEpochIdentifier)
+	require.True(t, found)
 	require.Equal(t, epochIn, epochOut)
 
+	// Unknown identifiers are not found
+	_, found = app.EpochKeeper.GetEpoch(ctx, "unknown")
+	require.False(t, found)
+
+	// Epochs are kept apart by identifier
+	hourEpoch := types.Epoch{
+		Identifier:            types.HourEpochIdentifier,
+		CurrentEpochStartTime: currentTime,
+		CurrentEpoch:          7,
+	}
+	app.EpochKeeper.SetEpoch(ctx, hourEpoch)
+	epochOut, found = app.EpochKeeper.GetEpoch(ctx, types.DayEpochIdentifier)
+	require.True(t, found)
+	require.Equal(t, epochIn, epochOut)
+
+	// All epochs are returned, including the genesis ones
+	epochs := app.EpochKeeper.AllEpochs(ctx)
+	require.Len(t, epochs, 3)
+	require.Contains(t, epochs, epochIn)
+	require.Contains(t, epochs, hourEpoch)
+
+	// The epoch can be removed
+	app.EpochKeeper.DeleteEpoch(ctx, types.HourEpochIdentifier)
+	_, found = app.EpochKeeper.GetEpoch(ctx, types.HourEpochIdentifier)
+	require.False(t, found)
+
 	// Test case: Should panic since ctx.Blocktime() is 0
 	lastEpoch := types.Epoch{
+		Identifier:            types.DayEpochIdentifier,
 		CurrentEpochStartTime: ctx.BlockTime().Add(-2 * time.Hour), // 2 hours ago
 		EpochDuration:         1 * time.Hour,                       // 1 hour epochs
 		CurrentEpoch:          2,
@@ -5,11 +5,31 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kiichain/kiichain/x/epoch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (k Keeper) Epoch(c context.Context, _ *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+func (k Keeper) Epoch(c context.Context, req *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	epoch := k.GetEpoch(ctx)
+	// Default to the "day" epoch, the one stored before named epochs
+	identifier := req.Identifier
+	if identifier == "" {
+		identifier = types.DefaultEpochIdentifier
+	}
+
+	epoch, found := k.GetEpoch(ctx, identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch %s not found", identifier)
+	}
 	return &types.QueryEpochResponse{Epoch: epoch}, nil
 }
+
+func (k Keeper) Epochs(c context.Context, _ *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochsResponse{Epochs: k.AllEpochs(ctx)}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
@@ -15,7 +16,45 @@ func TestEpochQuery(t *testing.T) {
 	epoch := types.DefaultEpoch()
 	keeper.SetEpoch(ctx, *epoch)
 
+	// Empty identifier defaults to the day epoch
 	response, err := keeper.Epoch(wctx, &types.QueryEpochRequest{})
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryEpochResponse{Epoch: *epoch}, response)
+
+	// Query by identifier
+	now := time.Now().UTC()
+	hourEpoch := types.NewEpoch(types.HourEpochIdentifier, now, time.Hour, 3, now, 10)
+	keeper.SetEpoch(ctx, *hourEpoch)
+	response, err = keeper.Epoch(wctx, &types.QueryEpochRequest{Identifier: types.HourEpochIdentifier})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEpochResponse{Epoch: *hourEpoch}, response)
+
+	// Unknown identifier
+	_, err = keeper.Epoch(wctx, &types.QueryEpochRequest{Identifier: "unknown"})
+	require.ErrorContains(t, err, "epoch unknown not found")
+
+	// Nil request
+	_, err = keeper.Epoch(wctx, nil)
+	require.Error(t, err)
+}
+
+func TestEpochsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.EpochKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// No epochs
+	response, err := keeper.Epochs(wctx, &types.QueryEpochsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Epochs)
+
+	// Set the default epochs
+	epochs := types.DefaultEpochs()
+	for _, epoch := range epochs {
+		keeper.SetEpoch(ctx, epoch)
+	}
+
+	// Epochs are returned ordered by identifier
+	response, err = keeper.Epochs(wctx, &types.QueryEpochsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Epoch{epochs[1], epochs[0], epochs[2]}, response.Epochs)
 }
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/x/epoch/keeper"
+	"github.com/kiichain/kiichain/x/epoch/types"
+)
+
+// V4MigrateStore apply the migration from v3 to v4 for the module
+// the single stored epoch becomes the "day" named epoch with a duration of one day
+// and the missing default epochs ("hour" and "week") are created starting at the current block
+func V4MigrateStore(ctx sdk.Context, k *keeper.Keeper) error {
+	// Keep the counters and timings of the legacy epoch, name it and set the day duration
+	legacyEpoch, found := k.GetLegacyEpoch(ctx)
+	if found {
+		legacyEpoch.Identifier = types.DayEpochIdentifier
+		legacyEpoch.EpochDuration = types.DefaultEpochDuration
+		k.SetEpoch(ctx, legacyEpoch)
+		k.DeleteLegacyEpoch(ctx)
+	}
+
+	// Create the default epochs that don't exist yet
+	for _, defaultEpoch := range types.DefaultEpochs() {
+		if _, found := k.GetEpoch(ctx, defaultEpoch.Identifier); found {
+			continue
+		}
+
+		epoch := types.NewEpoch(
+			defaultEpoch.Identifier,
+			ctx.BlockTime(),
+			defaultEpoch.EpochDuration,
+			types.DefaultCurrentEpoch,
+			ctx.BlockTime(),
+			ctx.BlockHeight(),
+		)
+		k.SetEpoch(ctx, *epoch)
+	}
+
+	ctx.Logger().Info("Migration to v4 completed successfully")
+
+	return nil
+}
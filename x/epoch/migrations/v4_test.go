@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/kiichain/kiichain/x/epoch/migrations"
+	"github.com/kiichain/kiichain/x/epoch/types"
+)
+
+// TestV3toV4Migration test the v3 to v4 migration
+func TestV3toV4Migration(t *testing.T) {
+	// Get the keeper and context
+	k := testkeeper.EVMTestApp.EpochKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+
+	// Remove the genesis epochs and store the legacy single epoch
+	for _, epoch := range types.DefaultEpochs() {
+		k.DeleteEpoch(ctx, epoch.Identifier)
+	}
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now.Add(time.Minute)).WithBlockHeight(110)
+	legacyEpoch := types.Epoch{
+		GenesisTime:           now.Add(-time.Hour),
+		EpochDuration:         time.Minute,
+		CurrentEpoch:          42,
+		CurrentEpochStartTime: now,
+		CurrentEpochHeight:    100,
+	}
+	bz, err := proto.Marshal(&legacyEpoch)
+	require.NoError(t, err)
+	store := ctx.KVStore(testkeeper.EVMTestApp.GetKey(types.StoreKey))
+	store.Set([]byte(types.LegacyEpochKey), bz)
+
+	// Run the migration
+	err = migrations.V4MigrateStore(ctx, &k)
+	require.NoError(t, err)
+
+	// The legacy epoch is now the day epoch, with the day duration
+	epoch, found := k.GetEpoch(ctx, types.DayEpochIdentifier)
+	require.True(t, found)
+	dayEpoch := legacyEpoch
+	dayEpoch.Identifier = types.DayEpochIdentifier
+	dayEpoch.EpochDuration = time.Hour * 24
+	require.Equal(t, dayEpoch, epoch)
+
+	// The hour and week epochs start at the migration block
+	hourEpoch := *types.NewEpoch(types.HourEpochIdentifier, ctx.BlockTime(), time.Hour, 0, ctx.BlockTime(), 110)
+	weekEpoch := *types.NewEpoch(types.WeekEpochIdentifier, ctx.BlockTime(), time.Hour*24*7, 0, ctx.BlockTime(), 110)
+	require.Equal(t, []types.Epoch{dayEpoch, hourEpoch, weekEpoch}, k.AllEpochs(ctx))
+	for _, epoch := range k.AllEpochs(ctx) {
+		require.NoError(t, epoch.Validate())
+	}
+
+	// The legacy key is removed
+	_, found = k.GetLegacyEpoch(ctx)
+	require.False(t, found)
+
+	// Running again is a no-op
+	err = migrations.V4MigrateStore(ctx.WithBlockHeight(120), &k)
+	require.NoError(t, err)
+	require.Equal(t, []types.Epoch{dayEpoch, hourEpoch, weekEpoch}, k.AllEpochs(ctx))
+}
@@ -166,6 +166,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Register the v3 to v4 migration
+	err = cfg.RegisterMigration(types.ModuleName, 3, func(ctx sdk.Context) error {
+		// Migrate the store
+		if err := migrations.V4MigrateStore(ctx, &am.keeper); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -200,17 +213,22 @@ func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec) <-
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	lastEpoch := am.keeper.GetEpoch(ctx)
-	ctx.Logger().Info(fmt.Sprintf("Current block time %s, last %s; duration %d", ctx.BlockTime().String(), lastEpoch.CurrentEpochStartTime.String(), lastEpoch.EpochDuration))
+	// Each epoch advances on its own duration
+	for _, lastEpoch := range am.keeper.AllEpochs(ctx) {
+		ctx.Logger().Info(fmt.Sprintf("Epoch %s current block time %s, last %s; duration %d", lastEpoch.Identifier, ctx.BlockTime().String(), lastEpoch.CurrentEpochStartTime.String(), lastEpoch.EpochDuration))
+
+		if ctx.BlockTime().Sub(lastEpoch.CurrentEpochStartTime) <= lastEpoch.EpochDuration {
+			continue
+		}
 
-	if ctx.BlockTime().Sub(lastEpoch.CurrentEpochStartTime) > lastEpoch.EpochDuration {
 		am.keeper.AfterEpochEnd(ctx, lastEpoch)
 
 		newEpoch := types.Epoch{
+			Identifier:            lastEpoch.Identifier,
 			GenesisTime:           lastEpoch.GenesisTime,
 			EpochDuration:         lastEpoch.EpochDuration,
 			CurrentEpoch:          lastEpoch.CurrentEpoch + 1,
@@ -222,13 +240,14 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.EventTypeNewEpoch,
+				sdk.NewAttribute(types.AttributeEpochIdentifier, newEpoch.Identifier),
 				sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprint(newEpoch.CurrentEpoch)),
 				sdk.NewAttribute(types.AttributeEpochTime, newEpoch.CurrentEpochStartTime.String()),
 				sdk.NewAttribute(types.AttributeEpochHeight, fmt.Sprint(newEpoch.CurrentEpochHeight)),
 			),
 		)
 
-		metrics.SetEpochNew(newEpoch.CurrentEpoch)
+		metrics.SetEpochNew(newEpoch.Identifier, newEpoch.CurrentEpoch)
 	}
 }
 
@@ -94,6 +94,7 @@ func TestBeginBlock(t *testing.T) {
 
 	// Test case: new epoch should start
 	lastEpoch := types.Epoch{
+		Identifier:            types.HourEpochIdentifier,
 		GenesisTime:           now.Add(-3 * time.Hour),
 		CurrentEpochStartTime: ctx.BlockTime().Add(-2 * time.Hour), // 2 hours ago
 		EpochDuration:         1 * time.Hour,                       // 1 hour epochs
@@ -102,21 +103,38 @@ func TestBeginBlock(t *testing.T) {
 	}
 	app.EpochKeeper.SetEpoch(ctx, lastEpoch)
 
-	appModule.BeginBlock(ctx, abci.RequestBeginBlock{})
-	newEpoch := app.EpochKeeper.GetEpoch(ctx)
+	// The day epoch runs on its own duration
+	dayEpoch := types.Epoch{
+		Identifier:            types.DayEpochIdentifier,
+		GenesisTime:           now.Add(-3 * time.Hour),
+		CurrentEpochStartTime: ctx.BlockTime().Add(-2 * time.Hour), // 2 hours ago
+		EpochDuration:         24 * time.Hour,                      // 1 day epochs
+		CurrentEpoch:          5,
+		CurrentEpochHeight:    0,
+	}
+	app.EpochKeeper.SetEpoch(ctx, dayEpoch)
 
-	ctx.EventManager().Events()
+	appModule.BeginBlock(ctx, abci.RequestBeginBlock{})
+	newEpoch, found := app.EpochKeeper.GetEpoch(ctx, types.HourEpochIdentifier)
+	require.True(t, found)
 
 	require.Equal(t, lastEpoch.CurrentEpoch+1, newEpoch.CurrentEpoch)
+	require.Equal(t, types.HourEpochIdentifier, newEpoch.Identifier)
 	require.True(t, hasEventType(ctx, types.EventTypeNewEpoch))
 
+	// The day epoch should not move
+	newDayEpoch, found := app.EpochKeeper.GetEpoch(ctx, types.DayEpochIdentifier)
+	require.True(t, found)
+	require.Equal(t, dayEpoch.CurrentEpoch, newDayEpoch.CurrentEpoch)
+
 	// Test case: new epoch should not start yet
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ctx = ctx.WithBlockTime(lastEpoch.CurrentEpochStartTime.Add(30 * time.Minute)) // only 30 minutes passed
 	app.EpochKeeper.SetEpoch(ctx, lastEpoch)
 
 	appModule.BeginBlock(ctx, abci.RequestBeginBlock{})
-	newEpoch = app.EpochKeeper.GetEpoch(ctx)
+	newEpoch, found = app.EpochKeeper.GetEpoch(ctx, types.HourEpochIdentifier)
+	require.True(t, found)
 
 	require.Equal(t, lastEpoch.CurrentEpoch, newEpoch.CurrentEpoch)
 	require.False(t, hasEventType(ctx, types.EventTypeNewEpoch))
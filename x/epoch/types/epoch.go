@@ -2,21 +2,31 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 	"time"
 )
 
 const (
-	// Default epoch params
-	DefaultEpochDuration = time.Minute
-	DefaultCurrentEpoch  = 0
-	DefaultEpochHeight   = 0
+	// Default epoch identifiers
+	HourEpochIdentifier = "hour"
+	DayEpochIdentifier  = "day"
+	WeekEpochIdentifier = "week"
 
-	// MaxEpochDuration is the max epoch duration of one hour
-	MaxEpochDuration = time.Hour
+	// Default epoch params, the v4 migration sets the duration of the
+	// existing epoch (previously one minute) to the day duration
+	DefaultEpochIdentifier = DayEpochIdentifier
+	DefaultEpochDuration   = time.Hour * 24
+	DefaultCurrentEpoch    = 0
+	DefaultEpochHeight     = 0
+
+	// MaxEpochDuration is the max epoch duration of one week (previously one hour),
+	// so the "week" epoch is valid
+	MaxEpochDuration = time.Hour * 24 * 7
 )
 
 // NewEpoch creates a new Epoch instance
 func NewEpoch(
+	identifier string,
 	genesisTime time.Time,
 	epochDuration time.Duration,
 	currentEpoch uint64,
@@ -24,6 +34,7 @@ func NewEpoch(
 	currentEpochHeight int64,
 ) *Epoch {
 	return &Epoch{
+		Identifier:            identifier,
 		GenesisTime:           genesisTime,
 		EpochDuration:         epochDuration,
 		CurrentEpoch:          currentEpoch,
@@ -32,13 +43,14 @@ func NewEpoch(
 	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultEpoch returns the default "day" epoch
 func DefaultEpoch() *Epoch {
 	// Get now and build a new epoch
 	now := time.Now().UTC()
 
 	// Return the epoch
 	return NewEpoch(
+		DefaultEpochIdentifier,
 		now,
 		DefaultEpochDuration,
 		DefaultCurrentEpoch,
@@ -47,8 +59,26 @@ func DefaultEpoch() *Epoch {
 	)
 }
 
+// DefaultEpochs returns the default set of named epochs
+func DefaultEpochs() []Epoch {
+	// Get now and build the epochs with the same start time
+	now := time.Now().UTC()
+
+	// Return the epochs
+	return []Epoch{
+		*NewEpoch(HourEpochIdentifier, now, time.Hour, DefaultCurrentEpoch, now, DefaultEpochHeight),
+		*NewEpoch(DayEpochIdentifier, now, time.Hour*24, DefaultCurrentEpoch, now, DefaultEpochHeight),
+		*NewEpoch(WeekEpochIdentifier, now, time.Hour*24*7, DefaultCurrentEpoch, now, DefaultEpochHeight),
+	}
+}
+
 // Validate validates the epoch
 func (e *Epoch) Validate() error {
+	// Check the identifier
+	if strings.TrimSpace(e.GetIdentifier()) == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+
 	// Check if genesis time is zero
 	if e.GetGenesisTime().IsZero() {
 		return fmt.Errorf("epoch genesis time cannot be zero")
@@ -7,9 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -34,6 +33,8 @@ type Epoch struct {
 	CurrentEpoch          uint64        `protobuf:"varint,3,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch" yaml:"current_epoch"`
 	CurrentEpochStartTime time.Time     `protobuf:"bytes,4,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	CurrentEpochHeight    int64         `protobuf:"varint,5,opt,name=current_epoch_height,json=currentEpochHeight,proto3" json:"current_epoch_height" yaml:"current_epoch_height"`
+	// identifier is the unique name of the epoch (e.g. "hour", "day", "week")
+	Identifier string `protobuf:"bytes,6,opt,name=identifier,proto3" json:"identifier,omitempty" yaml:"identifier"`
 }
 
 func (m *Epoch) Reset()         { *m = Epoch{} }
@@ -104,6 +105,13 @@ func (m *Epoch) GetCurrentEpochHeight() int64 {
 	return 0
 }
 
+func (m *Epoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Epoch)(nil), "kiichain.kiichain3.epoch.Epoch")
 }
@@ -111,33 +119,35 @@ func init() {
 func init() { proto.RegisterFile("epoch/epoch.proto", fileDescriptor_36a9d1673530db42) }
 
 var fileDescriptor_36a9d1673530db42 = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0x9b, 0x40,
-	0x1c, 0xc5, 0xb9, 0xda, 0xee, 0x80, 0xed, 0x4a, 0xa5, 0xb6, 0x44, 0x5d, 0x89, 0xb3, 0x98, 0x5c,
-	0xb9, 0x02, 0xa9, 0x1e, 0x2c, 0x75, 0x44, 0xb5, 0xd4, 0xa9, 0x83, 0x93, 0x29, 0x43, 0x10, 0x26,
-	0x17, 0x38, 0xc5, 0x70, 0x08, 0x0e, 0x29, 0x6c, 0xf9, 0x08, 0x1e, 0x33, 0xe6, 0xe3, 0x78, 0xf4,
-	0x98, 0xe9, 0x12, 0xd9, 0x9b, 0x47, 0x3e, 0x41, 0xc4, 0x1d, 0x44, 0x90, 0x58, 0xca, 0x62, 0xdd,
-	0xfd, 0xdf, 0xfb, 0xbf, 0x9f, 0x79, 0x3a, 0xf9, 0x2b, 0x8a, 0x88, 0xeb, 0x9b, 0xfc, 0xd7, 0x88,
-	0x62, 0x42, 0x89, 0xa2, 0xde, 0x60, 0xec, 0xfa, 0x0e, 0x0e, 0x8d, 0xea, 0x30, 0x33, 0xb8, 0x3e,
-	0x1a, 0x78, 0xc4, 0x23, 0xdc, 0x64, 0x16, 0x27, 0xe1, 0x1f, 0x41, 0x8f, 0x10, 0x6f, 0x8d, 0x4c,
-	0x7e, 0x5b, 0xa5, 0xd7, 0x26, 0xc5, 0x01, 0x4a, 0xa8, 0x13, 0x44, 0xa5, 0x41, 0x7b, 0x6b, 0xb8,
-	0x4a, 0x63, 0x87, 0x62, 0x12, 0x0a, 0x5d, 0x7f, 0x68, 0xcb, 0x9d, 0x45, 0x01, 0x50, 0x2e, 0xe5,
-	0x9e, 0x87, 0x42, 0x94, 0xe0, 0xc4, 0x2e, 0x42, 0x54, 0x30, 0x06, 0x93, 0xee, 0xef, 0x91, 0x21,
-	0x02, 0x8c, 0x2a, 0xc0, 0x38, 0xaf, 0x08, 0x16, 0xdc, 0x32, 0x28, 0xe5, 0x0c, 0x7e, 0xcb, 0x9c,
-	0x60, 0xfd, 0x47, 0xaf, 0x6f, 0xeb, 0x9b, 0x27, 0x08, 0x96, 0xdd, 0x72, 0x54, 0xac, 0x28, 0x99,
-	0xfc, 0x85, 0x7f, 0x89, 0x5d, 0xfd, 0x03, 0xf5, 0x13, 0x27, 0x7c, 0x7f, 0x47, 0xf8, 0x5b, 0x1a,
-	0xac, 0x79, 0x01, 0x38, 0x32, 0xa8, 0x54, 0x2b, 0xbf, 0x48, 0x80, 0x29, 0x0a, 0x22, 0x9a, 0xe5,
-	0x0c, 0x0e, 0x05, 0xb6, 0x19, 0xaa, 0xdf, 0x17, 0xe0, 0x3e, 0x1f, 0x56, 0x39, 0xca, 0x7f, 0xb9,
-	0xef, 0xa6, 0x71, 0x8c, 0x42, 0x6a, 0x73, 0x41, 0x6d, 0x8d, 0xc1, 0xa4, 0x6d, 0xfd, 0x3c, 0x32,
-	0xd8, 0x14, 0x72, 0x06, 0x07, 0x22, 0xb5, 0x31, 0xd6, 0x97, 0xbd, 0xf2, 0x2e, 0xaa, 0xba, 0x03,
-	0xb2, 0xda, 0x30, 0xd8, 0x09, 0x75, 0x62, 0x2a, 0x7a, 0x6b, 0x7f, 0xd8, 0xdb, 0xb4, 0xec, 0x0d,
-	0x9e, 0x40, 0xd5, 0x92, 0x44, 0x87, 0xc3, 0x3a, 0xf9, 0xac, 0x10, 0x79, 0x9b, 0x58, 0x1e, 0x34,
-	0xf7, 0x7c, 0x84, 0x3d, 0x9f, 0xaa, 0x9d, 0x31, 0x98, 0xb4, 0xac, 0xf9, 0x91, 0xc1, 0x93, 0x7a,
-	0xce, 0xe0, 0x8f, 0x53, 0x54, 0xa1, 0xea, 0x4b, 0xa5, 0x4e, 0xfb, 0xc7, 0x87, 0xd6, 0x62, 0xbb,
-	0xd7, 0xc0, 0x6e, 0xaf, 0x81, 0xe7, 0xbd, 0x06, 0x36, 0x07, 0x4d, 0xda, 0x1d, 0x34, 0xe9, 0xf1,
-	0xa0, 0x49, 0x17, 0x53, 0x0f, 0x53, 0x3f, 0x5d, 0x19, 0x2e, 0x09, 0xcc, 0xea, 0xbd, 0xbe, 0x1e,
-	0x66, 0xe6, 0xad, 0x78, 0xda, 0x26, 0xcd, 0x22, 0x94, 0xac, 0x3e, 0xf3, 0x26, 0x66, 0x2f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0xd5, 0xea, 0x5e, 0xf6, 0x02, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x8b, 0x9b, 0x40,
+	0x18, 0xc6, 0x9d, 0x6e, 0x76, 0xa1, 0xb3, 0xbb, 0x85, 0xb5, 0x09, 0xd8, 0x14, 0x1c, 0xf1, 0x64,
+	0xff, 0xa0, 0xd0, 0xa5, 0x04, 0x7a, 0x94, 0x14, 0x7a, 0xea, 0xc1, 0xf6, 0xd4, 0x43, 0xc5, 0x98,
+	0x89, 0x0e, 0x8d, 0x8e, 0xe8, 0x08, 0xf5, 0xd6, 0x8f, 0x90, 0x63, 0x3f, 0x52, 0x8e, 0xb9, 0xb5,
+	0xa7, 0x69, 0x49, 0x6e, 0x39, 0xfa, 0x09, 0x8a, 0x33, 0x4a, 0xb4, 0x0d, 0xf4, 0x12, 0x66, 0xde,
+	0xe7, 0x79, 0x9f, 0x5f, 0x7c, 0x50, 0x78, 0x87, 0x33, 0x1a, 0xc6, 0x8e, 0xf8, 0xb5, 0xb3, 0x9c,
+	0x32, 0xaa, 0x6a, 0x5f, 0x08, 0x09, 0xe3, 0x80, 0xa4, 0x76, 0x77, 0xb8, 0xb7, 0x85, 0x3e, 0x1d,
+	0x47, 0x34, 0xa2, 0xc2, 0xe4, 0x34, 0x27, 0xe9, 0x9f, 0xa2, 0x88, 0xd2, 0x68, 0x8d, 0x1d, 0x71,
+	0x5b, 0x94, 0x2b, 0x87, 0x91, 0x04, 0x17, 0x2c, 0x48, 0xb2, 0xd6, 0xa0, 0xff, 0x6d, 0x58, 0x96,
+	0x79, 0xc0, 0x08, 0x4d, 0xa5, 0x6e, 0xfe, 0x18, 0xc1, 0xcb, 0xb7, 0x0d, 0x40, 0xfd, 0x0c, 0x6f,
+	0x22, 0x9c, 0xe2, 0x82, 0x14, 0x7e, 0x13, 0xa2, 0x01, 0x03, 0x58, 0xd7, 0xaf, 0xa6, 0xb6, 0x0c,
+	0xb0, 0xbb, 0x00, 0xfb, 0x63, 0x47, 0x70, 0xd1, 0x96, 0x23, 0xa5, 0xe6, 0xe8, 0x71, 0x15, 0x24,
+	0xeb, 0x37, 0x66, 0x7f, 0xdb, 0xdc, 0xfc, 0x42, 0xc0, 0xbb, 0x6e, 0x47, 0xcd, 0x8a, 0x5a, 0xc1,
+	0x47, 0xe2, 0x49, 0xfc, 0xee, 0x1f, 0x68, 0x0f, 0x04, 0xe1, 0xc9, 0x3f, 0x84, 0x79, 0x6b, 0x70,
+	0x67, 0x0d, 0xe0, 0xc8, 0x91, 0xda, 0xad, 0xbc, 0xa4, 0x09, 0x61, 0x38, 0xc9, 0x58, 0x55, 0x73,
+	0x34, 0x91, 0xd8, 0x61, 0xa8, 0xf9, 0xbd, 0x01, 0xdf, 0x8a, 0x61, 0x97, 0xa3, 0xbe, 0x87, 0xb7,
+	0x61, 0x99, 0xe7, 0x38, 0x65, 0xbe, 0x10, 0xb4, 0x0b, 0x03, 0x58, 0x23, 0xf7, 0xd9, 0x91, 0xa3,
+	0xa1, 0x50, 0x73, 0x34, 0x96, 0xa9, 0x83, 0xb1, 0xe9, 0xdd, 0xb4, 0x77, 0x59, 0xd5, 0x37, 0x00,
+	0xb5, 0x81, 0xc1, 0x2f, 0x58, 0x90, 0x33, 0xd9, 0xdb, 0xe8, 0xbf, 0xbd, 0xbd, 0x68, 0x7b, 0x43,
+	0x67, 0x50, 0xbd, 0x24, 0xd9, 0xe1, 0xa4, 0x4f, 0xfe, 0xd0, 0x88, 0xa2, 0x4d, 0x02, 0xc7, 0xc3,
+	0xbd, 0x18, 0x93, 0x28, 0x66, 0xda, 0xa5, 0x01, 0xac, 0x0b, 0x77, 0x76, 0xe4, 0xe8, 0xac, 0x5e,
+	0x73, 0xf4, 0xf4, 0x1c, 0x55, 0xaa, 0xa6, 0xa7, 0xf6, 0x69, 0xef, 0xc4, 0x50, 0x7d, 0x0d, 0x21,
+	0x59, 0xe2, 0x94, 0x91, 0x15, 0xc1, 0xb9, 0x76, 0x65, 0x00, 0xeb, 0xa1, 0x3b, 0xa9, 0x39, 0xba,
+	0x93, 0x41, 0x27, 0xcd, 0xf4, 0x7a, 0x46, 0x77, 0xbe, 0xdd, 0xeb, 0x60, 0xb7, 0xd7, 0xc1, 0xef,
+	0xbd, 0x0e, 0x36, 0x07, 0x5d, 0xd9, 0x1d, 0x74, 0xe5, 0xe7, 0x41, 0x57, 0x3e, 0x3d, 0x8f, 0x08,
+	0x8b, 0xcb, 0x85, 0x1d, 0xd2, 0xc4, 0xe9, 0x5e, 0xf3, 0xd3, 0xe1, 0xab, 0xfc, 0x20, 0x1c, 0x56,
+	0x65, 0xb8, 0x58, 0x5c, 0x89, 0xfe, 0xee, 0xff, 0x0c, 0x00, 0x52, 0xfd, 0x08, 0x6d, 0x2c, 0x03,
+	0x00, 0x00,
 }
 
 func (m *Epoch) Marshal() (dAtA []byte, err error) {
@@ -160,6 +170,13 @@ func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.CurrentEpochHeight != 0 {
 		i = encodeVarintEpoch(dAtA, i, uint64(m.CurrentEpochHeight))
 		i--
@@ -226,6 +243,10 @@ func (m *Epoch) Size() (n int) {
 	if m.CurrentEpochHeight != 0 {
 		n += 1 + sovEpoch(uint64(m.CurrentEpochHeight))
 	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
 	return n
 }
 
@@ -401,6 +422,38 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEpoch(dAtA[iNdEx:])
@@ -24,9 +24,33 @@ func TestEpochValidation(t *testing.T) {
 			name:  "Good - Default epoch",
 			epoch: types.DefaultEpoch(),
 		},
+		{
+			name: "Good - Week epoch",
+			epoch: types.NewEpoch(
+				types.WeekEpochIdentifier,
+				now,
+				time.Hour*24*7,
+				0,
+				now,
+				0,
+			),
+		},
+		{
+			name: "Bad - Empty identifier",
+			epoch: types.NewEpoch(
+				"",
+				now,
+				types.DefaultEpochDuration,
+				0,
+				now,
+				0,
+			),
+			errContains: "epoch identifier cannot be empty",
+		},
 		{
 			name: "Bad - Zero genesis time",
 			epoch: types.NewEpoch(
+				types.DayEpochIdentifier,
 				time.Time{},
 				types.DefaultEpochDuration,
 				0,
@@ -38,6 +62,7 @@ func TestEpochValidation(t *testing.T) {
 		{
 			name: "Bad - Zero duration",
 			epoch: types.NewEpoch(
+				types.DayEpochIdentifier,
 				now,
 				0,
 				0,
@@ -49,17 +74,19 @@ func TestEpochValidation(t *testing.T) {
 		{
 			name: "Bad - Giant epoch duration",
 			epoch: types.NewEpoch(
+				types.DayEpochIdentifier,
 				now,
-				time.Hour*24, // One day
+				time.Hour*24*8, // Eight days
 				0,
 				now,
 				0,
 			),
-			errContains: "epoch duration cannot exceed 3600.000000 seconds",
+			errContains: "epoch duration cannot exceed 604800.000000 seconds",
 		},
 		{
 			name: "Bad - Genesis time after current epoch start time",
 			epoch: types.NewEpoch(
+				types.DayEpochIdentifier,
 				now.Add(time.Second), // One second after current epoch start time
 				types.DefaultEpochDuration,
 				0,
@@ -71,6 +98,7 @@ func TestEpochValidation(t *testing.T) {
 		{
 			name: "Bad - Current epoch heigh negative",
 			epoch: types.NewEpoch(
+				types.DayEpochIdentifier,
 				now,
 				types.DefaultEpochDuration,
 				0,
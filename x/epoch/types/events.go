@@ -3,7 +3,8 @@ package types
 const (
 	EventTypeNewEpoch = "new_epoch"
 
-	AttributeEpochNumber = "epoch_number"
-	AttributeEpochTime   = "epoch_time"
-	AttributeEpochHeight = "epoch_height"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochTime       = "epoch_time"
+	AttributeEpochHeight     = "epoch_height"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -7,7 +9,7 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
-		Epoch:  DefaultEpoch(),
+		Epochs: DefaultEpochs(),
 	}
 }
 
@@ -19,6 +21,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	err = gs.Epoch.Validate()
-	return err
+	// Validate each epoch and check for duplicated identifiers
+	seenIdentifiers := make(map[string]bool)
+	for _, epoch := range gs.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if seenIdentifiers[epoch.Identifier] {
+			return fmt.Errorf("duplicated epoch identifier: %s", epoch.Identifier)
+		}
+		seenIdentifiers[epoch.Identifier] = true
+	}
+
+	return nil
 }
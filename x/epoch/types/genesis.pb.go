@@ -25,8 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epoch module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Epoch  *Epoch `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Params Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Epochs []Epoch `protobuf:"bytes,3,rep,name=epochs,proto3" json:"epochs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,9 +69,9 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetEpoch() *Epoch {
+func (m *GenesisState) GetEpochs() []Epoch {
 	if m != nil {
-		return m.Epoch
+		return m.Epochs
 	}
 	return nil
 }
@@ -83,21 +83,21 @@ func init() {
 func init() { proto.RegisterFile("epoch/genesis.proto", fileDescriptor_ff244678b065710d) }
 
 var fileDescriptor_ff244678b065710d = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
+	// 223 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x2d, 0xc8, 0x4f,
 	0xce, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x92, 0xc8, 0xce, 0xcc, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x83, 0x31, 0x8c, 0xf5, 0xc0, 0xea,
 	0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x8a, 0xf4, 0x41, 0x2c, 0x88, 0x7a, 0x29, 0x21, 0x88,
 	0x21, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x33, 0xa4, 0x04, 0x21, 0x62, 0x60, 0x12, 0x22, 0xa4,
-	0xd4, 0xca, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x8e, 0x8b,
-	0x0d, 0xa2, 0x47, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0x97, 0xc5, 0x7a, 0x01,
-	0x60, 0x75, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x09, 0x99, 0x72, 0xb1, 0x82,
-	0x65, 0x25, 0x98, 0xc0, 0xda, 0xe5, 0x71, 0x6b, 0x77, 0x05, 0x91, 0x41, 0x10, 0xd5, 0x4e, 0xae,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9d, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x33, 0x02, 0xce, 0x30, 0xd6, 0xaf, 0x80, 0x78, 0x47,
-	0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x2b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x6e, 0xd6, 0xdc, 0x6c, 0x43, 0x01, 0x00, 0x00,
+	0x34, 0x99, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x1d, 0x17,
+	0x1b, 0x44, 0x8f, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x82, 0x1e, 0x2e, 0x8b, 0xf5, 0x02,
+	0xc0, 0xea, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x12, 0xb2, 0xe5, 0x62, 0x03,
+	0xcb, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe3, 0xd6, 0xef, 0x0a, 0x22, 0x61,
+	0xda, 0x21, 0x9a, 0xbc, 0x58, 0x38, 0x98, 0x04, 0x98, 0x9d, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc,
+	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
+	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
+	0x57, 0x1f, 0x66, 0x1e, 0x82, 0x51, 0x01, 0xf1, 0x9b, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
+	0x1b, 0xd8, 0x8b, 0xc6, 0x80, 0x01, 0x00, 0xe9, 0x1a, 0xc6, 0xec, 0x50, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,17 +120,19 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != nil {
-		{
-			size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -164,9 +166,11 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	if m.Epoch != nil {
-		l = m.Epoch.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
@@ -239,9 +243,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -268,10 +272,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Epoch == nil {
-				m.Epoch = &Epoch{}
-			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18,6 +18,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "duplicated epoch identifier",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.Epoch{*types.DefaultEpoch(), *types.DefaultEpoch()},
+			},
+			valid: false,
+		},
 		{
 			desc:     "invalid genesis state",
 			genState: &types.GenesisState{},
@@ -20,3 +20,16 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+const (
+	// LegacyEpochKey is the key of the single epoch stored before named epochs
+	LegacyEpochKey = "epoch"
+
+	// EpochKeyPrefix is the prefix of the named epochs, keyed by identifier
+	EpochKeyPrefix = "epoch/"
+)
+
+// EpochKey returns the store key of the epoch with the given identifier
+func EpochKey(identifier string) []byte {
+	return append(KeyPrefix(EpochKeyPrefix), []byte(identifier)...)
+}
@@ -114,6 +114,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 type QueryEpochRequest struct {
+	// identifier of the epoch, defaults to the "day" epoch when empty
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
 func (m *QueryEpochRequest) Reset()         { *m = QueryEpochRequest{} }
@@ -149,6 +151,13 @@ func (m *QueryEpochRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEpochRequest proto.InternalMessageInfo
 
+func (m *QueryEpochRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
 type QueryEpochResponse struct {
 	Epoch Epoch `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch"`
 }
@@ -193,40 +202,126 @@ func (m *QueryEpochResponse) GetEpoch() Epoch {
 	return Epoch{}
 }
 
+type QueryEpochsRequest struct {
+}
+
+func (m *QueryEpochsRequest) Reset()         { *m = QueryEpochsRequest{} }
+func (m *QueryEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsRequest) ProtoMessage()    {}
+func (*QueryEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05537adf7c5c875f, []int{4}
+}
+func (m *QueryEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsRequest.Merge(m, src)
+}
+func (m *QueryEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochsRequest proto.InternalMessageInfo
+
+type QueryEpochsResponse struct {
+	Epochs []Epoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryEpochsResponse) Reset()         { *m = QueryEpochsResponse{} }
+func (m *QueryEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsResponse) ProtoMessage()    {}
+func (*QueryEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05537adf7c5c875f, []int{5}
+}
+func (m *QueryEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsResponse.Merge(m, src)
+}
+func (m *QueryEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochsResponse) GetEpochs() []Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.epoch.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.kiichain3.epoch.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochRequest)(nil), "kiichain.kiichain3.epoch.QueryEpochRequest")
 	proto.RegisterType((*QueryEpochResponse)(nil), "kiichain.kiichain3.epoch.QueryEpochResponse")
+	proto.RegisterType((*QueryEpochsRequest)(nil), "kiichain.kiichain3.epoch.QueryEpochsRequest")
+	proto.RegisterType((*QueryEpochsResponse)(nil), "kiichain.kiichain3.epoch.QueryEpochsResponse")
 }
 
 func init() { proto.RegisterFile("epoch/query.proto", fileDescriptor_05537adf7c5c875f) }
 
 var fileDescriptor_05537adf7c5c875f = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3d, 0x4f, 0x02, 0x31,
-	0x18, 0xc7, 0xaf, 0x44, 0x18, 0xea, 0x44, 0x41, 0x25, 0x17, 0x2d, 0xe4, 0x26, 0x23, 0x7a, 0x0d,
-	0x30, 0x9a, 0x38, 0x90, 0xb0, 0x0b, 0x89, 0x8b, 0x5b, 0xef, 0xd2, 0x1c, 0x8d, 0x72, 0x2d, 0xb4,
-	0x18, 0x19, 0x35, 0x71, 0x37, 0xe1, 0x4b, 0x31, 0x92, 0xb8, 0x38, 0x19, 0x03, 0x7e, 0x10, 0x43,
-	0xdb, 0x53, 0xd1, 0x10, 0x59, 0x2e, 0xcd, 0x73, 0xbf, 0xff, 0xcb, 0x3d, 0x57, 0x58, 0x64, 0x52,
-	0xc4, 0x7d, 0x32, 0x1c, 0xb3, 0xd1, 0x24, 0x94, 0x23, 0xa1, 0x05, 0xaa, 0xdc, 0x70, 0x1e, 0xf7,
-	0x29, 0x4f, 0xc3, 0xec, 0xd0, 0x0a, 0x0d, 0xe5, 0x97, 0x13, 0x91, 0x08, 0x03, 0x91, 0xd5, 0xc9,
-	0xf2, 0xfe, 0x61, 0x22, 0x44, 0x72, 0xcb, 0x08, 0x95, 0x9c, 0xd0, 0x34, 0x15, 0x9a, 0x6a, 0x2e,
-	0x52, 0xe5, 0xde, 0x9e, 0xc4, 0x42, 0x0d, 0x84, 0x22, 0x11, 0x55, 0xcc, 0xc6, 0x90, 0xbb, 0x46,
-	0xc4, 0x34, 0x6d, 0x10, 0x49, 0x13, 0x9e, 0x1a, 0xd8, 0xb1, 0xc8, 0x96, 0x91, 0x74, 0x44, 0x07,
-	0x99, 0xde, 0x15, 0x34, 0x4f, 0x3b, 0x0a, 0xca, 0x10, 0x75, 0x57, 0x46, 0x97, 0x86, 0xeb, 0xb1,
-	0xe1, 0x98, 0x29, 0x1d, 0x5c, 0xc1, 0xd2, 0xda, 0x54, 0x49, 0x91, 0x2a, 0x86, 0x2e, 0x60, 0xc1,
-	0xfa, 0x55, 0x40, 0x0d, 0x1c, 0xef, 0x36, 0x6b, 0xe1, 0xa6, 0xcf, 0x0b, 0xad, 0xb2, 0xbd, 0x33,
-	0x7b, 0xab, 0x7a, 0x3d, 0xa7, 0x0a, 0x4a, 0xb0, 0x68, 0x6c, 0x3b, 0x2b, 0x24, 0xcb, 0xea, 0xba,
-	0x06, 0x6e, 0xe8, 0xa2, 0xce, 0x61, 0xde, 0x18, 0xb9, 0xa4, 0xea, 0xe6, 0x24, 0xa3, 0x73, 0x41,
-	0x56, 0xd3, 0x9c, 0xe6, 0x60, 0xde, 0x78, 0xa2, 0x07, 0x00, 0xf3, 0x06, 0x40, 0xf5, 0xcd, 0x0e,
-	0x7f, 0x3a, 0xf9, 0xa7, 0xdb, 0xc1, 0xb6, 0x6b, 0x70, 0xf4, 0xf8, 0xf2, 0x31, 0xcd, 0x1d, 0xa0,
-	0x3d, 0x92, 0xc1, 0xe4, 0xc7, 0xa2, 0xd1, 0x13, 0x80, 0x05, 0xbb, 0x0e, 0xf4, 0x9f, 0xef, 0xda,
-	0x5f, 0xf0, 0xcf, 0xb6, 0xa4, 0x5d, 0x0d, 0x6c, 0x6a, 0x54, 0xd0, 0xfe, 0xef, 0x1a, 0x76, 0xfb,
-	0xed, 0xce, 0x6c, 0x81, 0xc1, 0x7c, 0x81, 0xc1, 0xfb, 0x02, 0x83, 0xe7, 0x25, 0xf6, 0xe6, 0x4b,
-	0xec, 0xbd, 0x2e, 0xb1, 0x77, 0x5d, 0x4f, 0xb8, 0xee, 0x8f, 0xa3, 0x30, 0x16, 0x83, 0x6f, 0xed,
-	0x57, 0x24, 0xb9, 0x77, 0x3e, 0x7a, 0x22, 0x99, 0x8a, 0x0a, 0xe6, 0xe2, 0xb4, 0x3e, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x91, 0x68, 0xa1, 0x4c, 0xee, 0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8f, 0xd2, 0x40,
+	0x14, 0xc6, 0x5b, 0x91, 0x26, 0x8e, 0x27, 0x06, 0x54, 0xd2, 0xe8, 0x40, 0x7a, 0x32, 0x28, 0x9d,
+	0x00, 0x47, 0xa3, 0x07, 0xa2, 0x77, 0x21, 0x7a, 0xf1, 0x36, 0xad, 0x63, 0x99, 0x28, 0x9d, 0xd2,
+	0x19, 0x8c, 0x1c, 0x35, 0xf1, 0x6e, 0xe2, 0xd1, 0x7f, 0x88, 0x23, 0x89, 0x17, 0x4f, 0x66, 0x03,
+	0xfb, 0x87, 0x6c, 0xfa, 0x66, 0xca, 0x42, 0x76, 0x09, 0xbd, 0x90, 0xc9, 0x9b, 0xef, 0xfb, 0xde,
+	0xef, 0x0d, 0xaf, 0xa8, 0xc1, 0x33, 0x19, 0xcf, 0xe8, 0x62, 0xc9, 0xf3, 0x55, 0x98, 0xe5, 0x52,
+	0x4b, 0xdc, 0xfe, 0x2c, 0x44, 0x3c, 0x63, 0x22, 0x0d, 0xcb, 0xc3, 0x28, 0x04, 0x95, 0xdf, 0x4a,
+	0x64, 0x22, 0x41, 0x44, 0x8b, 0x93, 0xd1, 0xfb, 0x8f, 0x13, 0x29, 0x93, 0x2f, 0x9c, 0xb2, 0x4c,
+	0x50, 0x96, 0xa6, 0x52, 0x33, 0x2d, 0x64, 0xaa, 0xec, 0x6d, 0x2f, 0x96, 0x6a, 0x2e, 0x15, 0x8d,
+	0x98, 0xe2, 0xa6, 0x0d, 0xfd, 0x3a, 0x88, 0xb8, 0x66, 0x03, 0x9a, 0xb1, 0x44, 0xa4, 0x20, 0xb6,
+	0x5a, 0x6c, 0x60, 0x32, 0x96, 0xb3, 0x79, 0xe9, 0xb7, 0x80, 0xf0, 0x6b, 0x4a, 0x41, 0x0b, 0xe1,
+	0x49, 0x11, 0xf4, 0x16, 0x74, 0x53, 0xbe, 0x58, 0x72, 0xa5, 0x83, 0xf7, 0xa8, 0x79, 0x54, 0x55,
+	0x99, 0x4c, 0x15, 0xc7, 0xaf, 0x90, 0x67, 0xf2, 0xda, 0x6e, 0xd7, 0x7d, 0x7a, 0x7f, 0xd8, 0x0d,
+	0x4f, 0x8d, 0x17, 0x1a, 0xe7, 0xf8, 0xee, 0xfa, 0x7f, 0xc7, 0x99, 0x5a, 0x57, 0x30, 0x42, 0x0d,
+	0x88, 0x7d, 0x53, 0x48, 0x6c, 0x2f, 0x4c, 0x10, 0x12, 0x1f, 0x79, 0xaa, 0xc5, 0x27, 0xc1, 0x73,
+	0x08, 0xbe, 0x37, 0x3d, 0xa8, 0x04, 0x13, 0x84, 0x0f, 0x4d, 0x16, 0xe5, 0x05, 0xaa, 0x43, 0x23,
+	0x4b, 0xd2, 0x39, 0x4d, 0x02, 0x3e, 0x0b, 0x62, 0x3c, 0xfb, 0xa1, 0xe1, 0x6a, 0x3f, 0xf4, 0x3b,
+	0xd4, 0x3c, 0xaa, 0xda, 0x4e, 0x2f, 0x91, 0x07, 0xae, 0x62, 0xe8, 0x5a, 0xf5, 0x56, 0xd6, 0x34,
+	0xfc, 0x53, 0x43, 0x75, 0x88, 0xc5, 0xdf, 0x5d, 0x54, 0x07, 0x05, 0x7e, 0x76, 0x3a, 0xe2, 0xc6,
+	0xfb, 0xf8, 0xcf, 0xab, 0x89, 0x0d, 0x6d, 0xf0, 0xe4, 0xc7, 0xdf, 0xcb, 0xdf, 0x77, 0x1e, 0xe1,
+	0x07, 0xb4, 0x14, 0xd3, 0x83, 0x3f, 0x1d, 0xff, 0x74, 0x91, 0x67, 0xe6, 0xc3, 0x95, 0x72, 0xcb,
+	0xc7, 0xf1, 0xfb, 0x15, 0xd5, 0x16, 0x83, 0x00, 0x46, 0x1b, 0x3f, 0xbc, 0x15, 0x43, 0x01, 0x87,
+	0x59, 0x91, 0xb3, 0x1c, 0x47, 0x9b, 0xe9, 0xf7, 0x2b, 0xaa, 0xcf, 0x71, 0x98, 0x8d, 0x1c, 0xbf,
+	0x5e, 0x6f, 0x89, 0xbb, 0xd9, 0x12, 0xf7, 0x62, 0x4b, 0xdc, 0x5f, 0x3b, 0xe2, 0x6c, 0x76, 0xc4,
+	0xf9, 0xb7, 0x23, 0xce, 0x87, 0x5e, 0x22, 0xf4, 0x6c, 0x19, 0x85, 0xb1, 0x9c, 0x5f, 0x7b, 0xf7,
+	0x87, 0x6f, 0x36, 0x46, 0xaf, 0x32, 0xae, 0x22, 0x0f, 0xbe, 0xa5, 0xd1, 0xd5, 0x00, 0x7a, 0x05,
+	0xf8, 0x78, 0x01, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -241,8 +336,10 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// Query the epoch in the chain
+	// Query the epoch in the chain by its identifier
 	Epoch(ctx context.Context, in *QueryEpochRequest, opts ...grpc.CallOption) (*QueryEpochResponse, error)
+	// Query all the epochs in the chain
+	Epochs(ctx context.Context, in *QueryEpochsRequest, opts ...grpc.CallOption) (*QueryEpochsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -264,6 +361,15 @@ func (c *queryClient) Epoch(ctx context.Context, in *QueryEpochRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) Epochs(ctx context.Context, in *QueryEpochsRequest, opts ...grpc.CallOption) (*QueryEpochsResponse, error) {
+	out := new(QueryEpochsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.epoch.Query/Epochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.epoch.Query/Params", in, out, opts...)
@@ -275,8 +381,10 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// Query the epoch in the chain
+	// Query the epoch in the chain by its identifier
 	Epoch(context.Context, *QueryEpochRequest) (*QueryEpochResponse, error)
+	// Query all the epochs in the chain
+	Epochs(context.Context, *QueryEpochsRequest) (*QueryEpochsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -288,6 +396,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Epoch(ctx context.Context, req *QueryEpochRequest) (*QueryEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Epoch not implemented")
 }
+func (*UnimplementedQueryServer) Epochs(ctx context.Context, req *QueryEpochsRequest) (*QueryEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Epochs not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -314,6 +425,24 @@ func _Query_Epoch_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Epochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Epochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.epoch.Query/Epochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Epochs(ctx, req.(*QueryEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +469,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Epoch",
 			Handler:    _Query_Epoch_Handler,
 		},
+		{
+			MethodName: "Epochs",
+			Handler:    _Query_Epochs_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -425,6 +558,13 @@ func (m *QueryEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -461,6 +601,66 @@ func (m *QueryEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -498,6 +698,10 @@ func (m *QueryEpochRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -512,6 +716,30 @@ func (m *QueryEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -680,6 +908,38 @@ func (m *QueryEpochRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -784,6 +1044,140 @@ func (m *QueryEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 
 	"github.com/kiichain/kiichain/x/evm/keeper"
 	evmtypes "github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 )
 
 type BasicDecorator struct {
@@ -24,7 +25,7 @@ func NewBasicDecorator(k *keeper.Keeper) *BasicDecorator {
 // cherrypicked from go-ethereum:txpool:ValidateTransaction
 func (gl BasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	msg := evmtypes.MustGetEVMTransactionMessage(tx)
-	etx, _ := msg.AsTransaction()
+	etx, txData := msg.AsTransaction()
 
 	if msg.Derived != nil && !gl.k.EthReplayConfig.Enabled && !gl.k.EthBlockTestConfig.Enabled {
 		startingNonce := gl.k.GetNonce(ctx, msg.Derived.SenderEVMAddr)
@@ -51,14 +52,14 @@ func (gl BasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, n
 	if err != nil {
 		return ctx, err
 	}
+	// set-code transactions pay for their authorizations on top of go-ethereum's intrinsic gas
+	if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+		intrGas += uint64(len(sctx.Authorizations)) * ethtx.PerEmptyAccountCost
+	}
 	if etx.Gas() < intrGas {
 		return ctx, sdkerrors.ErrOutOfGas
 	}
 
-	if etx.Type() == ethtypes.BlobTxType {
-		return ctx, sdkerrors.ErrUnsupportedTxType
-	}
-
 	// Check if gas exceed the limit
 	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
 		// If there exists a maximum block gas limit, we must ensure that the tx
@@ -68,64 +69,23 @@ func (gl BasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, n
 		}
 	}
 
-	//TODO: support blobs (leaving this commented out)
-	// Ensure blob transactions have valid commitments
-	//if etx.Type() == ethtypes.BlobTxType {
-	//	sidecar := etx.BlobTxSidecar()
-	//	if sidecar == nil {
-	//		return ctx, fmt.Errorf("missing sidecar in blob transaction")
-	//	}
-	//	// Ensure the number of items in the blob transaction and various side
-	//	// data match up before doing any expensive validations
-	//	hashes := etx.BlobHashes()
-	//	if len(hashes) == 0 {
-	//		return ctx, fmt.Errorf("blobless blob transaction")
-	//	}
-	//	if len(hashes) > params.MaxBlobGasPerBlock/params.BlobTxBlobGasPerBlob {
-	//		return ctx, fmt.Errorf("too many blobs in transaction: have %d, permitted %d", len(hashes), params.MaxBlobGasPerBlock/params.BlobTxBlobGasPerBlob)
-	//	}
-	//	if err := validateBlobSidecar(hashes, sidecar); err != nil {
-	//		return ctx, err
-	//	}
-	//}
+	// Blob transactions are accepted once the upgrade enabling them has run. Their sidecars are
+	// verified and kept by the EVM RPC node they are sent to, so blocks only carry the blob hashes.
+	if etx.Type() == ethtypes.BlobTxType {
+		if !gl.k.IsBlobsActive(ctx) {
+			return ctx, sdkerrors.ErrUnsupportedTxType
+		}
+		if etx.BlobTxSidecar() != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "blob transactions must be sent through eth_sendRawTransaction, which strips their sidecar")
+		}
+		hashes := etx.BlobHashes()
+		if len(hashes) == 0 {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "blobless blob transaction")
+		}
+		if len(hashes) > params.MaxBlobGasPerBlock/params.BlobTxBlobGasPerBlob {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many blobs in transaction: have %d, permitted %d", len(hashes), params.MaxBlobGasPerBlock/params.BlobTxBlobGasPerBlob)
+		}
+	}
 
 	return next(ctx, tx, simulate)
 }
-
-// NOTE: if we re-enable blob support we can put this back.
-// this allows code coverage to calculate correctly
-//func validateBlobSidecar(hashes []common.Hash, sidecar *ethtypes.BlobTxSidecar) error {
-//	if len(sidecar.Blobs) != len(hashes) {
-//		return fmt.Errorf("invalid number of %d blobs compared to %d blob hashes", len(sidecar.Blobs), len(hashes))
-//	}
-//	if len(sidecar.Commitments) != len(hashes) {
-//		return fmt.Errorf("invalid number of %d blob commitments compared to %d blob hashes", len(sidecar.Commitments), len(hashes))
-//	}
-//	if len(sidecar.Proofs) != len(hashes) {
-//		return fmt.Errorf("invalid number of %d blob proofs compared to %d blob hashes", len(sidecar.Proofs), len(hashes))
-//	}
-//	// Blob quantities match up, validate that the provers match with the
-//	// transaction hash before getting to the cryptography
-//	hasher := sha256.New()
-//	for i, want := range hashes {
-//		hasher.Write(sidecar.Commitments[i][:])
-//		hash := hasher.Sum(nil)
-//		hasher.Reset()
-//
-//		var vhash common.Hash
-//		vhash[0] = params.BlobTxHashVersion
-//		copy(vhash[1:], hash[1:])
-//
-//		if vhash != want {
-//			return fmt.Errorf("blob %d: computed hash %#x mismatches transaction one %#x", i, vhash, want)
-//		}
-//	}
-//	// Blob commitments match with the hashes in the transaction, verify the
-//	// blobs themselves via KZG
-//	for i := range sidecar.Blobs {
-//		if err := kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
-//			return fmt.Errorf("invalid blob %d: %v", i, err)
-//		}
-//	}
-//	return nil
-//}
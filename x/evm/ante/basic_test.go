@@ -1,11 +1,13 @@
 package ante_test
 
 import (
+	"crypto/sha256"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/params"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/kiichain/kiichain/x/evm/ante"
@@ -54,5 +56,35 @@ func TestBasicDecorator(t *testing.T) {
 		return ctx, nil
 	})
 	require.NotNil(t, err)
-	require.Error(t, err, sdkerrors.ErrUnsupportedTxType)
+	require.Contains(t, err.Error(), "blobless blob transaction")
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.Nil(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.Nil(t, err)
+	vhash := sha256.Sum256(commitment[:])
+	vhash[0] = params.BlobTxHashVersion
+	// sidecars aren't part of the transactions in blocks
+	sidecar := &ethtx.BlobTxSidecar{Blobs: [][]byte{blob[:]}, Commitments: [][]byte{commitment[:]}, Proofs: [][]byte{proof[:]}}
+	msg, _ = types.NewMsgEVMTransaction(&ethtx.BlobTx{GasLimit: 21000, BlobHashes: [][]byte{vhash[:]}, Sidecar: sidecar})
+	ctx, err = a.AnteHandle(ctx, &mockTx{msgs: []sdk.Msg{msg}}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "strips their sidecar")
+
+	msg, _ = types.NewMsgEVMTransaction(&ethtx.BlobTx{GasLimit: 21000, BlobHashes: [][]byte{vhash[:]}})
+	ctx, err = a.AnteHandle(ctx, &mockTx{msgs: []sdk.Msg{msg}}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.Nil(t, err)
+
+	// blob transactions are rejected before the upgrade
+	k.SetBlobsTime(ctx, ctx.BlockTime().Unix()+1)
+	_, err = a.AnteHandle(ctx, &mockTx{msgs: []sdk.Msg{msg}}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.Equal(t, sdkerrors.ErrUnsupportedTxType, err)
+	k.SetBlobsTime(ctx, 0)
 }
@@ -5,7 +5,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/kiichain/kiichain/app/antedecorators"
@@ -54,9 +53,7 @@ func (fc EVMFeeCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// if EVM version is Cancun or later, and the transaction contains at least one blob, we need to
 	// make sure the transaction carries a non-zero blob fee cap.
 	if ver >= derived.Cancun && len(txData.GetBlobHashes()) > 0 {
-		// For now we are simply assuming excessive blob gas is 0. In the future we might change it to be
-		// dynamic based on prior block usage.
-		if txData.GetBlobFeeCap().Cmp(eip4844.CalcBlobFee(0)) < 0 {
+		if txData.GetBlobFeeCap().Cmp(fc.evmKeeper.GetBlobBaseFee(ctx)) < 0 {
 			return ctx, sdkerrors.ErrInsufficientFee
 		}
 	}
@@ -90,7 +87,7 @@ func (fc EVMFeeCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		if err != nil {
 			return ctx, err
 		}
-		if err := fc.evmKeeper.AddAnteSurplus(ctx, etx.Hash(), surplus); err != nil {
+		if err := fc.evmKeeper.AddAnteSurplus(ctx, msg.Hash(), surplus); err != nil {
 			return ctx, err
 		}
 	}
@@ -38,10 +38,13 @@ var BigBalanceThresholdMinus1 *big.Int = new(big.Int).SetUint64(BalanceThreshold
 var SignerMap = map[derived.SignerVersion]func(*big.Int) ethtypes.Signer{
 	derived.London: ethtypes.NewLondonSigner,
 	derived.Cancun: ethtypes.NewCancunSigner,
+	// set-code transactions aren't signed through go-ethereum signers, see preprocessSetCodeTx
+	derived.Prague: ethtypes.NewCancunSigner,
 }
 var AllowedTxTypes = map[derived.SignerVersion][]uint8{
 	derived.London: {ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType},
 	derived.Cancun: {ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType, ethtypes.BlobTxType},
+	derived.Prague: {ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtx.SetCodeTxType},
 }
 
 type EVMPreprocessDecorator struct {
@@ -59,6 +62,9 @@ func (p *EVMPreprocessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	if err := Preprocess(ctx, msg); err != nil {
 		return ctx, err
 	}
+	if msg.Derived.Version == derived.Prague && !p.evmKeeper.IsPragueActive(ctx) {
+		return ctx, sdkerrors.Wrap(ethtypes.ErrTxTypeNotSupported, "set code transactions aren't enabled yet")
+	}
 
 	// use infinite gas meter for EVM transaction because EVM handles gas checking from within
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
@@ -149,6 +155,10 @@ func Preprocess(ctx sdk.Context, msgEVMTransaction *evmtypes.MsgEVMTransaction)
 		return nil
 	}
 
+	if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+		return preprocessSetCodeTx(msgEVMTransaction, sctx)
+	}
+
 	ethTx := ethtypes.NewTx(txData.AsEthereumData())
 	chainID := ethTx.ChainId()
 	chainCfg := evmtypes.DefaultChainConfig()
@@ -181,6 +191,33 @@ func Preprocess(ctx sdk.Context, msgEVMTransaction *evmtypes.MsgEVMTransaction)
 	return nil
 }
 
+// preprocessSetCodeTx recovers the sender of a set-code transaction and the authorities of its
+// authorizations. Whether set-code transactions are accepted yet is stateful, so it is checked
+// in AnteHandle.
+func preprocessSetCodeTx(msgEVMTransaction *evmtypes.MsgEVMTransaction, sctx *ethtx.SetCodeTx) error {
+	V, R, S := sctx.GetRawSignatureValues()
+	V = AdjustV(V, ethtx.SetCodeTxType, sctx.GetChainID())
+	evmAddr, kiiAddr, kiiPubkey, err := helpers.GetAddresses(V, R, S, sctx.SigHash())
+	if err != nil {
+		return err
+	}
+	authorities := make([]common.Address, len(sctx.Authorizations))
+	for i, auth := range sctx.Authorizations {
+		if authority, err := auth.Authority(); err == nil {
+			authorities[i] = authority
+		}
+	}
+	msgEVMTransaction.Derived = &derived.Derived{
+		SenderEVMAddr: evmAddr,
+		SenderKiiAddr: kiiAddr,
+		PubKey:        &secp256k1.PubKey{Key: kiiPubkey.Bytes()},
+		Version:       derived.Prague,
+		IsAssociate:   false,
+		Authorities:   authorities,
+	}
+	return nil
+}
+
 func (p *EVMPreprocessDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sdk.Tx, txIndex int, next sdk.AnteDepGenerator) (newTxDeps []sdkacltypes.AccessOperation, err error) {
 	msg := evmtypes.MustGetEVMTransactionMessage(tx)
 	return next(append(txDeps, sdkacltypes.AccessOperation{
@@ -178,6 +178,53 @@ func TestPreprocessAssociateTxWithWeiBalance(t *testing.T) {
 	require.Equal(t, evmAddr, associated)
 }
 
+func TestPreprocessSetCodeTx(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).WithBlockTime(time.Unix(1700000000, 0)).CacheContext()
+	handler := ante.NewEVMPreprocessDecorator(k, k.AccountKeeper())
+	privKey := testkeeper.MockPrivateKey()
+	kiiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	authKey, _ := crypto.GenerateKey()
+	chainID := k.ChainID(ctx)
+	authChainID := sdk.NewIntFromBigInt(chainID)
+	auth := ethtx.SetCodeAuthorization{ChainID: &authChainID, Address: common.Address{'a'}.Hex()}
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), authKey)
+	require.Nil(t, err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+	txData := &ethtx.SetCodeTx{
+		GasLimit:       100000,
+		To:             common.Address{'b'}.Hex(),
+		Authorizations: []ethtx.SetCodeAuthorization{auth},
+	}
+	txData.SetAmount(sdk.ZeroInt())
+	txData.SetGasFeeCap(sdk.NewInt(10))
+	txData.SetGasTipCap(sdk.NewInt(10))
+	txData.SetSignatureValues(chainID, nil, nil, nil)
+	sig, err = crypto.Sign(txData.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	txData.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	msg, err := types.NewMsgEVMTransaction(txData)
+	require.Nil(t, err)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	// set-code transactions are rejected until the upgrade enabling them ran
+	k.SetPragueTime(ctx, -1)
+	_, err = handler.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}}, false, next)
+	require.ErrorIs(t, err, ethtypes.ErrTxTypeNotSupported)
+
+	k.SetPragueTime(ctx, ctx.BlockTime().Unix())
+	_, err = handler.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}}, false, next)
+	require.Nil(t, err)
+	require.Equal(t, derived.Prague, msg.Derived.Version)
+	require.Equal(t, evmAddr, msg.Derived.SenderEVMAddr)
+	require.Equal(t, kiiAddr, msg.Derived.SenderKiiAddr)
+	require.Equal(t, []common.Address{crypto.PubkeyToAddress(authKey.PublicKey)}, msg.Derived.Authorities)
+	require.Equal(t, txData.Hash(), msg.Hash())
+}
+
 func TestGetVersion(t *testing.T) {
 	ethCfg := &params.ChainConfig{}
 	ctx := sdk.Context{}.WithBlockHeight(10).WithBlockTime(time.Now())
@@ -26,9 +26,10 @@ func NewEVMSigVerifyDecorator(evmKeeper *evmkeeper.Keeper, latestCtxGetter func(
 }
 
 func (svd *EVMSigVerifyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	ethTx, _ := types.MustGetEVMTransactionMessage(tx).AsTransaction()
+	msg := types.MustGetEVMTransactionMessage(tx)
+	ethTx, _ := msg.AsTransaction()
 
-	evmAddr := types.MustGetEVMTransactionMessage(tx).Derived.SenderEVMAddr
+	evmAddr := msg.Derived.SenderEVMAddr
 
 	nextNonce := svd.evmKeeper.GetNonce(ctx, evmAddr)
 	txNonce := ethTx.Nonce()
@@ -37,7 +38,7 @@ func (svd *EVMSigVerifyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	ctx = ctx.WithIsEVM(true)
 	ctx = ctx.WithEVMNonce(txNonce)
 	ctx = ctx.WithEVMSenderAddress(evmAddr.Hex())
-	ctx = ctx.WithEVMTxHash(ethTx.Hash().Hex())
+	ctx = ctx.WithEVMTxHash(msg.Hash().Hex())
 
 	chainID := svd.evmKeeper.ChainID(ctx)
 	txChainID := ethTx.ChainId()
@@ -0,0 +1,58 @@
+// Package entrypoint holds the interfaces of the ERC-4337 v0.7 EntryPoint contract and of the
+// accounts and paymasters it calls. The EntryPoint isn't deployed by the chain, so there is no
+// contract binary here.
+package entrypoint
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// V07Address is the canonical address the v0.7 EntryPoint is deployed at on every chain
+var V07Address = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+
+//go:embed EntryPoint.abi
+//go:embed IAccount.abi
+//go:embed IPaymaster.abi
+var f embed.FS
+
+var (
+	parsedABI          = parseABI("EntryPoint.abi")
+	parsedAccountABI   = parseABI("IAccount.abi")
+	parsedPaymasterABI = parseABI("IPaymaster.abi")
+)
+
+func GetABI() []byte {
+	return readABI("EntryPoint.abi")
+}
+
+func GetParsedABI() *abi.ABI {
+	return parsedABI
+}
+
+func GetParsedAccountABI() *abi.ABI {
+	return parsedAccountABI
+}
+
+func GetParsedPaymasterABI() *abi.ABI {
+	return parsedPaymasterABI
+}
+
+func readABI(name string) []byte {
+	bz, err := f.ReadFile(name)
+	if err != nil {
+		panic("failed to read " + name)
+	}
+	return bz
+}
+
+func parseABI(name string) *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(string(readABI(name))))
+	if err != nil {
+		panic(err)
+	}
+	return &parsed
+}
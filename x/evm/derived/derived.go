@@ -11,6 +11,7 @@ type SignerVersion int
 const (
 	London SignerVersion = iota
 	Cancun
+	Prague
 )
 
 type Derived struct {
@@ -19,6 +20,9 @@ type Derived struct {
 	PubKey        *secp256k1.PubKey
 	IsAssociate   bool
 	Version       SignerVersion
+	// Authorities are the signers of the authorizations of a set-code transaction, in order.
+	// Authorizations whose signature can't be recovered have a zero authority.
+	Authorities []common.Address
 }
 
 // Derived should never come from deserialization or be transmitted after serialization,
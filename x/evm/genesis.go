@@ -13,6 +13,10 @@ import (
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState) {
 	k.InitGenesis(ctx, genState)
 	k.SetParams(ctx, genState.Params)
+	// new chains accept set-code and blob transactions and emit precompile logs from genesis; existing ones once upgraded
+	k.SetPragueTime(ctx, 0)
+	k.SetPrecompileLogsTime(ctx, 0)
+	k.SetBlobsTime(ctx, 0)
 	for _, aa := range genState.AddressAssociations {
 		k.SetAddressMapping(ctx, sdk.MustAccAddressFromBech32(aa.KiiAddress), common.HexToAddress(aa.EthAddress))
 	}
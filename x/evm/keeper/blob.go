@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/kiichain/kiichain/x/evm/types"
+)
+
+// GetBlockBlobGas returns the blob gas used and the excess blob gas of the last block that
+// ended. Like the block bloom, values of past blocks are read from the state of their height.
+func (k *Keeper) GetBlockBlobGas(ctx sdk.Context) (blobGasUsed uint64, excessBlobGas uint64) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockBlobGasKey)
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:])
+}
+
+func (k *Keeper) SetBlockBlobGas(ctx sdk.Context, blobGasUsed uint64, excessBlobGas uint64) {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], blobGasUsed)
+	binary.BigEndian.PutUint64(bz[8:], excessBlobGas)
+	ctx.KVStore(k.storeKey).Set(types.BlockBlobGasKey, bz)
+}
+
+// GetExcessBlobGas returns the excess blob gas of the block being processed, which EIP-4844
+// derives from the blob gas used and the excess blob gas of its parent
+func (k *Keeper) GetExcessBlobGas(ctx sdk.Context) uint64 {
+	parentBlobGasUsed, parentExcessBlobGas := k.GetBlockBlobGas(ctx)
+	return eip4844.CalcExcessBlobGas(parentExcessBlobGas, parentBlobGasUsed)
+}
+
+// GetBlobBaseFee returns the price per unit of blob gas of the block being processed
+func (k *Keeper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	return eip4844.CalcBlobFee(k.GetExcessBlobGas(ctx))
+}
+
+// GetBlobGasUsed returns the blob gas used by the transactions of the current block that
+// made it to execution
+func (k *Keeper) GetBlobGasUsed(deferredInfos []*types.DeferredInfo) (blobGasUsed uint64) {
+	for _, deferredInfo := range deferredInfos {
+		if deferredInfo.Error != "" || int(deferredInfo.TxIndex) >= len(k.msgs) || k.msgs[deferredInfo.TxIndex] == nil {
+			continue
+		}
+		etx, _ := k.msgs[deferredInfo.TxIndex].AsTransaction()
+		if etx != nil {
+			blobGasUsed += etx.BlobGas()
+		}
+	}
+	return
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobGas(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	// no blob has been used yet
+	require.Equal(t, uint64(0), k.GetExcessBlobGas(ctx))
+	require.Equal(t, big.NewInt(1), k.GetBlobBaseFee(ctx))
+
+	// blocks under the target don't accumulate excess blob gas
+	k.SetBlockBlobGas(ctx, params.BlobTxTargetBlobGasPerBlock, 0)
+	require.Equal(t, uint64(0), k.GetExcessBlobGas(ctx))
+
+	// full blocks raise the blob base fee
+	excess := uint64(0)
+	for i := 0; i < 100; i++ {
+		k.SetBlockBlobGas(ctx, params.MaxBlobGasPerBlock, excess)
+		require.Equal(t, excess+params.MaxBlobGasPerBlock-params.BlobTxTargetBlobGasPerBlock, k.GetExcessBlobGas(ctx))
+		excess = k.GetExcessBlobGas(ctx)
+	}
+	used, storedExcess := k.GetBlockBlobGas(ctx)
+	require.Equal(t, uint64(params.MaxBlobGasPerBlock), used)
+	require.Equal(t, excess-(params.MaxBlobGasPerBlock-params.BlobTxTargetBlobGasPerBlock), storedExcess)
+	require.Equal(t, 1, k.GetBlobBaseFee(ctx).Cmp(big.NewInt(1)))
+
+	// and empty blocks bring it back down
+	k.SetBlockBlobGas(ctx, 0, excess)
+	require.Equal(t, excess-params.BlobTxTargetBlobGasPerBlock, k.GetExcessBlobGas(ctx))
+}
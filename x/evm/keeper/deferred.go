@@ -28,12 +28,13 @@ func (k *Keeper) GetAllEVMTxDeferredInfo(ctx sdk.Context) (res []*types.Deferred
 			if etx == nil {
 				panic("etx is nil for EVM DeferredInfo msg.AsTransaction(). This should never happen.")
 			}
+			txHash := msg.Hash()
 			if txRes.Code == 0 {
-				ctx.Logger().Error(fmt.Sprintf("transaction %s has code 0 but no deferred info", etx.Hash().Hex()))
+				ctx.Logger().Error(fmt.Sprintf("transaction %s has code 0 but no deferred info", txHash.Hex()))
 			}
 			res = append(res, &types.DeferredInfo{
 				TxIndex: uint32(txIdx),
-				TxHash:  etx.Hash().Bytes(),
+				TxHash:  txHash.Bytes(),
 				Error:   txRes.Log,
 			})
 		} else {
@@ -211,7 +211,7 @@ func (k *Keeper) GetVMBlockContext(ctx sdk.Context, gp core.GasPool) (*vm.BlockC
 		Time:        uint64(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  utils.Big0, // only needed for PoW
 		BaseFee:     k.GetDynamicBaseFeePerGas(ctx).TruncateInt().BigInt(),
-		BlobBaseFee: utils.Big1, // Cancun not enabled
+		BlobBaseFee: k.GetBlobBaseFee(ctx),
 		Random:      &rh,
 	}, nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/kiichain/kiichain/x/evm/artifacts/erc721"
 	"github.com/kiichain/kiichain/x/evm/state"
 	"github.com/kiichain/kiichain/x/evm/types"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
 )
 
 type msgServer struct {
@@ -48,7 +49,8 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 		return &types.MsgEVMTransactionResponse{}, nil
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	tx, _ := msg.AsTransaction()
+	tx, txData := msg.AsTransaction()
+	txHash := msg.Hash()
 	isWasmdPrecompileCall := wasmd.IsWasmdCall(tx.To())
 	if isWasmdPrecompileCall {
 		ctx = ctx.WithEVMEntryViaWasmdPrecompile(true)
@@ -66,6 +68,16 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 	stateDB := state.NewDBImpl(ctx, &server, false)
 	emsg := server.GetEVMMessage(ctx, tx, msg.Derived.SenderEVMAddr)
 	gp := server.GetGasPool()
+	var authGas uint64
+	if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+		authGas = server.applySetCodeAuthorizations(ctx, stateDB, sctx, msg.Derived.Authorities, emsg.From)
+		if authGas > emsg.GasLimit {
+			return nil, core.ErrIntrinsicGas
+		}
+		emsg.GasLimit -= authGas
+		// the nonce is checked in the ante handler, and authorizations may have bumped it
+		emsg.SkipAccountChecks = true
+	}
 
 	defer func() {
 		defer stateDB.Cleanup()
@@ -124,7 +136,7 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 				extraSurplus = extraSurplus.Add(syntheticDeferredInfo.Surplus)
 			}
 		}
-		receipt, rerr := server.WriteReceipt(ctx, stateDB, emsg, uint32(tx.Type()), tx.Hash(), serverRes.GasUsed, serverRes.VmError)
+		receipt, rerr := server.WriteReceipt(ctx, stateDB, emsg, uint32(txData.TxType()), txHash, serverRes.GasUsed, serverRes.VmError)
 		if rerr != nil {
 			err = rerr
 			ctx.Logger().Error(fmt.Sprintf("failed to write EVM receipt: %s", err))
@@ -149,7 +161,7 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 		surplus = surplus.Add(extraSurplus)
 		bloom := ethtypes.Bloom{}
 		bloom.SetBytes(receipt.LogsBloom)
-		server.AppendToEvmTxDeferredInfo(ctx, bloom, tx.Hash(), surplus)
+		server.AppendToEvmTxDeferredInfo(ctx, bloom, txHash, surplus)
 
 		// GasUsed in serverRes is in EVM's gas unit, not Kii's gas unit.
 		// PriorityNormalizer is the coefficient that's used to adjust EVM
@@ -162,7 +174,7 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 
 	res, applyErr := server.applyEVMMessage(ctx, emsg, stateDB, gp)
 	serverRes = &types.MsgEVMTransactionResponse{
-		Hash: tx.Hash().Hex(),
+		Hash: txHash.Hex(),
 	}
 	if applyErr != nil {
 		// This should not happen, as anything that could cause applyErr is supposed to
@@ -193,7 +205,7 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 		)
 	}
 
-	serverRes.GasUsed = res.UsedGas
+	serverRes.GasUsed = res.UsedGas + authGas
 	serverRes.ReturnData = res.ReturnData
 	serverRes.Logs = types.NewLogsFromEth(stateDB.GetAllLogs())
 
@@ -220,6 +232,11 @@ func (k *Keeper) GetEVMMessage(ctx sdk.Context, tx *ethtypes.Transaction, sender
 		BlobGasFeeCap:     tx.BlobGasFeeCap(),
 		From:              sender,
 	}
+	// accounts that delegated their code can still send transactions, which go-ethereum would
+	// reject for not being EOAs. Nonces are checked in the ante handler either way.
+	if _, delegated := state.ParseDelegation(k.GetCode(ctx, sender)); delegated {
+		msg.SkipAccountChecks = true
+	}
 	// If baseFee provided, set gasPrice to effectiveGasPrice.
 	baseFee := k.GetBaseFee(ctx)
 	if baseFee != nil {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -295,6 +296,117 @@ func TestEVMDynamicFeeTransaction(t *testing.T) {
 	require.Equal(t, uint32(ethtypes.ReceiptStatusSuccessful), receipt.Status) // value is 0x14 = 20
 }
 
+func signSetCodeAuthorization(t *testing.T, chainID *big.Int, addr common.Address, nonce uint64, key *ecdsa.PrivateKey) ethtx.SetCodeAuthorization {
+	authChainID := sdk.NewIntFromBigInt(chainID)
+	auth := ethtx.SetCodeAuthorization{ChainID: &authChainID, Address: addr.Hex(), Nonce: nonce}
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+	return auth
+}
+
+func TestEVMSetCodeTransaction(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	code, err := os.ReadFile("../../../example/contracts/simplestorage/SimpleStorage.bin")
+	require.Nil(t, err)
+	bz, err := hex.DecodeString(string(code))
+	require.Nil(t, err)
+	privKey := testkeeper.MockPrivateKey()
+	key, _ := crypto.HexToECDSA(hex.EncodeToString(privKey.Bytes()))
+	chainID := k.ChainID(ctx)
+	signer := ethtypes.MakeSigner(types.DefaultChainConfig().EthereumConfig(chainID), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		GasFeeCap: big.NewInt(1000000000000),
+		Gas:       200000,
+		Value:     big.NewInt(0),
+		Data:      bz,
+	}), signer, key)
+	require.Nil(t, err)
+	txwrapper, err := ethtx.NewDynamicFeeTx(tx)
+	require.Nil(t, err)
+	req, err := types.NewMsgEVMTransaction(txwrapper)
+	require.Nil(t, err)
+
+	_, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(1000000)))
+	k.BankKeeper().MintCoins(ctx, types.ModuleName, amt)
+	k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, evmAddr[:], amt)
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	anteHandle := func(req *types.MsgEVMTransaction) {
+		require.Nil(t, ante.Preprocess(ctx, req))
+		ctx, err = ante.NewEVMFeeCheckDecorator(k).AnteHandle(ctx, mockTx{msgs: []sdk.Msg{req}}, false, func(sdk.Context, sdk.Tx, bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		require.Nil(t, err)
+	}
+
+	// Deploy Simple Storage contract
+	anteHandle(req)
+	res, err := msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Empty(t, res.VmError)
+	require.NoError(t, k.FlushTransientReceipts(ctx))
+	receipt, err := k.GetReceipt(ctx, common.HexToHash(res.Hash))
+	require.Nil(t, err)
+	contractAddr := common.HexToAddress(receipt.ContractAddress)
+
+	// delegate an EOA to the contract and call it in the same transaction
+	authKey, _ := crypto.GenerateKey()
+	authority := crypto.PubkeyToAddress(authKey.PublicKey)
+	otherChainKey, _ := crypto.GenerateKey()
+	abi, err := simplestorage.SimplestorageMetaData.GetAbi()
+	require.Nil(t, err)
+	bz, err = abi.Pack("set", big.NewInt(20))
+	require.Nil(t, err)
+	setCodeTx := &ethtx.SetCodeTx{
+		Nonce:    1,
+		GasLimit: 200000,
+		To:       authority.Hex(),
+		Data:     bz,
+		Authorizations: []ethtx.SetCodeAuthorization{
+			signSetCodeAuthorization(t, chainID, contractAddr, 0, authKey),
+			// authorizations for another chain are skipped
+			signSetCodeAuthorization(t, big.NewInt(12345), contractAddr, 0, otherChainKey),
+		},
+	}
+	setCodeTx.SetAmount(sdk.ZeroInt())
+	setCodeTx.SetGasFeeCap(sdk.NewInt(1000000000000))
+	setCodeTx.SetGasTipCap(sdk.ZeroInt())
+	setCodeTx.SetSignatureValues(chainID, nil, nil, nil)
+	sig, err := crypto.Sign(setCodeTx.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	setCodeTx.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	req, err = types.NewMsgEVMTransaction(setCodeTx)
+	require.Nil(t, err)
+	anteHandle(req)
+	require.Equal(t, evmAddr, req.Derived.SenderEVMAddr)
+	require.Equal(t, authority, req.Derived.Authorities[0])
+
+	res, err = msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Empty(t, res.VmError)
+	require.Equal(t, setCodeTx.Hash().Hex(), res.Hash)
+	require.Greater(t, res.GasUsed, uint64(2*ethtx.PerEmptyAccountCost))
+	require.NoError(t, k.FlushTransientReceipts(ctx))
+	receipt, err = k.GetReceipt(ctx, setCodeTx.Hash())
+	require.Nil(t, err)
+	require.Equal(t, uint32(ethtx.SetCodeTxType), receipt.TxType)
+	require.Equal(t, uint32(ethtypes.ReceiptStatusSuccessful), receipt.Status)
+
+	stateDB := state.NewDBImpl(ctx, k, false)
+	target, delegated := stateDB.GetDelegation(authority)
+	require.True(t, delegated)
+	require.Equal(t, contractAddr, target)
+	require.Equal(t, uint64(1), stateDB.GetNonce(authority))
+	require.Equal(t, uint64(2), stateDB.GetNonce(evmAddr))
+	// the contract code ran against the storage of the EOA
+	require.Equal(t, common.BigToHash(big.NewInt(20)), stateDB.GetState(authority, common.Hash{}))
+	require.Equal(t, common.Hash{}, stateDB.GetState(contractAddr, common.Hash{}))
+	_, delegated = stateDB.GetDelegation(crypto.PubkeyToAddress(otherChainKey.PublicKey))
+	require.False(t, delegated)
+}
+
 func TestEVMPrecompiles(t *testing.T) {
 	k, ctx := testkeeper.MockEVMKeeperWithPrecompiles()
 	params := k.GetParams(ctx)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,6 +45,40 @@ func (k *Keeper) RunWithOneOffEVMInstance(
 	return nil
 }
 
+// UpsertERCNativePointerFromCosmos deploys or upgrades the ERC20 native pointer of the token for a
+// cosmos message. Unlike RunWithOneOffEVMInstance, the deployment is metered like CallEVM: it is
+// bounded by and charged to the gas of the message.
+func (k *Keeper) UpsertERCNativePointerFromCosmos(
+	ctx sdk.Context, token string, metadata utils.ERCMetadata,
+) (contractAddr common.Address, err error) {
+	if ctx.IsEVM() && !ctx.EVMEntryViaWasmdPrecompile() {
+		return common.Address{}, errors.New("ERC20 native pointers deployed in EVM transactions must use the EVM of the caller")
+	}
+	executionCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).WithEVMEntryViaWasmdPrecompile(false)
+	stateDB := state.NewDBImpl(executionCtx, k, false)
+	evmModuleAddress := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+	blockCtx, err := k.GetVMBlockContext(executionCtx, k.GetGasPool())
+	if err != nil {
+		return common.Address{}, err
+	}
+	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	txCtx := core.NewEVMTxContext(&core.Message{From: evmModuleAddress, GasPrice: utils.Big0})
+	evm := vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{})
+	// the EVM gas used by the deployment is charged to ctx
+	contractAddr, err = k.UpsertERCNativePointer(ctx, evm, token, metadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+	surplus, err := stateDB.Finalize()
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !surplus.IsZero() {
+		return common.Address{}, fmt.Errorf("ERC20 native pointer deployment left a non-zero surplus %s", surplus)
+	}
+	return contractAddr, nil
+}
+
 func (k *Keeper) UpsertERCNativePointer(
 	ctx sdk.Context, evm *vm.EVM, token string, metadata utils.ERCMetadata,
 ) (contractAddr common.Address, err error) {
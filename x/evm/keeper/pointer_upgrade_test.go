@@ -68,6 +68,35 @@ func TestUpsertERCNativePointer(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestUpsertERCNativePointerFromCosmos(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000000))
+	addr, err := k.UpsertERCNativePointerFromCosmos(ctx, "cosmos", utils.ERCMetadata{Name: "test", Symbol: "test", Decimals: 6})
+	require.Nil(t, err)
+	// the deployment is charged to the message
+	deployGas := ctx.GasMeter().GasConsumed()
+	require.NotZero(t, deployGas)
+	newAddr, err := k.UpsertERCNativePointerFromCosmos(ctx, "cosmos", utils.ERCMetadata{Name: "test2", Symbol: "test2", Decimals: 12})
+	require.Nil(t, err)
+	require.Equal(t, addr, newAddr)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), deployGas)
+	res, err := k.QueryERCSingleOutput(ctx, "native", addr, "name")
+	require.Nil(t, err)
+	require.Equal(t, "test2", res.(string))
+
+	// and bounded by its gas
+	lowGasCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 200000))
+	_, err = k.UpsertERCNativePointerFromCosmos(lowGasCtx, "lowgas", utils.ERCMetadata{Name: "test", Symbol: "test"})
+	require.NotNil(t, err)
+	_, _, exists := k.GetERC20NativePointer(ctx, "lowgas")
+	require.False(t, exists)
+
+	// EVM transactions must deploy with the EVM of the caller
+	_, err = k.UpsertERCNativePointerFromCosmos(ctx.WithIsEVM(true), "evm", utils.ERCMetadata{Name: "test", Symbol: "test"})
+	require.NotNil(t, err)
+}
+
 func TestUpsertERC20Pointer(t *testing.T) {
 	k := &testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain/x/evm/state"
+	"github.com/kiichain/kiichain/x/evm/types/ethtx"
+)
+
+// applySetCodeAuthorizations delegates the code of the authorities of a set-code transaction
+// and returns the gas the authorizations cost. Like EIP-7702 specifies, invalid authorizations
+// are skipped rather than failing the transaction. The refund for authorities that already
+// exist is deducted from the cost directly, so it isn't subject to the EIP-3529 refund cap.
+func (k *Keeper) applySetCodeAuthorizations(ctx sdk.Context, stateDB *state.DBImpl, txData *ethtx.SetCodeTx, authorities []common.Address, sender common.Address) (gas uint64) {
+	chainID := k.ChainID(ctx)
+	for i, auth := range txData.Authorizations {
+		gas += ethtx.PerEmptyAccountCost
+		if authChainID := auth.GetChainID(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+			continue
+		}
+		if auth.Nonce == math.MaxUint64 || i >= len(authorities) || authorities[i] == (common.Address{}) {
+			continue
+		}
+		authority := authorities[i]
+		if _, delegated := stateDB.GetDelegation(authority); !delegated && stateDB.GetCodeSize(authority) != 0 {
+			continue
+		}
+		// the sender nonce is only bumped during execution, so a self-sponsored authorization
+		// is one ahead of the state
+		expectedNonce := stateDB.GetNonce(authority)
+		if authority == sender {
+			expectedNonce++
+		}
+		if auth.Nonce != expectedNonce {
+			continue
+		}
+		if stateDB.Exist(authority) {
+			gas -= ethtx.PerEmptyAccountCost - ethtx.PerAuthBaseCost
+		}
+		stateDB.SetDelegation(authority, auth.GetAddress())
+		stateDB.SetNonce(authority, stateDB.GetNonce(authority)+1)
+	}
+	return gas
+}
@@ -42,3 +42,14 @@ func (k *Keeper) IterateState(ctx sdk.Context, cb func(addr common.Address, key
 		}
 	}
 }
+
+// IterateStorage iterates over the storage of a contract in key order, starting at the given key
+func (k *Keeper) IterateStorage(ctx sdk.Context, addr common.Address, start common.Hash, cb func(key common.Hash, val common.Hash) bool) {
+	iter := k.PrefixStore(ctx, types.StateKey(addr)).Iterator(start[:], nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToHash(iter.Key()), common.BytesToHash(iter.Value())) {
+			break
+		}
+	}
+}
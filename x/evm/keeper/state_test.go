@@ -21,3 +21,29 @@ func TestState(t *testing.T) {
 	got := k.GetState(ctx, addr, common.HexToHash("0xabc"))
 	require.Equal(t, common.HexToHash("0xdef"), got)
 }
+
+func TestIterateStorage(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	_, addr := testkeeper.MockAddressPair()
+	_, otherAddr := testkeeper.MockAddressPair()
+	k.SetState(ctx, addr, common.HexToHash("0x1"), common.HexToHash("0xa"))
+	k.SetState(ctx, addr, common.HexToHash("0x2"), common.HexToHash("0xb"))
+	k.SetState(ctx, addr, common.HexToHash("0x3"), common.HexToHash("0xc"))
+	k.SetState(ctx, otherAddr, common.HexToHash("0x4"), common.HexToHash("0xd"))
+
+	keys := []common.Hash{}
+	k.IterateStorage(ctx, addr, common.HexToHash("0x2"), func(key common.Hash, val common.Hash) bool {
+		keys = append(keys, key)
+		return false
+	})
+	require.Equal(t, []common.Hash{common.HexToHash("0x2"), common.HexToHash("0x3")}, keys)
+
+	// stops when the callback returns true
+	keys = []common.Hash{}
+	k.IterateStorage(ctx, addr, common.Hash{}, func(key common.Hash, val common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, []common.Hash{common.HexToHash("0x1")}, keys)
+}
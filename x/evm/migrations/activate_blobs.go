@@ -0,0 +1,12 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+)
+
+// ActivateBlobs accepts blob transactions and tracks the blob gas of blocks from the upgrade block on
+func ActivateBlobs(ctx sdk.Context, k *keeper.Keeper) error {
+	k.SetBlobsTime(ctx, ctx.BlockTime().Unix())
+	return nil
+}
@@ -0,0 +1,12 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+)
+
+// ActivatePrague starts accepting EIP-7702 set-code transactions from the upgrade block on
+func ActivatePrague(ctx sdk.Context, k *keeper.Keeper) error {
+	k.SetPragueTime(ctx, ctx.BlockTime().Unix())
+	return nil
+}
@@ -0,0 +1,12 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/evm/keeper"
+)
+
+// ActivatePrecompileLogs starts emitting EVM logs from precompiles from the upgrade block on
+func ActivatePrecompileLogs(ctx sdk.Context, k *keeper.Keeper) error {
+	k.SetPrecompileLogsTime(ctx, ctx.BlockTime().Unix())
+	return nil
+}
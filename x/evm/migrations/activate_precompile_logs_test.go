@@ -0,0 +1,19 @@
+package migrations_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/kiichain/kiichain/x/evm/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivatePrecompileLogs(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Unix(1700000000, 0))
+	require.Nil(t, migrations.ActivatePrecompileLogs(ctx, &k))
+	require.Equal(t, int64(1700000000), k.GetPrecompileLogsTime(ctx))
+	require.True(t, k.IsPrecompileLogsActive(ctx))
+	require.False(t, k.IsPrecompileLogsActive(ctx.WithBlockTime(time.Unix(1699999999, 0))))
+}
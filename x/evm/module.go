@@ -221,6 +221,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 13, func(ctx sdk.Context) error {
 		return migrations.MigrateEip1559Params(ctx, am.keeper)
 	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
+		return migrations.ActivatePrague(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.ActivatePrecompileLogs(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 17, func(ctx sdk.Context) error {
+		return migrations.ActivateBlobs(ctx, am.keeper)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -258,7 +270,7 @@ func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec) <-
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 15 }
+func (AppModule) ConsensusVersion() uint64 { return 18 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -344,5 +356,8 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	}
 	am.keeper.SetTxHashesOnHeight(ctx, ctx.BlockHeight(), utils.Filter(utils.Map(evmTxDeferredInfoList, func(i *types.DeferredInfo) common.Hash { return common.BytesToHash(i.TxHash) }), func(h common.Hash) bool { return h.Cmp(ethtypes.EmptyTxsHash) != 0 }))
 	am.keeper.SetBlockBloom(ctx, utils.Map(evmTxDeferredInfoList, func(i *types.DeferredInfo) ethtypes.Bloom { return ethtypes.BytesToBloom(i.TxBloom) }))
+	if am.keeper.IsBlobsActive(ctx) {
+		am.keeper.SetBlockBlobGas(ctx, am.keeper.GetBlobGasUsed(evmTxDeferredInfoList), am.keeper.GetExcessBlobGas(ctx))
+	}
 	return []abci.ValidatorUpdate{}
 }
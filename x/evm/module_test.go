@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/kiichain/kiichain/app"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/kiichain/kiichain/x/evm"
@@ -79,7 +81,7 @@ func TestModuleExportGenesis(t *testing.T) {
 func TestConsensusVersion(t *testing.T) {
 	k, _ := testkeeper.MockEVMKeeper()
 	module := evm.NewAppModule(nil, k)
-	assert.Equal(t, uint64(15), module.ConsensusVersion())
+	assert.Equal(t, uint64(18), module.ConsensusVersion())
 }
 
 func TestABCI(t *testing.T) {
@@ -173,6 +175,28 @@ func TestABCI(t *testing.T) {
 	require.Equal(t, sdk.ZeroInt(), k.BankKeeper().SpendableCoins(ctx, coinbase).AmountOf("ukii"))
 }
 
+func TestBlockBlobGasActivation(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	m := evm.NewAppModule(nil, k)
+	k.SetBlockBlobGas(ctx, params.MaxBlobGasPerBlock, 0)
+
+	// the blob gas of blocks isn't tracked before the upgrade
+	k.SetBlobsTime(ctx, 1700000001)
+	m.BeginBlock(ctx, abci.RequestBeginBlock{})
+	m.EndBlock(ctx, abci.RequestEndBlock{})
+	used, excess := k.GetBlockBlobGas(ctx)
+	require.Equal(t, uint64(params.MaxBlobGasPerBlock), used)
+	require.Equal(t, uint64(0), excess)
+
+	k.SetBlobsTime(ctx, 1700000000)
+	m.BeginBlock(ctx, abci.RequestBeginBlock{})
+	m.EndBlock(ctx, abci.RequestEndBlock{})
+	used, excess = k.GetBlockBlobGas(ctx)
+	require.Equal(t, uint64(0), used)
+	require.Equal(t, uint64(params.MaxBlobGasPerBlock-params.BlobTxTargetBlobGasPerBlock), excess)
+}
+
 func TestAnteSurplus(t *testing.T) {
 	a := app.Setup(false, false)
 	k := a.EvmKeeper
@@ -18,6 +18,9 @@ type accessList struct {
 
 func (s *DBImpl) AddressInAccessList(addr common.Address) bool {
 	s.k.PrepareReplayedAddr(s.ctx, addr)
+	// opcodes reading the code of an account (e.g. EXTCODECOPY) check the access list first,
+	// the code they read is not the code of a call frame
+	s.frameCodePending = false
 	_, ok := s.getCurrentAccessList().Addresses[addr]
 	if ok {
 		return true
@@ -86,7 +89,7 @@ func (s *DBImpl) Prepare(_ params.Rules, sender, coinbase common.Address, dest *
 	if dest != nil {
 		s.k.PrepareReplayedAddr(s.ctx, *dest)
 	}
-	s.Snapshot()
+	s.snapshot()
 	s.AddAddressToAccessList(sender)
 	if dest != nil {
 		s.AddAddressToAccessList(*dest)
@@ -10,9 +10,22 @@ func (s *DBImpl) GetCodeHash(addr common.Address) common.Hash {
 	return s.k.GetCodeHash(s.ctx, addr)
 }
 
+// GetCode returns the code stored for addr, which is the delegation designator for accounts
+// that delegated their code, like EXTCODECOPY expects. The EVM loads the code of a call frame
+// with GetCode right after taking the frame snapshot, that load gets the code of the delegate
+// instead since EIP-7702 executes it when the account is called.
 func (s *DBImpl) GetCode(addr common.Address) []byte {
 	s.k.PrepareReplayedAddr(s.ctx, addr)
-	return s.k.GetCode(s.ctx, addr)
+	code := s.k.GetCode(s.ctx, addr)
+	if !s.frameCodePending {
+		return code
+	}
+	s.frameCodePending = false
+	if target, ok := ParseDelegation(code); ok {
+		s.k.PrepareReplayedAddr(s.ctx, target)
+		return s.k.GetCode(s.ctx, target)
+	}
+	return code
 }
 
 func (s *DBImpl) SetCode(addr common.Address, code []byte) {
@@ -20,7 +33,7 @@ func (s *DBImpl) SetCode(addr common.Address, code []byte) {
 
 	if s.logger != nil && s.logger.OnCodeChange != nil {
 		// The SetCode method could be modified to return the old code/hash directly.
-		oldCode := s.GetCode(addr)
+		oldCode := s.k.GetCode(s.ctx, addr)
 		oldHash := s.GetCodeHash(addr)
 
 		s.logger.OnCodeChange(addr, oldHash, oldCode, common.Hash(crypto.Keccak256(code)), code)
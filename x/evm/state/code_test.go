@@ -1,13 +1,16 @@
 package state_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
 	"github.com/kiichain/kiichain/x/evm/state"
+	"github.com/kiichain/kiichain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,3 +30,73 @@ func TestCode(t *testing.T) {
 	require.Equal(t, code, statedb.GetCode(addr))
 	require.Equal(t, 5, statedb.GetCodeSize(addr))
 }
+
+func TestDelegation(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	_, eoa := testkeeper.MockAddressPair()
+	_, contract := testkeeper.MockAddressPair()
+	statedb := state.NewDBImpl(ctx, k, false)
+	code := []byte{1, 2, 3, 4, 5}
+	statedb.SetCode(contract, code)
+
+	_, ok := statedb.GetDelegation(eoa)
+	require.False(t, ok)
+	statedb.SetDelegation(eoa, contract)
+	target, ok := statedb.GetDelegation(eoa)
+	require.True(t, ok)
+	require.Equal(t, contract, target)
+	// the code, code hash and size are the designator ones
+	require.Equal(t, state.AddressToDelegation(contract), statedb.GetCode(eoa))
+	require.Equal(t, crypto.Keccak256Hash(state.AddressToDelegation(contract)), statedb.GetCodeHash(eoa))
+	require.Equal(t, 23, statedb.GetCodeSize(eoa))
+
+	// the code loaded for a call frame is the delegate one
+	statedb.Snapshot()
+	require.Equal(t, code, statedb.GetCode(eoa))
+	require.Equal(t, state.AddressToDelegation(contract), statedb.GetCode(eoa))
+
+	// reading the code from an opcode goes through the access list first
+	statedb.Snapshot()
+	statedb.AddressInAccessList(eoa)
+	require.Equal(t, state.AddressToDelegation(contract), statedb.GetCode(eoa))
+
+	_, ok = state.ParseDelegation(code)
+	require.False(t, ok)
+
+	// delegating to the zero address clears the code
+	statedb.SetDelegation(eoa, common.Address{})
+	_, ok = statedb.GetDelegation(eoa)
+	require.False(t, ok)
+	require.Empty(t, statedb.GetCode(eoa))
+}
+
+func TestDelegationExecution(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	_, eoa := testkeeper.MockAddressPair()
+	_, delegate := testkeeper.MockAddressPair()
+	_, reader := testkeeper.MockAddressPair()
+	statedb := state.NewDBImpl(ctx, k, false)
+	// returns 42
+	statedb.SetCode(delegate, []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3})
+	// returns the 23 bytes EXTCODECOPY copies from the code of the EOA
+	readerCode := append([]byte{0x60, 0x17, 0x60, 0x00, 0x60, 0x00, 0x73}, eoa.Bytes()...)
+	statedb.SetCode(reader, append(readerCode, 0x3c, 0x60, 0x17, 0x60, 0x00, 0xf3))
+	statedb.SetDelegation(eoa, delegate)
+
+	blockCtx, err := k.GetVMBlockContext(ctx, k.GetGasPool())
+	require.Nil(t, err)
+	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	evm := vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{})
+
+	// calling the EOA runs the code of the delegate
+	ret, _, err := evm.Call(vm.AccountRef(reader), eoa, nil, 100000, big.NewInt(0))
+	require.Nil(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(42)).Bytes(), ret)
+
+	// EXTCODECOPY copies the designator
+	ret, _, err = evm.Call(vm.AccountRef(eoa), reader, nil, 100000, big.NewInt(0))
+	require.Nil(t, err)
+	require.Equal(t, state.AddressToDelegation(delegate), ret)
+}
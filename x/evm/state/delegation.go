@@ -0,0 +1,39 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DelegationPrefix prefixes the code EIP-7702 stores for accounts that delegated their code
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// ParseDelegation returns the address a delegation designator points to
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// AddressToDelegation returns the delegation designator pointing to addr
+func AddressToDelegation(addr common.Address) []byte {
+	return append(append([]byte{}, DelegationPrefix...), addr.Bytes()...)
+}
+
+// GetDelegation returns the address addr delegated its code to, if any
+func (s *DBImpl) GetDelegation(addr common.Address) (common.Address, bool) {
+	s.k.PrepareReplayedAddr(s.ctx, addr)
+	return ParseDelegation(s.k.GetCode(s.ctx, addr))
+}
+
+// SetDelegation stores the designator delegating the code of addr to target. Delegating to the
+// zero address clears the code of addr instead.
+func (s *DBImpl) SetDelegation(addr common.Address, target common.Address) {
+	if target == (common.Address{}) {
+		s.SetCode(addr, nil)
+		return
+	}
+	s.SetCode(addr, AddressToDelegation(target))
+}
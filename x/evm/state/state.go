@@ -13,6 +13,8 @@ import (
 
 func (s *DBImpl) CreateAccount(acc common.Address) {
 	s.k.PrepareReplayedAddr(s.ctx, acc)
+	// the created account has no code to load
+	s.frameCodePending = false
 	// clear any existing state but keep balance untouched
 	s.clearAccountState(acc)
 	s.MarkAccount(acc, AccountCreated)
@@ -91,7 +93,13 @@ func (s *DBImpl) HasSelfDestructed(acc common.Address) bool {
 	return bytes.Equal(val, AccountDeleted)
 }
 
+// Snapshot is called by the EVM when it enters a call frame, before it loads the code of the frame
 func (s *DBImpl) Snapshot() int {
+	s.frameCodePending = true
+	return s.snapshot()
+}
+
+func (s *DBImpl) snapshot() int {
 	newCtx := s.ctx.WithMultiStore(s.ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
 	s.snapshottedCtxs = append(s.snapshottedCtxs, s.ctx)
 	s.ctx = newCtx
@@ -105,7 +113,8 @@ func (s *DBImpl) RevertToSnapshot(rev int) {
 	s.snapshottedCtxs = s.snapshottedCtxs[:rev]
 	s.tempStateCurrent = s.tempStatesHist[rev]
 	s.tempStatesHist = s.tempStatesHist[:rev]
-	s.Snapshot()
+	s.frameCodePending = false
+	s.snapshot()
 }
 
 func (s *DBImpl) handleResidualFundsInDestructedAccounts(st *TemporaryState) {
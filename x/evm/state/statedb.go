@@ -38,6 +38,10 @@ type DBImpl struct {
 	eventsSuppressed bool
 
 	logger *tracing.Hooks
+
+	// set when the EVM takes the snapshot of a new call frame, until the code of the frame
+	// is loaded (see GetCode)
+	frameCodePending bool
 }
 
 func NewDBImpl(ctx sdk.Context, k EVMKeeper, simulation bool) *DBImpl {
@@ -56,7 +60,7 @@ func NewDBImpl(ctx sdk.Context, k EVMKeeper, simulation bool) *DBImpl {
 		tempStateCurrent:   NewTemporaryState(),
 		coinbaseEvmAddress: feeCollector,
 	}
-	s.Snapshot() // take an initial snapshot for GetCommitted
+	s.snapshot() // take an initial snapshot for GetCommitted
 	return s
 }
 
@@ -75,6 +75,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&ethtx.AccessListTx{},
 		&ethtx.LegacyTx{},
 		&ethtx.BlobTx{},
+		&ethtx.SetCodeTx{},
 		&ethtx.AssociateTx{},
 	)
 
@@ -124,6 +125,11 @@ func UnpackTxData(any *codectypes.Any) (ethtx.TxData, error) {
 			// value is a blob tx
 			return &btx, nil
 		}
+		sctx := ethtx.SetCodeTx{}
+		if proto.Unmarshal(any.Value, &sctx) == nil {
+			// value is a set code tx
+			return &sctx, nil
+		}
 		astx := ethtx.AssociateTx{}
 		if proto.Unmarshal(any.Value, &astx) == nil {
 			// value is an associate tx
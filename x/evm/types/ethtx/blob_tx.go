@@ -1,6 +1,7 @@
 package ethtx
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math/big"
@@ -237,6 +238,24 @@ func (tx BlobTx) Validate() error {
 		)
 	}
 
+	if tx.Sidecar != nil {
+		for _, blob := range tx.Sidecar.Blobs {
+			if len(blob) != len(kzg4844.Blob{}) {
+				return fmt.Errorf("invalid blob size %d", len(blob))
+			}
+		}
+		for _, commitment := range tx.Sidecar.Commitments {
+			if len(commitment) != len(kzg4844.Commitment{}) {
+				return fmt.Errorf("invalid blob commitment size %d", len(commitment))
+			}
+		}
+		for _, proof := range tx.Sidecar.Proofs {
+			if len(proof) != len(kzg4844.Proof{}) {
+				return fmt.Errorf("invalid blob proof size %d", len(proof))
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -285,3 +304,42 @@ func sidecarToEthSidecar(sidecar *BlobTxSidecar) *ethtypes.BlobTxSidecar {
 		Proofs:      utils.Map(sidecar.Proofs, func(p []byte) kzg4844.Proof { return kzg4844.Proof(p) }),
 	}
 }
+
+// ValidateBlobSidecar checks that the sidecar holds one blob per blob hash, that the hashes
+// are the versioned hashes of the commitments and that the KZG proofs of the blobs are valid.
+// cherrypicked from go-ethereum:txpool:validateBlobSidecar
+func ValidateBlobSidecar(hashes []common.Hash, sidecar *ethtypes.BlobTxSidecar) error {
+	if len(sidecar.Blobs) != len(hashes) {
+		return fmt.Errorf("invalid number of %d blobs compared to %d blob hashes", len(sidecar.Blobs), len(hashes))
+	}
+	if len(sidecar.Commitments) != len(hashes) {
+		return fmt.Errorf("invalid number of %d blob commitments compared to %d blob hashes", len(sidecar.Commitments), len(hashes))
+	}
+	if len(sidecar.Proofs) != len(hashes) {
+		return fmt.Errorf("invalid number of %d blob proofs compared to %d blob hashes", len(sidecar.Proofs), len(hashes))
+	}
+	// Blob quantities match up, validate that the provers match with the
+	// transaction hash before getting to the cryptography
+	hasher := sha256.New()
+	for i, want := range hashes {
+		hasher.Write(sidecar.Commitments[i][:])
+		hash := hasher.Sum(nil)
+		hasher.Reset()
+
+		var vhash common.Hash
+		vhash[0] = params.BlobTxHashVersion
+		copy(vhash[1:], hash[1:])
+
+		if vhash != want {
+			return fmt.Errorf("blob %d: computed hash %#x mismatches transaction one %#x", i, vhash, want)
+		}
+	}
+	// Blob commitments match with the hashes in the transaction, verify the
+	// blobs themselves via KZG
+	for i := range sidecar.Blobs {
+		if err := kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return fmt.Errorf("invalid blob %d: %v", i, err)
+		}
+	}
+	return nil
+}
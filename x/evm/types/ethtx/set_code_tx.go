@@ -0,0 +1,460 @@
+package ethtx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// SetCodeTxType is the EIP-7702 transaction type. The go-ethereum version Kii runs on predates
+	// EIP-7702, so set-code transactions are decoded, signed and hashed here instead.
+	SetCodeTxType = 0x04
+
+	// SetCodeAuthorizationMagic prefixes the payload signed by an authorization signer
+	SetCodeAuthorizationMagic = 0x05
+
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization
+	PerEmptyAccountCost = 25000
+	// PerAuthBaseCost is what an authorization costs if its authority already exists; the
+	// difference with PerEmptyAccountCost is refunded
+	PerAuthBaseCost = 12500
+)
+
+// setCodeTxRLP is the EIP-2718 payload of a set-code transaction
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []setCodeAuthorizationRLP
+	V, R, S    *big.Int
+}
+
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R, S    *big.Int
+}
+
+// DecodeSetCodeTx decodes the binary encoding of a set-code transaction, i.e. its type byte
+// followed by its RLP payload
+func DecodeSetCodeTx(bz []byte) (*SetCodeTx, error) {
+	if len(bz) == 0 || bz[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(bz[1:], &dec); err != nil {
+		return nil, err
+	}
+	for _, v := range []*big.Int{dec.ChainID, dec.GasTipCap, dec.GasFeeCap, dec.Value, dec.V, dec.R, dec.S} {
+		if !IsValidInt256(v) {
+			return nil, errors.New("value overflow")
+		}
+	}
+	txData := &SetCodeTx{
+		Nonce:    dec.Nonce,
+		Data:     dec.Data,
+		GasLimit: dec.Gas,
+		To:       dec.To.Hex(),
+	}
+	txData.SetAmount(sdk.NewIntFromBigInt(dec.Value))
+	txData.SetGasFeeCap(sdk.NewIntFromBigInt(dec.GasFeeCap))
+	txData.SetGasTipCap(sdk.NewIntFromBigInt(dec.GasTipCap))
+	txData.SetAccesses(NewAccessList(&dec.AccessList))
+	for _, auth := range dec.AuthList {
+		if !IsValidInt256(auth.ChainID) || !IsValidInt256(auth.R) || !IsValidInt256(auth.S) {
+			return nil, errors.New("authorization value overflow")
+		}
+		chainID := sdk.NewIntFromBigInt(auth.ChainID)
+		txData.Authorizations = append(txData.Authorizations, SetCodeAuthorization{
+			ChainID: &chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       big.NewInt(int64(auth.V)).Bytes(),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		})
+	}
+	txData.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return txData, txData.Validate()
+}
+
+// MarshalBinary returns the binary encoding of the transaction, as accepted by
+// eth_sendRawTransaction
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	v, r, s := tx.GetRawSignatureValues()
+	enc := tx.unsignedRLP()
+	enc.V, enc.R, enc.S = v, r, s
+	return prefixedRLP(SetCodeTxType, enc)
+}
+
+// Hash returns the hash the transaction is known by
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// SigHash returns the hash signed by the sender of the transaction
+func (tx *SetCodeTx) SigHash() common.Hash {
+	enc := tx.unsignedRLP()
+	bz, err := prefixedRLP(SetCodeTxType, []interface{}{
+		enc.ChainID, enc.Nonce, enc.GasTipCap, enc.GasFeeCap, enc.Gas, enc.To, enc.Value, enc.Data, enc.AccessList, enc.AuthList,
+	})
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+func (tx *SetCodeTx) unsignedRLP() *setCodeTxRLP {
+	enc := &setCodeTxRLP{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      tx.GetValue(),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		AuthList:   []setCodeAuthorizationRLP{},
+	}
+	for _, auth := range tx.Authorizations {
+		v, r, s := auth.GetRawSignatureValues()
+		enc.AuthList = append(enc.AuthList, setCodeAuthorizationRLP{
+			ChainID: auth.GetChainID(),
+			Address: auth.GetAddress(),
+			Nonce:   auth.Nonce,
+			V:       uint8(v.Uint64()),
+			R:       r,
+			S:       s,
+		})
+	}
+	for _, v := range []**big.Int{&enc.ChainID, &enc.GasTipCap, &enc.GasFeeCap, &enc.Value} {
+		if *v == nil {
+			*v = new(big.Int)
+		}
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+	return enc
+}
+
+func prefixedRLP(prefix byte, val interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{prefix})
+	if err := rlp.Encode(buf, val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: append([]SetCodeAuthorization{}, tx.Authorizations...),
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns the dynamic fee transaction the set-code transaction executes as once
+// its authorizations are applied. Its hash and signature hash aren't the ones of the set-code
+// transaction; use Hash and SigHash instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdk.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+func (tx *SetCodeTx) GetBlobFeeCap() *big.Int {
+	return nil
+}
+
+func (tx *SetCodeTx) GetBlobHashes() []common.Hash {
+	return nil
+}
+
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errors.New("gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errors.New("gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return fmt.Errorf("gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return fmt.Errorf("gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return fmt.Errorf("max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !IsValidInt256(tx.Fee()) {
+		return errors.New("fee out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return fmt.Errorf("amount cannot be negative %s", amount)
+	}
+
+	// set-code transactions can't create contracts
+	if err := ValidateAddress(tx.To); err != nil {
+		return errors.New("invalid to address")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errors.New("set code transaction must have at least one authorization")
+	}
+	for _, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return err
+		}
+	}
+
+	chainID := tx.GetChainID()
+
+	if chainID == nil {
+		return errors.New(
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	return nil
+}
+
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+func (tx *SetCodeTx) SetAmount(v sdk.Int) {
+	tx.Amount = &v
+}
+
+func (tx *SetCodeTx) SetGasFeeCap(v sdk.Int) {
+	tx.GasFeeCap = &v
+}
+
+func (tx *SetCodeTx) SetGasTipCap(v sdk.Int) {
+	tx.GasTipCap = &v
+}
+
+func (tx *SetCodeTx) SetAccesses(v AccessList) {
+	tx.Accesses = v
+}
+
+func (auth *SetCodeAuthorization) GetChainID() *big.Int {
+	if auth.ChainID == nil {
+		return new(big.Int)
+	}
+	return auth.ChainID.BigInt()
+}
+
+func (auth *SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(auth.Address)
+}
+
+func (auth *SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(auth.V, auth.R, auth.S)
+}
+
+// SigHash returns the hash signed by the authority of the authorization
+func (auth *SetCodeAuthorization) SigHash() common.Hash {
+	bz, err := prefixedRLP(SetCodeAuthorizationMagic, []interface{}{auth.GetChainID(), auth.GetAddress(), auth.Nonce})
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// Authority recovers the address that signed the authorization
+func (auth *SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := auth.GetRawSignatureValues()
+	return recoverAddress(auth.SigHash(), v, r, s)
+}
+
+// Sender recovers the address that signed the transaction
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverAddress(tx.SigHash(), v, r, s)
+}
+
+// recoverAddress recovers the signer of a hash from a signature whose V is the y parity
+func recoverAddress(hash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if v.BitLen() > 8 || !crypto.ValidateSignatureValues(byte(v.Uint64()), r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = byte(v.Uint64())
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+// Validate checks the authorization is well-formed. Authorizations with invalid signatures or
+// for another chain are skipped during execution rather than invalidating the transaction.
+func (auth SetCodeAuthorization) Validate() error {
+	if err := ValidateAddress(auth.Address); err != nil {
+		return errors.New("invalid authorization address")
+	}
+	if auth.ChainID != nil && auth.ChainID.IsNegative() {
+		return errors.New("authorization chain ID cannot be negative")
+	}
+	v, r, s := auth.GetRawSignatureValues()
+	if !IsValidInt256(v) || !IsValidInt256(r) || !IsValidInt256(s) {
+		return errors.New("authorization signature out of bound")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func signSetCodeAuthorization(t *testing.T, auth *SetCodeAuthorization, key *ecdsa.PrivateKey) {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+}
+
+func mockSetCodeTransaction(t *testing.T, key *ecdsa.PrivateKey, authKey *ecdsa.PrivateKey) *SetCodeTx {
+	chainID := sdk.NewInt(1)
+	auth := SetCodeAuthorization{ChainID: &chainID, Address: common.Address{'d'}.Hex(), Nonce: 3}
+	signSetCodeAuthorization(t, &auth, authKey)
+	tx := &SetCodeTx{
+		Nonce:          2,
+		GasLimit:       100000,
+		To:             common.Address{'a'}.Hex(),
+		Data:           []byte{'b'},
+		Authorizations: []SetCodeAuthorization{auth},
+	}
+	al := mockAccessList()
+	tx.SetAccesses(NewAccessList(&al))
+	tx.SetAmount(sdk.NewInt(20))
+	tx.SetGasFeeCap(sdk.NewInt(100))
+	tx.SetGasTipCap(sdk.NewInt(50))
+	tx.SetSignatureValues(big.NewInt(1), nil, nil, nil)
+	sig, err := crypto.Sign(tx.SigHash().Bytes(), key)
+	require.Nil(t, err)
+	tx.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	return tx
+}
+
+func TestSetCodeTransaction(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	authKey, _ := crypto.GenerateKey()
+	tx := mockSetCodeTransaction(t, key, authKey)
+	require.Nil(t, tx.Validate())
+
+	require.Equal(t, uint8(SetCodeTxType), tx.TxType())
+	require.Equal(t, tx, tx.Copy())
+	require.Equal(t, big.NewInt(1), tx.GetChainID())
+	require.Equal(t, big.NewInt(100), tx.GetGasPrice())
+	require.Equal(t, big.NewInt(50), tx.GetGasTipCap())
+	require.Equal(t, big.NewInt(20), tx.GetValue())
+	require.Equal(t, common.Address{'a'}, *tx.GetTo())
+	require.Nil(t, tx.GetBlobFeeCap())
+	require.Nil(t, tx.GetBlobHashes())
+	require.Equal(t, big.NewInt(10000000), tx.Fee())
+	require.Equal(t, big.NewInt(10000020), tx.Cost())
+	require.Equal(t, big.NewInt(60), tx.EffectiveGasPrice(big.NewInt(10)))
+
+	// the execution view is a dynamic fee transaction with a different hash
+	view := ethtypes.NewTx(tx.AsEthereumData())
+	require.Equal(t, uint8(ethtypes.DynamicFeeTxType), view.Type())
+	require.Equal(t, tx.GetData(), view.Data())
+	require.NotEqual(t, tx.Hash(), view.Hash())
+
+	sender, err := tx.Sender()
+	require.Nil(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+	authority, err := tx.Authorizations[0].Authority()
+	require.Nil(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(authKey.PublicKey), authority)
+
+	bz, err := tx.MarshalBinary()
+	require.Nil(t, err)
+	require.Equal(t, byte(SetCodeTxType), bz[0])
+	require.Equal(t, crypto.Keccak256Hash(bz), tx.Hash())
+	decoded, err := DecodeSetCodeTx(bz)
+	require.Nil(t, err)
+	require.Equal(t, tx.Hash(), decoded.Hash())
+	require.Equal(t, tx.SigHash(), decoded.SigHash())
+	decodedAuthority, err := decoded.Authorizations[0].Authority()
+	require.Nil(t, err)
+	require.Equal(t, authority, decodedAuthority)
+
+	_, err = DecodeSetCodeTx(bz[1:])
+	require.NotNil(t, err)
+	_, err = DecodeSetCodeTx(append([]byte{SetCodeTxType}, 1, 2, 3))
+	require.NotNil(t, err)
+
+	// tampering with an authorization changes the recovered authority
+	tampered := decoded.Authorizations[0]
+	tampered.Nonce++
+	tamperedAuthority, err := tampered.Authority()
+	if err == nil {
+		require.NotEqual(t, authority, tamperedAuthority)
+	}
+	tampered.V = []byte{2}
+	_, err = tampered.Authority()
+	require.NotNil(t, err)
+}
+
+func TestSetCodeTransactionValidate(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	for _, tc := range []struct {
+		name   string
+		modify func(*SetCodeTx)
+	}{
+		{"no authorizations", func(tx *SetCodeTx) { tx.Authorizations = nil }},
+		{"contract creation", func(tx *SetCodeTx) { tx.To = "" }},
+		{"no chain ID", func(tx *SetCodeTx) { tx.ChainID = nil }},
+		{"no tip cap", func(tx *SetCodeTx) { tx.GasTipCap = nil }},
+		{"tip cap above fee cap", func(tx *SetCodeTx) { tx.SetGasTipCap(sdk.NewInt(200)) }},
+		{"negative amount", func(tx *SetCodeTx) { tx.SetAmount(sdk.NewInt(-1)) }},
+		{"invalid authorization address", func(tx *SetCodeTx) { tx.Authorizations[0].Address = "0x12" }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := mockSetCodeTransaction(t, key, key)
+			tc.modify(tx)
+			require.NotNil(t, tx.Validate())
+		})
+	}
+}
@@ -358,6 +358,101 @@ func (m *BlobTxSidecar) GetProofs() [][]byte {
 	return nil
 }
 
+type SetCodeTx struct {
+	ChainID        *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	Nonce          uint64                                  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasTipCap      *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_tip_cap,omitempty"`
+	GasFeeCap      *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_fee_cap,omitempty"`
+	GasLimit       uint64                                  `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	To             string                                  `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Amount         *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value,omitempty"`
+	Data           []byte                                  `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	Accesses       AccessList                              `protobuf:"bytes,9,rep,name=accesses,proto3,castrepeated=AccessList" json:"accessList"`
+	Authorizations []SetCodeAuthorization                  `protobuf:"bytes,10,rep,name=authorizations,proto3" json:"authorizationList"`
+	// signature values
+	V []byte `protobuf:"bytes,11,opt,name=v,proto3" json:"v,omitempty"`
+	R []byte `protobuf:"bytes,12,opt,name=r,proto3" json:"r,omitempty"`
+	S []byte `protobuf:"bytes,13,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeTx) Reset()         { *m = SetCodeTx{} }
+func (m *SetCodeTx) String() string { return proto.CompactTextString(m) }
+func (*SetCodeTx) ProtoMessage()    {}
+func (*SetCodeTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa89218db340ee8, []int{7}
+}
+func (m *SetCodeTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeTx.Merge(m, src)
+}
+func (m *SetCodeTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeTx proto.InternalMessageInfo
+
+// SetCodeAuthorization is an EIP-7702 authorization to set the code of the signer
+// to a delegation to address
+type SetCodeAuthorization struct {
+	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	Address string                                  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce   uint64                                  `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// signature values
+	V []byte `protobuf:"bytes,4,opt,name=v,proto3" json:"v,omitempty"`
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeAuthorization) Reset()         { *m = SetCodeAuthorization{} }
+func (m *SetCodeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SetCodeAuthorization) ProtoMessage()    {}
+func (*SetCodeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aa89218db340ee8, []int{8}
+}
+func (m *SetCodeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeAuthorization.Merge(m, src)
+}
+func (m *SetCodeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeAuthorization proto.InternalMessageInfo
+
 type ExtensionOptionsEthereumTx struct {
 }
 
@@ -365,7 +460,7 @@ func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthe
 func (m *ExtensionOptionsEthereumTx) String() string { return proto.CompactTextString(m) }
 func (*ExtensionOptionsEthereumTx) ProtoMessage()    {}
 func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5aa89218db340ee8, []int{7}
+	return fileDescriptor_5aa89218db340ee8, []int{9}
 }
 func (m *ExtensionOptionsEthereumTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,63 +497,70 @@ func init() {
 	proto.RegisterType((*DynamicFeeTx)(nil), "kiichain.kiichain3.eth.DynamicFeeTx")
 	proto.RegisterType((*BlobTx)(nil), "kiichain.kiichain3.eth.BlobTx")
 	proto.RegisterType((*BlobTxSidecar)(nil), "kiichain.kiichain3.eth.BlobTxSidecar")
+	proto.RegisterType((*SetCodeTx)(nil), "kiichain.kiichain3.eth.SetCodeTx")
+	proto.RegisterType((*SetCodeAuthorization)(nil), "kiichain.kiichain3.eth.SetCodeAuthorization")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "kiichain.kiichain3.eth.ExtensionOptionsEthereumTx")
 }
 
 func init() { proto.RegisterFile("eth/tx.proto", fileDescriptor_5aa89218db340ee8) }
 
 var fileDescriptor_5aa89218db340ee8 = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x8e, 0xe3, 0x44,
-	0x14, 0xcd, 0xc3, 0x79, 0x5d, 0xbb, 0x7b, 0xa4, 0xd2, 0x68, 0x64, 0x0d, 0x52, 0x1c, 0x05, 0x0d,
-	0x8a, 0x90, 0x88, 0xa5, 0x6e, 0x56, 0x6c, 0xa0, 0x33, 0x0f, 0xe8, 0x21, 0x08, 0x64, 0xb2, 0x82,
-	0x45, 0x54, 0xb1, 0x6b, 0xec, 0x52, 0xc7, 0x2e, 0xcb, 0x55, 0x89, 0x9c, 0x3f, 0x60, 0xc9, 0x27,
-	0xb0, 0x62, 0xc1, 0x1f, 0xf0, 0x07, 0xb3, 0x9c, 0x25, 0x62, 0x11, 0x50, 0xb2, 0xcb, 0x57, 0xa0,
-	0xaa, 0x72, 0x32, 0xb1, 0xa6, 0x5b, 0xa2, 0x5b, 0x8d, 0x58, 0xc0, 0x2a, 0xf7, 0x94, 0xab, 0xce,
-	0xbd, 0x3e, 0xe7, 0x38, 0x2a, 0xb0, 0x88, 0x88, 0x5c, 0x91, 0x0f, 0xd3, 0x8c, 0x09, 0x86, 0x1e,
-	0x5d, 0x51, 0xea, 0x47, 0x98, 0x26, 0xc3, 0x7d, 0x71, 0x3e, 0x24, 0x22, 0x7a, 0xfc, 0x30, 0x64,
-	0x21, 0x53, 0x5b, 0x5c, 0x59, 0xe9, 0xdd, 0x7d, 0x0c, 0xe6, 0x85, 0xef, 0x13, 0xce, 0x27, 0x8b,
-	0x74, 0x4e, 0x90, 0x0d, 0x2d, 0x1c, 0x04, 0x19, 0xe1, 0xdc, 0xae, 0xf6, 0xaa, 0x83, 0x8e, 0xb7,
-	0x87, 0xe8, 0x0c, 0x2c, 0x2e, 0x58, 0x86, 0x43, 0x32, 0xbd, 0x22, 0x2b, 0x6e, 0xd7, 0x7a, 0xf5,
-	0x41, 0x67, 0xf4, 0x60, 0xb7, 0x76, 0xcc, 0x62, 0xfd, 0x4b, 0xb2, 0xe2, 0xde, 0x31, 0xf8, 0xc4,
-	0xf8, 0xe1, 0x27, 0xa7, 0xd2, 0x0f, 0xc0, 0xbc, 0xe0, 0x9c, 0xf9, 0x14, 0x0b, 0x32, 0xc9, 0x91,
-	0x05, 0xd5, 0xa5, 0x22, 0xb7, 0xbc, 0xea, 0x52, 0xa2, 0xcc, 0xae, 0x69, 0x94, 0x49, 0xc4, 0xed,
-	0xba, 0x46, 0x1c, 0x3d, 0x81, 0x53, 0x7f, 0xc1, 0x05, 0x8b, 0xa7, 0x31, 0xe1, 0x1c, 0x87, 0xc4,
-	0x36, 0xd4, 0x4c, 0x27, 0x7a, 0xf5, 0x2b, 0xbd, 0x58, 0x74, 0xf9, 0xb9, 0x06, 0xed, 0x31, 0x09,
-	0xb1, 0xbf, 0x9a, 0xe4, 0xe8, 0x21, 0x34, 0x12, 0x96, 0xf8, 0x44, 0xf5, 0x31, 0x3c, 0x0d, 0xd0,
-	0xe7, 0xd0, 0x09, 0x31, 0x9f, 0xa6, 0x19, 0xf5, 0x89, 0xea, 0xd9, 0x19, 0x7d, 0xf8, 0xfb, 0xda,
-	0xf9, 0x20, 0xa4, 0x22, 0x5a, 0xcc, 0x86, 0x3e, 0x8b, 0x5d, 0x9f, 0xf1, 0x98, 0xf1, 0xe2, 0xe7,
-	0x23, 0x1e, 0x5c, 0xb9, 0x62, 0x95, 0x12, 0x3e, 0xbc, 0x4c, 0x84, 0xd7, 0x0e, 0x31, 0xff, 0x46,
-	0x9e, 0x45, 0xef, 0x69, 0xa2, 0x39, 0x8d, 0xa9, 0x50, 0xe3, 0x1a, 0xea, 0xe1, 0x58, 0x62, 0x74,
-	0x0a, 0x35, 0xc1, 0x8a, 0x49, 0x6b, 0x82, 0xa1, 0x97, 0xd0, 0x58, 0xe2, 0xf9, 0x82, 0xd8, 0x0d,
-	0xd5, 0xf1, 0xe3, 0xbf, 0xdf, 0x71, 0xb3, 0x76, 0x9a, 0x17, 0x31, 0x5b, 0x24, 0xc2, 0xd3, 0x14,
-	0x08, 0x81, 0x11, 0x60, 0x81, 0xed, 0xa6, 0x92, 0x48, 0xd5, 0x5a, 0xcf, 0x56, 0x49, 0xcf, 0x76,
-	0x49, 0xcf, 0x4e, 0xa1, 0x67, 0x21, 0xd4, 0xae, 0x0e, 0x96, 0xb6, 0x7c, 0x4c, 0xb9, 0x98, 0xe4,
-	0xe8, 0x7b, 0x68, 0xab, 0x98, 0x4c, 0x69, 0xa0, 0x4d, 0x1f, 0x7d, 0x76, 0xab, 0x19, 0x5b, 0x4f,
-	0xe5, 0xe9, 0xcb, 0x67, 0xbb, 0xb5, 0xd3, 0xf2, 0x75, 0xe9, 0x15, 0x45, 0xf0, 0xd6, 0x89, 0xda,
-	0x8d, 0x4e, 0xd4, 0xef, 0xcb, 0x09, 0xe3, 0x5a, 0x27, 0x1a, 0xef, 0x3a, 0xd1, 0xbc, 0x3f, 0x27,
-	0x5a, 0x47, 0x4e, 0x60, 0x68, 0x63, 0x25, 0x2c, 0xe1, 0x76, 0xbb, 0x57, 0x1f, 0x98, 0x67, 0xef,
-	0x0f, 0xaf, 0xff, 0x18, 0x87, 0x47, 0xdf, 0xdc, 0xa8, 0xf7, 0x7a, 0xed, 0x54, 0x76, 0x6b, 0x07,
-	0xf0, 0xc1, 0x95, 0x5f, 0xfe, 0x70, 0xe0, 0xad, 0x47, 0xde, 0x81, 0x56, 0x9b, 0xdd, 0x29, 0x99,
-	0x0d, 0x25, 0xb3, 0xcd, 0xb2, 0xd9, 0xbf, 0x1a, 0x60, 0x3d, 0x5b, 0x25, 0x38, 0xa6, 0xfe, 0x0b,
-	0x42, 0xfe, 0x1d, 0xb3, 0x5f, 0x82, 0x29, 0x3d, 0x12, 0x34, 0x9d, 0xfa, 0x38, 0xbd, 0x83, 0xdd,
-	0xd2, 0xe2, 0x09, 0x4d, 0x9f, 0xe2, 0x74, 0xcf, 0xf5, 0x8a, 0x10, 0xc5, 0x65, 0xdc, 0x89, 0xeb,
-	0x05, 0x21, 0x92, 0xab, 0x94, 0x9d, 0xc6, 0xb5, 0xd9, 0x69, 0xbe, 0x9b, 0x9d, 0xd6, 0xfd, 0x65,
-	0xa7, 0x7d, 0x43, 0x76, 0x3a, 0xff, 0x60, 0x76, 0xa0, 0x94, 0x1d, 0xb3, 0x94, 0x1d, 0xab, 0x9c,
-	0x9d, 0x6d, 0x03, 0x9a, 0xa3, 0x39, 0x9b, 0xfd, 0x9f, 0x9a, 0xff, 0x72, 0x6a, 0xc6, 0x60, 0xcd,
-	0xe6, 0x6c, 0x76, 0x10, 0x0f, 0x6e, 0x2d, 0x1e, 0xc8, 0xf3, 0x85, 0x7a, 0x0e, 0x98, 0x8a, 0x2d,
-	0xc2, 0x3c, 0x22, 0xf2, 0xdf, 0xaa, 0x3e, 0xb0, 0xf4, 0x86, 0x2f, 0xd4, 0x0a, 0xfa, 0x14, 0x5a,
-	0x9c, 0x06, 0xc4, 0xc7, 0x99, 0x8a, 0xa3, 0x79, 0xf6, 0xe4, 0xa6, 0x17, 0xd2, 0xd1, 0xfc, 0x56,
-	0x6f, 0xf6, 0xf6, 0xa7, 0x74, 0xca, 0x4f, 0x4a, 0x29, 0x3f, 0x2d, 0xa5, 0xfc, 0x41, 0x39, 0xe5,
-	0x53, 0x38, 0x29, 0x31, 0xc9, 0x38, 0xca, 0x79, 0xe4, 0x05, 0x48, 0x0e, 0xa7, 0x01, 0xea, 0x81,
-	0xe9, 0xb3, 0x38, 0xa6, 0x22, 0x26, 0x89, 0xd0, 0xb7, 0x1f, 0xcb, 0x3b, 0x5e, 0x42, 0x8f, 0xa0,
-	0x99, 0x66, 0x8c, 0xbd, 0x92, 0x17, 0x18, 0xf9, 0xb0, 0x40, 0xfd, 0x3e, 0x3c, 0x7e, 0x9e, 0x0b,
-	0x92, 0x70, 0xca, 0x92, 0xaf, 0x53, 0x41, 0x59, 0xc2, 0x9f, 0x8b, 0x88, 0x64, 0x64, 0x11, 0x4f,
-	0x72, 0x3d, 0xc4, 0xe8, 0xf2, 0xf5, 0xa6, 0x5b, 0x7d, 0xb3, 0xe9, 0x56, 0xff, 0xdc, 0x74, 0xab,
-	0x3f, 0x6e, 0xbb, 0x95, 0x37, 0xdb, 0x6e, 0xe5, 0xb7, 0x6d, 0xb7, 0xf2, 0x9d, 0x7b, 0x24, 0xf2,
-	0xfe, 0xfd, 0x0f, 0xc5, 0xb9, 0x9b, 0xbb, 0x64, 0x19, 0x6b, 0xad, 0x5d, 0x22, 0x22, 0x91, 0xcf,
-	0x9a, 0xea, 0x5e, 0x77, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xcd, 0x0a, 0x5e, 0x15,
-	0x0a, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x9f, 0xf5, 0xbf, 0xb7, 0x9b, 0x54, 0xac, 0xa2, 0x6a, 0x29, 0x92, 0xd7, 0x0a, 0x2a,
+	0x8a, 0x10, 0xd8, 0x52, 0xca, 0x89, 0x0b, 0xc4, 0x69, 0x4b, 0x5b, 0x82, 0x40, 0x5b, 0x9f, 0xe0,
+	0x60, 0x8d, 0x77, 0x5f, 0xbd, 0xa3, 0x78, 0x77, 0x56, 0x3b, 0xe3, 0xc8, 0xe6, 0x13, 0x70, 0xe4,
+	0x23, 0x70, 0x02, 0x89, 0x6f, 0xc0, 0x37, 0xe8, 0xb1, 0x12, 0x17, 0xc4, 0xc1, 0xa0, 0xe4, 0x96,
+	0x4f, 0x81, 0x66, 0x66, 0x9d, 0xec, 0xd0, 0x58, 0xa2, 0x55, 0x2a, 0x0e, 0xcd, 0xc9, 0xef, 0x8d,
+	0x67, 0x7e, 0xef, 0xcf, 0xef, 0xf7, 0x56, 0x33, 0xe0, 0xa0, 0x88, 0x07, 0x62, 0xd1, 0xcf, 0x72,
+	0x26, 0x98, 0x7b, 0xfb, 0x98, 0xd2, 0x30, 0x26, 0x34, 0xed, 0xaf, 0x8d, 0x7b, 0x7d, 0x14, 0xf1,
+	0x9d, 0x9d, 0x29, 0x9b, 0x32, 0xb5, 0x65, 0x20, 0x2d, 0xbd, 0x7b, 0x97, 0x80, 0x7d, 0x10, 0x86,
+	0xc8, 0xf9, 0x68, 0x9e, 0xcd, 0xd0, 0xf5, 0xa0, 0x45, 0xa2, 0x28, 0x47, 0xce, 0xbd, 0x6a, 0xaf,
+	0xba, 0xd7, 0x09, 0xd6, 0xae, 0xbb, 0x0f, 0x0e, 0x17, 0x2c, 0x27, 0x53, 0x1c, 0x1f, 0xe3, 0x92,
+	0x7b, 0xb5, 0x5e, 0x7d, 0xaf, 0x33, 0xbc, 0x75, 0xbe, 0xf2, 0xed, 0x62, 0xfd, 0x4b, 0x5c, 0xf2,
+	0xa0, 0xec, 0x7c, 0x6a, 0xfd, 0xf0, 0x93, 0x5f, 0xd9, 0x8d, 0xc0, 0x3e, 0xe0, 0x9c, 0x85, 0x94,
+	0x08, 0x1c, 0x2d, 0x5c, 0x07, 0xaa, 0x27, 0x0a, 0xdc, 0x09, 0xaa, 0x27, 0xd2, 0xcb, 0xbd, 0x9a,
+	0xf6, 0x72, 0xe9, 0x71, 0xaf, 0xae, 0x3d, 0xee, 0xde, 0x85, 0xed, 0x70, 0xce, 0x05, 0x4b, 0xc6,
+	0x09, 0x72, 0x4e, 0xa6, 0xe8, 0x59, 0x2a, 0xa7, 0x2d, 0xbd, 0xfa, 0x95, 0x5e, 0x2c, 0xa2, 0xfc,
+	0x5c, 0x83, 0xf6, 0x11, 0x4e, 0x49, 0xb8, 0x1c, 0x2d, 0xdc, 0x1d, 0x68, 0xa4, 0x2c, 0x0d, 0x51,
+	0xc5, 0xb1, 0x02, 0xed, 0xb8, 0x5f, 0x40, 0x67, 0x4a, 0xf8, 0x38, 0xcb, 0x69, 0x88, 0x2a, 0x66,
+	0x67, 0xf8, 0xe1, 0x9f, 0x2b, 0xff, 0x83, 0x29, 0x15, 0xf1, 0x7c, 0xd2, 0x0f, 0x59, 0x32, 0x08,
+	0x19, 0x4f, 0x18, 0x2f, 0x7e, 0x3e, 0xe6, 0xd1, 0xf1, 0x40, 0x2c, 0x33, 0xe4, 0xfd, 0xc7, 0xa9,
+	0x08, 0xda, 0x53, 0xc2, 0xbf, 0x91, 0x67, 0xdd, 0xf7, 0x34, 0xd0, 0x8c, 0x26, 0x54, 0xa8, 0x74,
+	0x2d, 0xf5, 0xe7, 0x91, 0xf4, 0xdd, 0x6d, 0xa8, 0x09, 0x56, 0x64, 0x5a, 0x13, 0xcc, 0x7d, 0x02,
+	0x8d, 0x13, 0x32, 0x9b, 0xa3, 0xd7, 0x50, 0x11, 0x3f, 0xf9, 0xef, 0x11, 0x4f, 0x57, 0x7e, 0xf3,
+	0x20, 0x61, 0xf3, 0x54, 0x04, 0x1a, 0xc2, 0x75, 0xc1, 0x8a, 0x88, 0x20, 0x5e, 0x53, 0xb5, 0x48,
+	0xd9, 0xba, 0x9f, 0x2d, 0xa3, 0x9f, 0x6d, 0xa3, 0x9f, 0x1d, 0xed, 0xad, 0xe9, 0x38, 0xaf, 0x83,
+	0xa3, 0x29, 0x3f, 0xa2, 0x5c, 0x8c, 0x16, 0xee, 0x77, 0xd0, 0x56, 0x32, 0x19, 0xd3, 0x48, 0x93,
+	0x3e, 0xfc, 0xfc, 0x95, 0x72, 0x6c, 0x1d, 0xca, 0xd3, 0x8f, 0xef, 0x9f, 0xaf, 0xfc, 0x56, 0xa8,
+	0xcd, 0xa0, 0x30, 0xa2, 0x4b, 0x26, 0x6a, 0x1b, 0x99, 0xa8, 0x5f, 0x17, 0x13, 0xd6, 0x95, 0x4c,
+	0x34, 0x5e, 0x66, 0xa2, 0x79, 0x7d, 0x4c, 0xb4, 0x4a, 0x4c, 0x10, 0x68, 0x13, 0xd5, 0x58, 0xe4,
+	0x5e, 0xbb, 0x57, 0xdf, 0xb3, 0xf7, 0xdf, 0xef, 0x5f, 0x3d, 0x8c, 0xfd, 0xd2, 0xcc, 0x0d, 0x7b,
+	0xcf, 0x57, 0x7e, 0xe5, 0x7c, 0xe5, 0x03, 0xb9, 0x60, 0xe5, 0xd7, 0xbf, 0x7c, 0xb8, 0xe4, 0x28,
+	0xb8, 0x80, 0xd5, 0x64, 0x77, 0x0c, 0xb2, 0xc1, 0x20, 0xdb, 0x36, 0xc9, 0xfe, 0xcd, 0x02, 0xe7,
+	0xfe, 0x32, 0x25, 0x09, 0x0d, 0x1f, 0x22, 0xfe, 0x3f, 0x64, 0x3f, 0x01, 0x5b, 0x72, 0x24, 0x68,
+	0x36, 0x0e, 0x49, 0xf6, 0x1a, 0x74, 0x4b, 0x8a, 0x47, 0x34, 0x3b, 0x24, 0xd9, 0x1a, 0xeb, 0x19,
+	0xa2, 0xc2, 0xb2, 0x5e, 0x0b, 0xeb, 0x21, 0xa2, 0xc4, 0x32, 0xb4, 0xd3, 0xb8, 0x52, 0x3b, 0xcd,
+	0x97, 0xb5, 0xd3, 0xba, 0x3e, 0xed, 0xb4, 0x37, 0x68, 0xa7, 0xf3, 0x06, 0xb5, 0x03, 0x86, 0x76,
+	0x6c, 0x43, 0x3b, 0x8e, 0xa9, 0x9d, 0xb3, 0x06, 0x34, 0x87, 0x33, 0x36, 0xb9, 0x51, 0xcd, 0xdb,
+	0xac, 0x9a, 0x23, 0x70, 0x26, 0x33, 0x36, 0xb9, 0x68, 0x1e, 0xbc, 0x72, 0xf3, 0x40, 0x9e, 0x2f,
+	0xba, 0xe7, 0x83, 0xad, 0xd0, 0x62, 0xc2, 0x63, 0x94, 0x5f, 0xab, 0xfa, 0x9e, 0xa3, 0x37, 0x3c,
+	0x52, 0x2b, 0xee, 0x67, 0xd0, 0xe2, 0x34, 0xc2, 0x90, 0xe4, 0x4a, 0x8e, 0xf6, 0xfe, 0xdd, 0x4d,
+	0x05, 0x69, 0x69, 0x3e, 0xd5, 0x9b, 0x83, 0xf5, 0x29, 0xad, 0xf2, 0x2d, 0x43, 0xe5, 0xdb, 0x86,
+	0xca, 0x6f, 0x99, 0x2a, 0x1f, 0xc3, 0x96, 0x81, 0x24, 0xe5, 0x28, 0xf3, 0x91, 0x17, 0x20, 0x99,
+	0x9c, 0x76, 0xdc, 0x1e, 0xd8, 0x21, 0x4b, 0x12, 0x2a, 0x12, 0x4c, 0x85, 0xbe, 0xfd, 0x38, 0x41,
+	0x79, 0xc9, 0xbd, 0x0d, 0xcd, 0x2c, 0x67, 0xec, 0x99, 0xbc, 0xc0, 0xc8, 0x3f, 0x0b, 0x6f, 0xf7,
+	0x97, 0x06, 0x74, 0x9e, 0xa2, 0x38, 0x64, 0xd1, 0xcd, 0xf7, 0xf7, 0xad, 0x9e, 0x24, 0x06, 0xdb,
+	0x64, 0x2e, 0x62, 0x96, 0xd3, 0xef, 0x89, 0xa0, 0x2c, 0xe5, 0x1e, 0xa8, 0x40, 0x1f, 0x6d, 0x0a,
+	0x54, 0xa8, 0xe6, 0xa0, 0x7c, 0x68, 0xf8, 0x6e, 0x11, 0xf1, 0x1d, 0x03, 0x4b, 0x85, 0xfa, 0x17,
+	0xbc, 0x1e, 0x05, 0xdb, 0x18, 0x05, 0xc7, 0x18, 0x85, 0x2d, 0x73, 0x14, 0x7e, 0xaf, 0xc2, 0xce,
+	0x55, 0x31, 0xdf, 0xac, 0x68, 0x4b, 0x4f, 0x8e, 0x9a, 0xf9, 0xe4, 0xb8, 0x90, 0x73, 0xbd, 0x2c,
+	0x67, 0x55, 0x95, 0x65, 0x54, 0xd5, 0x30, 0xaa, 0x6a, 0x9a, 0x55, 0xed, 0xc2, 0x9d, 0x07, 0x0b,
+	0x81, 0x29, 0xa7, 0x2c, 0xfd, 0x3a, 0x53, 0x9d, 0x79, 0x20, 0x62, 0xcc, 0x71, 0x9e, 0x8c, 0x16,
+	0x7a, 0xcf, 0xf0, 0xd1, 0xf3, 0xd3, 0x6e, 0xf5, 0xc5, 0x69, 0xb7, 0xfa, 0xf7, 0x69, 0xb7, 0xfa,
+	0xe3, 0x59, 0xb7, 0xf2, 0xe2, 0xac, 0x5b, 0xf9, 0xe3, 0xac, 0x5b, 0xf9, 0xb6, 0x5f, 0x2a, 0x72,
+	0xcd, 0xce, 0xa5, 0xb1, 0x18, 0xe0, 0x49, 0xa2, 0x4b, 0x1d, 0xa0, 0x88, 0xc5, 0x62, 0xd2, 0x54,
+	0xcf, 0xaa, 0x7b, 0xff, 0x0c, 0x00, 0x3c, 0xb8, 0x41, 0x97, 0x94, 0x0d, 0x00, 0x00,
 }
 
 func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
@@ -1104,6 +1206,218 @@ func (m *BlobTxSidecar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetCodeTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCodeTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCodeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCodeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCodeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCodeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1433,19 +1747,114 @@ func (m *BlobTxSidecar) Size() (n int) {
 	return n
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+func (m *SetCodeTx) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCodeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *AccessTuple) Unmarshal(dAtA []byte) error {
@@ -3590,6 +3999,713 @@ func (m *BlobTxSidecar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetCodeTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCodeTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCodeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, SetCodeAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCodeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCodeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
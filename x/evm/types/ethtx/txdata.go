@@ -14,6 +14,7 @@ var (
 	_ TxData = &AccessListTx{}
 	_ TxData = &DynamicFeeTx{}
 	_ TxData = &BlobTx{}
+	_ TxData = &SetCodeTx{}
 	_ TxData = &AssociateTx{}
 )
 
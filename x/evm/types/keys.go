@@ -57,6 +57,10 @@ var (
 
 	LegacyBlockBloomCutoffHeightKey = []byte{0x1a}
 	BaseFeePerGasPrefix             = []byte{0x1b}
+	BlockBlobGasKey                 = []byte{0x1c}
+	PragueTimeKey                   = []byte{0x1d}
+	PrecompileLogsTimeKey           = []byte{0x1e}
+	BlobsTimeKey                    = []byte{0x1f}
 )
 
 var (
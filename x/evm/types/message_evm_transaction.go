@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/kiichain/kiichain/x/evm/types/ethtx"
@@ -58,6 +59,20 @@ func (msg *MsgEVMTransaction) AsTransaction() (*ethtypes.Transaction, ethtx.TxDa
 	return ethtypes.NewTx(txData.AsEthereumData()), txData
 }
 
+// Hash returns the hash the EVM transaction is known by. go-ethereum can't represent set-code
+// transactions, so their hash is computed from the set-code payload rather than from the
+// transaction AsTransaction returns.
+func (msg *MsgEVMTransaction) Hash() common.Hash {
+	etx, txData := msg.AsTransaction()
+	if sctx, ok := txData.(*ethtx.SetCodeTx); ok {
+		return sctx.Hash()
+	}
+	if etx == nil {
+		return common.Hash{}
+	}
+	return etx.Hash()
+}
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (msg *MsgEVMTransaction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(msg.Data, new(ethtx.TxData))
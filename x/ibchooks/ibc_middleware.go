@@ -0,0 +1,187 @@
+package ibchooks
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/kiichain/kiichain/x/ibchooks/keeper"
+	"github.com/kiichain/kiichain/x/ibchooks/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer application to run the contract call of an
+// incoming packet memo and to notify contracts of the outcome of the packets
+// they sent
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware returns a new IBCMiddleware wrapping app
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// ContractResultAck is the successful acknowledgement of a packet that
+// executed a hook
+type ContractResultAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IBCAck         []byte `json:"ibc_ack"`
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A transfer whose memo
+// carries a hook is credited to an intermediary account derived from the
+// channel and the original sender, which then calls the contract with the
+// received funds. Any failure results in an error acknowledgement, so core
+// IBC reverts the transfer and the funds are refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	memo, err := types.ParseMemo(data.GetMemo())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if memo == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// The receiver must be the contract, so that the sender explicitly agrees
+	// with the funds reaching it
+	if !strings.EqualFold(data.Receiver, memo.Contract()) {
+		return channeltypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(types.ErrInvalidReceiver, "receiver %s doesn't match the hook contract %s", data.Receiver, memo.Contract()),
+		)
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrInvalidPacket, "invalid amount %s", data.Amount))
+	}
+
+	intermediary := keeper.DeriveIntermediateSender(packet.GetDestChannel(), data.Sender)
+	data.Receiver = intermediary.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	funds := sdk.NewCoins(sdk.NewCoin(types.ReceivedDenom(packet, data.Denom), amount))
+	result, err := im.keeper.ExecuteHook(ctx, memo, intermediary, funds)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	bz, err := json.Marshal(ContractResultAck{ContractResult: result, IBCAck: ack.Acknowledgement()})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Once the transfer
+// application processed the acknowledgement, the contract that sent the packet
+// receives the onIbcAck callback.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	success := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+	im.keeper.OnAcknowledgement(ctx, packet.GetSourceChannel(), packet.GetSequence(), success, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Once the transfer
+// application refunded the sender, the contract that sent the packet receives
+// the onIbcTimeout callback.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeout(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	return nil
+}
@@ -0,0 +1,176 @@
+package ibchooks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/kiichain/kiichain/x/ibchooks"
+	"github.com/kiichain/kiichain/x/ibchooks/keeper"
+	"github.com/kiichain/kiichain/x/ibchooks/types"
+)
+
+// mockTransferApp records the packets it receives
+type mockTransferApp struct {
+	porttypes.IBCModule
+	received *transfertypes.FungibleTokenPacketData
+	timedOut bool
+}
+
+func (m *mockTransferApp) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	m.received = &data
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (m *mockTransferApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	m.timedOut = true
+	return nil
+}
+
+// mockChannel accepts every packet
+type mockChannel struct {
+	porttypes.ICS4Wrapper
+}
+
+func (mockChannel) SendPacket(sdk.Context, *capabilitytypes.Capability, exported.PacketI) error {
+	return nil
+}
+
+// mockVM stands in for both the EVM and the wasm keepers
+type mockVM struct {
+	wasmContracts map[string]bool
+	wasmCaller    sdk.AccAddress
+	wasmFunds     sdk.Coins
+	sudoMsg       []byte
+}
+
+func (m *mockVM) CallEVM(sdk.Context, common.Address, *common.Address, *sdk.Int, []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockVM) GetCode(sdk.Context, common.Address) []byte { return nil }
+
+func (m *mockVM) GetEVMAddressOrDefault(_ sdk.Context, addr sdk.AccAddress) common.Address {
+	return common.BytesToAddress(addr)
+}
+
+func (m *mockVM) GetKiiAddressOrDefault(_ sdk.Context, addr common.Address) sdk.AccAddress {
+	return addr[:]
+}
+
+func (m *mockVM) Execute(_ sdk.Context, _ sdk.AccAddress, caller sdk.AccAddress, _ []byte, coins sdk.Coins) ([]byte, error) {
+	m.wasmCaller, m.wasmFunds = caller, coins
+	return []byte("ok"), nil
+}
+
+func (m *mockVM) Sudo(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.sudoMsg = msg
+	return nil, nil
+}
+
+func (m *mockVM) HasContractInfo(_ sdk.Context, addr sdk.AccAddress) bool {
+	return m.wasmContracts[addr.String()]
+}
+
+func (m *mockVM) SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func setupMiddleware(t *testing.T) (sdk.Context, *mockTransferApp, *mockVM, ibchooks.IBCMiddleware, ibchooks.ICS4Middleware) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	app := &mockTransferApp{}
+	vm := &mockVM{wasmContracts: map[string]bool{}}
+	k := keeper.NewKeeper(storeKey, vm, vm, vm, vm)
+	return ctx, app, vm, ibchooks.NewIBCMiddleware(app, k), ibchooks.NewICS4Middleware(mockChannel{}, k)
+}
+
+func newTransferPacket(data transfertypes.FungibleTokenPacketData, sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-5",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+		Data:               data.GetBytes(),
+	}
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	ctx, app, vm, middleware, _ := setupMiddleware(t)
+	contract := sdk.AccAddress([]byte("wasm contract_______")).String()
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", contract)
+
+	// transfers without hooks are passed through
+	ack := middleware.OnRecvPacket(ctx, newTransferPacket(data, 1), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, contract, app.received.Receiver)
+	require.Nil(t, vm.wasmCaller)
+
+	// the receiver must be the hook contract
+	data.Memo = `{"wasm":{"contract":"` + contract + `","msg":{"swap":{}}}}`
+	data.Receiver = "kii1other"
+	ack = middleware.OnRecvPacket(ctx, newTransferPacket(data, 2), nil)
+	require.False(t, ack.Success())
+
+	// the funds are received by the intermediary, which calls the contract
+	data.Receiver = contract
+	ack = middleware.OnRecvPacket(ctx, newTransferPacket(data, 3), nil)
+	require.True(t, ack.Success())
+	intermediary := keeper.DeriveIntermediateSender("channel-0", "cosmos1sender")
+	require.Equal(t, intermediary.String(), app.received.Receiver)
+	require.Equal(t, intermediary, vm.wasmCaller)
+	denom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), vm.wasmFunds)
+
+	var result channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &result))
+	var contractAck ibchooks.ContractResultAck
+	require.NoError(t, json.Unmarshal(result.GetResult(), &contractAck))
+	require.Equal(t, []byte("ok"), contractAck.ContractResult)
+
+	// invalid hooks are rejected
+	data.Memo = `{"wasm":{"contract":"` + contract + `","msg":"swap"}}`
+	ack = middleware.OnRecvPacket(ctx, newTransferPacket(data, 4), nil)
+	require.False(t, ack.Success())
+}
+
+func TestSendPacketCallback(t *testing.T) {
+	ctx, app, vm, middleware, ics4 := setupMiddleware(t)
+	contract := sdk.AccAddress([]byte("wasm contract_______"))
+	vm.wasmContracts[contract.String()] = true
+
+	// packets sent by accounts don't register callbacks
+	data := transfertypes.NewFungibleTokenPacketData("ukii", "100", sdk.AccAddress([]byte("user")).String(), "cosmos1receiver")
+	require.NoError(t, ics4.SendPacket(ctx, nil, newTransferPacket(data, 1)))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, newTransferPacket(data, 1), nil))
+	require.True(t, app.timedOut)
+	require.Nil(t, vm.sudoMsg)
+
+	// packets sent by contracts are called back
+	data.Sender = contract.String()
+	require.NoError(t, ics4.SendPacket(ctx, nil, newTransferPacket(data, 2)))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, newTransferPacket(data, 2), nil))
+	require.JSONEq(t, `{"on_ibc_timeout":{"channel":"channel-5","sequence":2}}`, string(vm.sudoMsg))
+}
@@ -0,0 +1,64 @@
+package ibchooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/kiichain/kiichain/x/ibchooks/keeper"
+)
+
+var _ porttypes.ICS4Wrapper = ICS4Middleware{}
+
+// ICS4Middleware wraps the channel keeper used by the transfer application to
+// record the contracts sending packets, which are called back once the
+// packets are acknowledged or time out
+type ICS4Middleware struct {
+	channel porttypes.ICS4Wrapper
+	keeper  keeper.Keeper
+}
+
+// NewICS4Middleware returns a new ICS4Middleware wrapping channel
+func NewICS4Middleware(channel porttypes.ICS4Wrapper, k keeper.Keeper) ICS4Middleware {
+	return ICS4Middleware{
+		channel: channel,
+		keeper:  k,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (i ICS4Middleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	if err := i.channel.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil
+	}
+	if i.keeper.IsContract(ctx, sender) {
+		i.keeper.SetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence(), sender)
+	}
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (i ICS4Middleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (i ICS4Middleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return i.channel.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kiichain/kiichain/utils"
+	"github.com/kiichain/kiichain/x/ibchooks/types"
+)
+
+// Keeper is the ibc hooks keeper struct
+type Keeper struct {
+	storeKey       sdk.StoreKey
+	evmKeeper      types.EVMKeeper
+	wasmKeeper     types.WasmKeeper
+	wasmViewKeeper types.WasmViewKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper returns a new ibc hooks keeper
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	evmKeeper types.EVMKeeper,
+	wasmKeeper types.WasmKeeper,
+	wasmViewKeeper types.WasmViewKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		evmKeeper:      evmKeeper,
+		wasmKeeper:     wasmKeeper,
+		wasmViewKeeper: wasmViewKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// SetPacketCallback records the contract to notify once the packet sent on
+// channel with the given sequence is acknowledged or times out
+func (k Keeper) SetPacketCallback(ctx sdk.Context, channel string, sequence uint64, contract sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.PacketCallbackKey(channel, sequence), contract)
+}
+
+// GetPacketCallback returns the contract awaiting the outcome of a packet
+func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, sequence uint64) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PacketCallbackKey(channel, sequence))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// DeletePacketCallback removes the callback registered for a packet
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PacketCallbackKey(channel, sequence))
+}
+
+// GetPacketCallbackCount returns the number of packets awaiting a callback
+func (k Keeper) GetPacketCallbackCount(ctx sdk.Context) int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PacketCallbackKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// IsContract returns true if addr is a wasm contract or holds EVM code
+func (k Keeper) IsContract(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.wasmViewKeeper.HasContractInfo(ctx, addr) {
+		return true
+	}
+	return len(k.evmKeeper.GetCode(ctx, k.evmKeeper.GetEVMAddressOrDefault(ctx, addr))) > 0
+}
+
+// DeriveIntermediateSender returns the account that executes the hooks of
+// packets received on channel from sender. The original sender can't be
+// trusted on this chain, so it gets an address of its own that contracts can
+// authenticate.
+func DeriveIntermediateSender(channel, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(types.IntermediarySenderPrefix, []byte(channel+"/"+sender))[:20])
+}
+
+// ExecuteHook calls the contract of the memo from sender, forwarding funds
+// that sender already holds
+func (k Keeper) ExecuteHook(ctx sdk.Context, memo *types.Memo, sender sdk.AccAddress, funds sdk.Coins) ([]byte, error) {
+	var (
+		vm     string
+		result []byte
+		err    error
+	)
+	switch {
+	case memo.Wasm != nil:
+		vm = types.AttributeValueWasm
+		result, err = k.executeWasmHook(ctx, memo.Wasm, sender, funds)
+	case memo.EVM != nil:
+		vm = types.AttributeValueEVM
+		result, err = k.executeEVMHook(ctx, memo.EVM, sender, funds)
+	default:
+		return nil, sdkerrors.Wrap(types.ErrInvalidMemo, "empty hook")
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrHookExecution, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHookExecuted,
+			sdk.NewAttribute(types.AttributeKeyVM, vm),
+			sdk.NewAttribute(types.AttributeKeyContract, memo.Contract()),
+		),
+	)
+	return result, nil
+}
+
+// executeWasmHook executes a wasm contract, attaching funds to the call
+func (k Keeper) executeWasmHook(ctx sdk.Context, hook *types.WasmHook, sender sdk.AccAddress, funds sdk.Coins) ([]byte, error) {
+	contract, err := sdk.AccAddressFromBech32(hook.Contract)
+	if err != nil {
+		return nil, err
+	}
+	return k.wasmKeeper.Execute(ctx, contract, sender, hook.Msg, funds)
+}
+
+// executeEVMHook transfers funds to an EVM contract then calls it. Native
+// coins can't be attached as EVM value, so the contract receives them before
+// the call, the same way it would from a bank transfer.
+func (k Keeper) executeEVMHook(ctx sdk.Context, hook *types.EVMHook, sender sdk.AccAddress, funds sdk.Coins) ([]byte, error) {
+	contract := common.HexToAddress(hook.Contract)
+	if len(k.evmKeeper.GetCode(ctx, contract)) == 0 {
+		return nil, fmt.Errorf("%s is not a contract", hook.Contract)
+	}
+	data, err := hexutil.Decode(hook.Data)
+	if err != nil {
+		return nil, err
+	}
+	if !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, k.evmKeeper.GetKiiAddressOrDefault(ctx, contract), funds); err != nil {
+			return nil, err
+		}
+	}
+	value := sdk.ZeroInt()
+	return k.evmKeeper.CallEVM(ctx, k.evmKeeper.GetEVMAddressOrDefault(ctx, sender), &contract, &value, data)
+}
+
+// OnAcknowledgement delivers onIbcAck to the contract that sent the packet,
+// if any
+func (k Keeper) OnAcknowledgement(ctx sdk.Context, channel string, sequence uint64, success bool, ack []byte) {
+	contract, found := k.GetPacketCallback(ctx, channel, sequence)
+	if !found {
+		return
+	}
+	k.DeletePacketCallback(ctx, channel, sequence)
+
+	wasmMsg := types.WasmCallbackMsg{OnIbcAck: &types.OnIbcAck{Channel: channel, Sequence: sequence, Success: success, Ack: ack}}
+	k.deliverCallback(ctx, contract, channel, sequence, types.AttributeValueAck, wasmMsg, func() ([]byte, error) {
+		return types.CallbacksABI.Pack(types.OnIbcAckMethod, channel, sequence, success, ack)
+	})
+}
+
+// OnTimeout delivers onIbcTimeout to the contract that sent the packet, if any
+func (k Keeper) OnTimeout(ctx sdk.Context, channel string, sequence uint64) {
+	contract, found := k.GetPacketCallback(ctx, channel, sequence)
+	if !found {
+		return
+	}
+	k.DeletePacketCallback(ctx, channel, sequence)
+
+	wasmMsg := types.WasmCallbackMsg{OnIbcTimeout: &types.OnIbcTimeout{Channel: channel, Sequence: sequence}}
+	k.deliverCallback(ctx, contract, channel, sequence, types.AttributeValueTimeout, wasmMsg, func() ([]byte, error) {
+		return types.CallbacksABI.Pack(types.OnIbcTimeoutMethod, channel, sequence)
+	})
+}
+
+// deliverCallback calls the contract with a sudo message if it is a wasm
+// contract and with the packed EVM input otherwise. The callback can't fail
+// the packet: errors are reported in the emitted event and state changes of
+// a failed callback are discarded.
+func (k Keeper) deliverCallback(
+	ctx sdk.Context,
+	contract sdk.AccAddress,
+	channel string,
+	sequence uint64,
+	callback string,
+	wasmMsg types.WasmCallbackMsg,
+	evmInput func() ([]byte, error),
+) {
+	vm := types.AttributeValueEVM
+	if k.wasmViewKeeper.HasContractInfo(ctx, contract) {
+		vm = types.AttributeValueWasm
+	}
+
+	err := k.runCallback(ctx, func(ctx sdk.Context) error {
+		if vm == types.AttributeValueWasm {
+			msg, err := json.Marshal(wasmMsg)
+			if err != nil {
+				return err
+			}
+			_, err = k.wasmKeeper.Sudo(ctx, contract, msg)
+			return err
+		}
+
+		input, err := evmInput()
+		if err != nil {
+			return err
+		}
+		to := k.evmKeeper.GetEVMAddressOrDefault(ctx, contract)
+		from := k.evmKeeper.GetEVMAddressOrDefault(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		value := sdk.ZeroInt()
+		_, err = k.evmKeeper.CallEVM(ctx, from, &to, &value, input)
+		return err
+	})
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyVM, vm),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+		sdk.NewAttribute(types.AttributeKeyChannel, channel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallback, callback),
+	}
+	if err != nil {
+		ctx.Logger().Error("ibc hooks callback failed", "contract", contract.String(), "callback", callback, "err", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallback, attributes...))
+}
+
+// runCallback executes fn on a cached context limited to CallbackGasLimit,
+// writing its state only if it succeeds. Panics, including running out of
+// gas, are recovered and returned as errors.
+func (k Keeper) runCallback(ctx sdk.Context, fn func(sdk.Context) error) (err error) {
+	gasMeter := sdk.NewGasMeter(types.CallbackGasLimit, 1, 1)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "ibc hooks callback")
+	}()
+	defer utils.PanicHandler(func(r any) {
+		utils.LogPanicCallback(ctx, r)(r)
+		err = fmt.Errorf("callback panicked: %v", r)
+	})()
+
+	// cache the context and only write if the callback succeeds
+	cacheCtx, write := ctx.WithGasMeter(gasMeter).CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/kiichain/kiichain/x/ibchooks/keeper"
+	"github.com/kiichain/kiichain/x/ibchooks/types"
+)
+
+var stateKey = []byte("state")
+
+type mockEVMKeeper struct {
+	code   map[common.Address][]byte
+	from   common.Address
+	to     common.Address
+	input  []byte
+	result func(ctx sdk.Context) ([]byte, error)
+}
+
+func (m *mockEVMKeeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Address, _ *sdk.Int, data []byte) ([]byte, error) {
+	m.from, m.to, m.input = from, *to, data
+	if m.result != nil {
+		return m.result(ctx)
+	}
+	return []byte("evm result"), nil
+}
+
+func (m *mockEVMKeeper) GetCode(_ sdk.Context, addr common.Address) []byte {
+	return m.code[addr]
+}
+
+func (m *mockEVMKeeper) GetEVMAddressOrDefault(_ sdk.Context, kiiAddress sdk.AccAddress) common.Address {
+	return common.BytesToAddress(kiiAddress)
+}
+
+func (m *mockEVMKeeper) GetKiiAddressOrDefault(_ sdk.Context, evmAddress common.Address) sdk.AccAddress {
+	return sdk.AccAddress(evmAddress[:])
+}
+
+type mockWasmKeeper struct {
+	contracts map[string]bool
+	caller    sdk.AccAddress
+	coins     sdk.Coins
+	msg       []byte
+	result    func(ctx sdk.Context) ([]byte, error)
+}
+
+func (m *mockWasmKeeper) Execute(ctx sdk.Context, _ sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	m.caller, m.msg, m.coins = caller, msg, coins
+	if m.result != nil {
+		return m.result(ctx)
+	}
+	return []byte("wasm result"), nil
+}
+
+func (m *mockWasmKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.msg = msg
+	if m.result != nil {
+		return m.result(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockWasmKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+type mockBankKeeper struct {
+	to    sdk.AccAddress
+	coins sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoins(_ sdk.Context, _ sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	m.to, m.coins = toAddr, amt
+	return nil
+}
+
+type testSetup struct {
+	ctx      sdk.Context
+	storeKey sdk.StoreKey
+	keeper   keeper.Keeper
+	evm      *mockEVMKeeper
+	wasm     *mockWasmKeeper
+	bank     *mockBankKeeper
+}
+
+func setupKeeper(t *testing.T) testSetup {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	evm := &mockEVMKeeper{code: map[common.Address][]byte{}}
+	wasm := &mockWasmKeeper{contracts: map[string]bool{}}
+	bank := &mockBankKeeper{}
+	return testSetup{
+		ctx:      sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()),
+		storeKey: storeKey,
+		keeper:   keeper.NewKeeper(storeKey, evm, wasm, wasm, bank),
+		evm:      evm,
+		wasm:     wasm,
+		bank:     bank,
+	}
+}
+
+// callbackEvent returns the attributes of the last callback event
+func callbackEvent(t *testing.T, ctx sdk.Context) map[string]string {
+	var attributes map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCallback {
+			continue
+		}
+		attributes = map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+	}
+	require.NotNil(t, attributes, "no callback event emitted")
+	return attributes
+}
+
+func TestPacketCallbackStore(t *testing.T) {
+	s := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________"))
+
+	_, found := s.keeper.GetPacketCallback(s.ctx, "channel-0", 1)
+	require.False(t, found)
+
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 1, contract)
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 2, contract)
+	s.keeper.SetPacketCallback(s.ctx, "channel-1", 1, contract)
+	require.Equal(t, 3, s.keeper.GetPacketCallbackCount(s.ctx))
+
+	got, found := s.keeper.GetPacketCallback(s.ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, contract, got)
+
+	s.keeper.DeletePacketCallback(s.ctx, "channel-0", 1)
+	_, found = s.keeper.GetPacketCallback(s.ctx, "channel-0", 1)
+	require.False(t, found)
+	require.Equal(t, 2, s.keeper.GetPacketCallbackCount(s.ctx))
+}
+
+func TestIsContract(t *testing.T) {
+	s := setupKeeper(t)
+	wasmContract := sdk.AccAddress([]byte("wasm contract_______"))
+	evmContract := sdk.AccAddress([]byte("evm contract________"))
+	user := sdk.AccAddress([]byte("user________________"))
+	s.wasm.contracts[wasmContract.String()] = true
+	s.evm.code[common.BytesToAddress(evmContract)] = []byte{0x60}
+
+	require.True(t, s.keeper.IsContract(s.ctx, wasmContract))
+	require.True(t, s.keeper.IsContract(s.ctx, evmContract))
+	require.False(t, s.keeper.IsContract(s.ctx, user))
+}
+
+func TestDeriveIntermediateSender(t *testing.T) {
+	sender := keeper.DeriveIntermediateSender("channel-0", "cosmos1sender")
+	require.Len(t, sender, 20)
+	require.Equal(t, sender, keeper.DeriveIntermediateSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, keeper.DeriveIntermediateSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, keeper.DeriveIntermediateSender("channel-0", "cosmos1other"))
+}
+
+func TestExecuteWasmHook(t *testing.T) {
+	s := setupKeeper(t)
+	sender := keeper.DeriveIntermediateSender("channel-0", "cosmos1sender")
+	contract := sdk.AccAddress([]byte("wasm contract_______"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("ibc/ABC", 100))
+
+	memo, err := types.ParseMemo(`{"wasm":{"contract":"` + contract.String() + `","msg":{"swap":{}}}}`)
+	require.NoError(t, err)
+	result, err := s.keeper.ExecuteHook(s.ctx, memo, sender, funds)
+	require.NoError(t, err)
+	require.Equal(t, []byte("wasm result"), result)
+	require.Equal(t, sender, s.wasm.caller)
+	require.Equal(t, funds, s.wasm.coins)
+	require.JSONEq(t, `{"swap":{}}`, string(s.wasm.msg))
+
+	s.wasm.result = func(sdk.Context) ([]byte, error) { return nil, errors.New("swap failed") }
+	_, err = s.keeper.ExecuteHook(s.ctx, memo, sender, funds)
+	require.ErrorIs(t, err, types.ErrHookExecution)
+}
+
+func TestExecuteEVMHook(t *testing.T) {
+	s := setupKeeper(t)
+	sender := keeper.DeriveIntermediateSender("channel-0", "cosmos1sender")
+	contract := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("ibc/ABC", 100))
+	memo, err := types.ParseMemo(`{"evm":{"contract":"` + contract.Hex() + `","data":"0x1234"}}`)
+	require.NoError(t, err)
+
+	// the contract must have code
+	_, err = s.keeper.ExecuteHook(s.ctx, memo, sender, funds)
+	require.ErrorIs(t, err, types.ErrHookExecution)
+
+	s.evm.code[contract] = []byte{0x60}
+	result, err := s.keeper.ExecuteHook(s.ctx, memo, sender, funds)
+	require.NoError(t, err)
+	require.Equal(t, []byte("evm result"), result)
+	require.Equal(t, sdk.AccAddress(contract[:]), s.bank.to)
+	require.Equal(t, funds, s.bank.coins)
+	require.Equal(t, common.BytesToAddress(sender), s.evm.from)
+	require.Equal(t, contract, s.evm.to)
+	require.Equal(t, []byte{0x12, 0x34}, s.evm.input)
+}
+
+func TestOnAcknowledgementWasmCallback(t *testing.T) {
+	s := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("wasm contract_______"))
+	s.wasm.contracts[contract.String()] = true
+
+	// packets without a registered callback are ignored
+	s.keeper.OnAcknowledgement(s.ctx, "channel-0", 1, true, []byte("ack"))
+	require.Nil(t, s.wasm.msg)
+
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 1, contract)
+	s.keeper.OnAcknowledgement(s.ctx, "channel-0", 1, true, []byte(`{"result":"AQ=="}`))
+
+	var msg types.WasmCallbackMsg
+	require.NoError(t, json.Unmarshal(s.wasm.msg, &msg))
+	require.Nil(t, msg.OnIbcTimeout)
+	require.Equal(t, &types.OnIbcAck{Channel: "channel-0", Sequence: 1, Success: true, Ack: []byte(`{"result":"AQ=="}`)}, msg.OnIbcAck)
+
+	_, found := s.keeper.GetPacketCallback(s.ctx, "channel-0", 1)
+	require.False(t, found)
+	event := callbackEvent(t, s.ctx)
+	require.Equal(t, types.AttributeValueWasm, event[types.AttributeKeyVM])
+	require.Equal(t, types.AttributeValueAck, event[types.AttributeKeyCallback])
+	require.NotContains(t, event, types.AttributeKeyError)
+}
+
+func TestOnTimeoutEVMCallback(t *testing.T) {
+	s := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("evm contract________"))
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 7, contract)
+	s.keeper.OnTimeout(s.ctx, "channel-0", 7)
+
+	require.Equal(t, common.BytesToAddress(contract), s.evm.to)
+	method, err := types.CallbacksABI.MethodById(s.evm.input[:4])
+	require.NoError(t, err)
+	require.Equal(t, types.OnIbcTimeoutMethod, method.Name)
+	args, err := method.Inputs.Unpack(s.evm.input[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"channel-0", uint64(7)}, args)
+
+	event := callbackEvent(t, s.ctx)
+	require.Equal(t, types.AttributeValueEVM, event[types.AttributeKeyVM])
+	require.Equal(t, types.AttributeValueTimeout, event[types.AttributeKeyCallback])
+}
+
+func TestFailedCallbackIsDiscarded(t *testing.T) {
+	s := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("wasm contract_______"))
+	s.wasm.contracts[contract.String()] = true
+
+	// an error reverts the callback state changes
+	s.wasm.result = func(ctx sdk.Context) ([]byte, error) {
+		ctx.KVStore(s.storeKey).Set(stateKey, []byte{1})
+		return nil, errors.New("callback failed")
+	}
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 1, contract)
+	s.keeper.OnAcknowledgement(s.ctx, "channel-0", 1, false, []byte("ack"))
+	require.Nil(t, s.ctx.KVStore(s.storeKey).Get(stateKey))
+	require.Equal(t, "callback failed", callbackEvent(t, s.ctx)[types.AttributeKeyError])
+	_, found := s.keeper.GetPacketCallback(s.ctx, "channel-0", 1)
+	require.False(t, found)
+
+	// running out of gas is recovered and the spent gas is charged
+	s.wasm.result = func(ctx sdk.Context) ([]byte, error) {
+		ctx.KVStore(s.storeKey).Set(stateKey, []byte{1})
+		ctx.GasMeter().ConsumeGas(types.CallbackGasLimit+1, "loop")
+		return nil, nil
+	}
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
+	s.keeper.SetPacketCallback(ctx, "channel-0", 2, contract)
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
+	s.keeper.OnTimeout(ctx, "channel-0", 2)
+	require.Nil(t, ctx.KVStore(s.storeKey).Get(stateKey))
+	require.Contains(t, callbackEvent(t, ctx)[types.AttributeKeyError], "callback panicked")
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.CallbackGasLimit)
+
+	// a successful callback is written
+	s.wasm.result = func(ctx sdk.Context) ([]byte, error) {
+		ctx.KVStore(s.storeKey).Set(stateKey, []byte{1})
+		return nil, nil
+	}
+	s.keeper.SetPacketCallback(s.ctx, "channel-0", 3, contract)
+	s.keeper.OnAcknowledgement(s.ctx, "channel-0", 3, true, []byte("ack"))
+	require.Equal(t, []byte{1}, s.ctx.KVStore(s.storeKey).Get(stateKey))
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+// IIbcHooks is implemented by contracts that send ICS-20 transfers and want to
+// learn about their outcome. The callbacks are called by the x/ibchooks module
+// account, contracts should check msg.sender before trusting them.
+interface IIbcHooks {
+    // ack is the raw acknowledgement written by the receiving chain
+    function onIbcAck(
+        string memory channel,
+        uint64 sequence,
+        bool success,
+        bytes memory ack
+    ) external;
+
+    function onIbcTimeout(
+        string memory channel,
+        uint64 sequence
+    ) external;
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"bytes"
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	OnIbcAckMethod     = "onIbcAck"
+	OnIbcTimeoutMethod = "onIbcTimeout"
+)
+
+//go:embed callbacks_abi.json
+var f embed.FS
+
+// CallbacksABI is the ABI of IIbcHooks, the callbacks EVM contracts implement
+var CallbacksABI = mustGetCallbacksABI()
+
+func mustGetCallbacksABI() abi.ABI {
+	bz, err := f.ReadFile("callbacks_abi.json")
+	if err != nil {
+		panic(err)
+	}
+	newAbi, err := abi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		panic(err)
+	}
+	return newAbi
+}
+
+// WasmCallbackMsg is the sudo message delivered to wasm contracts
+type WasmCallbackMsg struct {
+	OnIbcAck     *OnIbcAck     `json:"on_ibc_ack,omitempty"`
+	OnIbcTimeout *OnIbcTimeout `json:"on_ibc_timeout,omitempty"`
+}
+
+type OnIbcAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Success  bool   `json:"success"`
+	Ack      []byte `json:"ack"`
+}
+
+type OnIbcTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// ReceivedDenom returns the local denom of the tokens credited by the
+// transfer application for a packet, following its prefixing rules
+func ReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens return to this chain, so the source prefix is removed
+		unprefixed := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
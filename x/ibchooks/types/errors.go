@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ibchooks module sentinel errors
+var (
+	ErrInvalidMemo     = sdkerrors.Register(ModuleName, 2, "invalid ibc hooks memo")
+	ErrInvalidReceiver = sdkerrors.Register(ModuleName, 3, "packet receiver must be the hook contract")
+	ErrInvalidPacket   = sdkerrors.Register(ModuleName, 4, "invalid ics20 packet")
+	ErrHookExecution   = sdkerrors.Register(ModuleName, 5, "ibc hook execution failed")
+)
@@ -0,0 +1,18 @@
+package types
+
+const (
+	EventTypeHookExecuted = "ibc_hook_executed"
+	EventTypeCallback     = "ibc_hook_callback"
+
+	AttributeKeyVM       = "vm"
+	AttributeKeyContract = "contract"
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+	AttributeKeyCallback = "callback"
+	AttributeKeyError    = "error"
+
+	AttributeValueEVM     = "evm"
+	AttributeValueWasm    = "wasm"
+	AttributeValueAck     = "ack"
+	AttributeValueTimeout = "timeout"
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMKeeper defines the EVM keeper methods used to call EVM contracts
+type EVMKeeper interface {
+	CallEVM(ctx sdk.Context, from common.Address, to *common.Address, val *sdk.Int, data []byte) ([]byte, error)
+	GetCode(ctx sdk.Context, addr common.Address) []byte
+	GetEVMAddressOrDefault(ctx sdk.Context, kiiAddress sdk.AccAddress) common.Address
+	GetKiiAddressOrDefault(ctx sdk.Context, evmAddress common.Address) sdk.AccAddress
+}
+
+// WasmKeeper defines the CosmWasm keeper methods used to call wasm contracts
+type WasmKeeper interface {
+	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// WasmViewKeeper defines the CosmWasm keeper methods used to recognize contracts
+type WasmViewKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
+
+// BankKeeper defines the bank keeper methods used to move received funds
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ibchooks"
+
+	// StoreKey defines the primary module store key. It can't be the module
+	// name as store keys must not be prefixes of each other, and "ibc" is taken.
+	StoreKey = "hooks-for-ibc"
+
+	// IntermediarySenderPrefix is hashed with the packet channel and sender to
+	// derive the account that executes contract calls for incoming packets
+	IntermediarySenderPrefix = "ibchooks-intermediary"
+
+	// CallbackGasLimit caps the gas an acknowledgement or timeout callback
+	// can spend, so that a contract can't block the packet lifecycle
+	CallbackGasLimit uint64 = 1_000_000
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	// PacketCallbackKeyPrefix is the prefix of the contracts awaiting the
+	// acknowledgement of a packet, keyed by source channel and sequence
+	PacketCallbackKeyPrefix = "callback/"
+)
+
+// PacketCallbackKey returns the store key of the callback registered for the
+// packet sent on channel with the given sequence
+func PacketCallbackKey(channel string, sequence uint64) []byte {
+	key := append(KeyPrefix(PacketCallbackKeyPrefix), []byte(channel)...)
+	key = append(key, '/')
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Memo is the part of an ICS-20 memo read by the hooks. Exactly one of EVM and
+// Wasm may be set.
+type Memo struct {
+	EVM  *EVMHook  `json:"evm,omitempty"`
+	Wasm *WasmHook `json:"wasm,omitempty"`
+}
+
+// EVMHook calls Contract with the hex encoded Data
+type EVMHook struct {
+	Contract string `json:"contract"`
+	Data     string `json:"data"`
+}
+
+// WasmHook executes Contract with the execute message Msg
+type WasmHook struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// ParseMemo extracts the hook from an ICS-20 memo. It returns nil without an
+// error when the memo isn't meant for the hooks, i.e. it isn't a JSON object
+// or has neither an `evm` nor a `wasm` key.
+func ParseMemo(memo string) (*Memo, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, nil
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &keys); err != nil {
+		return nil, nil
+	}
+	_, hasEVM := keys["evm"]
+	_, hasWasm := keys["wasm"]
+	if !hasEVM && !hasWasm {
+		return nil, nil
+	}
+	if hasEVM && hasWasm {
+		return nil, sdkerrors.Wrap(ErrInvalidMemo, "only one of evm and wasm can be set")
+	}
+
+	var m Memo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if err := m.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ValidateBasic checks the hook is well formed
+func (m Memo) ValidateBasic() error {
+	switch {
+	case m.EVM != nil:
+		if !common.IsHexAddress(m.EVM.Contract) {
+			return sdkerrors.Wrapf(ErrInvalidMemo, "invalid evm contract %s", m.EVM.Contract)
+		}
+		if _, err := hexutil.Decode(m.EVM.Data); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidMemo, "invalid evm data: %s", err)
+		}
+	case m.Wasm != nil:
+		if m.Wasm.Contract == "" {
+			return sdkerrors.Wrap(ErrInvalidMemo, "missing wasm contract")
+		}
+		if !json.Valid(m.Wasm.Msg) || !strings.HasPrefix(strings.TrimSpace(string(m.Wasm.Msg)), "{") {
+			return sdkerrors.Wrap(ErrInvalidMemo, "wasm msg must be a JSON object")
+		}
+	default:
+		return sdkerrors.Wrap(ErrInvalidMemo, "empty hook")
+	}
+	return nil
+}
+
+// Contract returns the contract targeted by the hook
+func (m Memo) Contract() string {
+	if m.EVM != nil {
+		return m.EVM.Contract
+	}
+	return m.Wasm.Contract
+}
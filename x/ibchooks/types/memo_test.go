@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/x/ibchooks/types"
+)
+
+func TestParseMemo(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		wantNil  bool
+		wantErr  bool
+		contract string
+	}{
+		{name: "empty memo", memo: "", wantNil: true},
+		{name: "plain text memo", memo: "hello", wantNil: true},
+		{name: "unrelated json memo", memo: `{"forward":{"receiver":"kii1"}}`, wantNil: true},
+		{
+			name:     "evm hook",
+			memo:     `{"evm":{"contract":"0x5FbDB2315678afecb367f032d93F642f64180aa3","data":"0x1234"}}`,
+			contract: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		},
+		{
+			name:     "wasm hook",
+			memo:     `{"wasm":{"contract":"kii14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s3p2nzy","msg":{"swap":{}}}}`,
+			contract: "kii14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s3p2nzy",
+		},
+		{name: "both hooks", memo: `{"evm":{},"wasm":{}}`, wantErr: true},
+		{name: "invalid evm contract", memo: `{"evm":{"contract":"kii1","data":"0x"}}`, wantErr: true},
+		{name: "invalid evm data", memo: `{"evm":{"contract":"0x5FbDB2315678afecb367f032d93F642f64180aa3","data":"zz"}}`, wantErr: true},
+		{name: "missing wasm contract", memo: `{"wasm":{"msg":{}}}`, wantErr: true},
+		{name: "wasm msg not an object", memo: `{"wasm":{"contract":"kii1","msg":"swap"}}`, wantErr: true},
+		{name: "empty hook", memo: `{"evm":null}`, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := types.ParseMemo(tc.memo)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidMemo)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantNil {
+				require.Nil(t, memo)
+				return
+			}
+			require.Equal(t, tc.contract, memo.Contract())
+		})
+	}
+}
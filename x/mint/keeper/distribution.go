@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain/x/mint/types"
+)
+
+// DistributeMintedCoins splits the minted coins between the recipients defined
+// by the distribution proportions params, any rounding leftover goes to staking
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, mintedCoins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	proportions := params.DistributionProportions
+
+	// Keep track of what is left to be sent to stakers
+	remaining := mintedCoins
+
+	// Burn the burn proportion
+	burnCoins := getProportions(mintedCoins, proportions.Burn)
+	if !burnCoins.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(burnCoins)
+		emitMintDistributionEvent(ctx, types.RecipientTypeBurn, types.ModuleName, burnCoins)
+	}
+
+	// Fund the community pool from the mint module account
+	communityPoolCoins := getProportions(mintedCoins, proportions.CommunityPool)
+	if !communityPoolCoins.IsZero() {
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, moduleAddress); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(communityPoolCoins)
+		emitMintDistributionEvent(ctx, types.RecipientTypeCommunityPool, types.RecipientTypeCommunityPool, communityPoolCoins)
+	}
+
+	// Pay the developer rewards receivers by weight
+	developerRewardsCoins := getProportions(mintedCoins, proportions.DeveloperRewards)
+	if !developerRewardsCoins.IsZero() {
+		for _, receiver := range params.WeightedDeveloperRewardsReceivers {
+			receiverCoins := getProportions(developerRewardsCoins, receiver.Weight)
+			if receiverCoins.IsZero() {
+				continue
+			}
+
+			receiverAddress, err := sdk.AccAddressFromBech32(receiver.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddress, receiverCoins); err != nil {
+				return err
+			}
+			remaining = remaining.Sub(receiverCoins)
+			emitMintDistributionEvent(ctx, types.RecipientTypeDeveloperRewards, receiver.Address, receiverCoins)
+		}
+	}
+
+	// Send the rest to the fee collector to be paid to stakers
+	if !remaining.IsZero() {
+		if err := k.AddCollectedFees(ctx, remaining); err != nil {
+			return err
+		}
+		emitMintDistributionEvent(ctx, types.RecipientTypeStaking, k.feeCollectorName, remaining)
+	}
+
+	return nil
+}
+
+// getProportions returns the coins multiplied by the ratio, truncated
+func getProportions(coins sdk.Coins, ratio sdk.Dec) sdk.Coins {
+	proportion, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(ratio).TruncateDecimal()
+	return proportion
+}
+
+// emitMintDistributionEvent emits the event of a single mint distribution recipient
+func emitMintDistributionEvent(ctx sdk.Context, recipientType string, recipient string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeKeyRecipientType, recipientType),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
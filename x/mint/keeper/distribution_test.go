@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/kiichain/kiichain/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistributeMintedCoins(t *testing.T) {
+	t.Parallel()
+
+	// Developer rewards receivers
+	receiverA := sdk.AccAddress([]byte("receiverA___________"))
+	receiverB := sdk.AccAddress([]byte("receiverB___________"))
+
+	t.Run("everything goes to staking by default", func(t *testing.T) {
+		app, ctx := createTestApp(false)
+		feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		balanceBefore := app.BankKeeper.GetBalance(ctx, feeCollector, "ukii")
+
+		coins := sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(1000)))
+		require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+		require.NoError(t, app.MintKeeper.DistributeMintedCoins(ctx, coins))
+
+		balanceAfter := app.BankKeeper.GetBalance(ctx, feeCollector, "ukii")
+		require.Equal(t, sdk.NewInt(1000), balanceAfter.Amount.Sub(balanceBefore.Amount))
+	})
+
+	t.Run("split between all the recipients", func(t *testing.T) {
+		app, ctx := createTestApp(false)
+
+		// Set the distribution params
+		params := types.DefaultParams()
+		params.DistributionProportions = types.DistributionProportions{
+			Staking:          sdk.NewDecWithPrec(5, 1),
+			CommunityPool:    sdk.NewDecWithPrec(2, 1),
+			DeveloperRewards: sdk.NewDecWithPrec(2, 1),
+			Burn:             sdk.NewDecWithPrec(1, 1),
+		}
+		params.WeightedDeveloperRewardsReceivers = []types.WeightedAddress{
+			{Address: receiverA.String(), Weight: sdk.NewDecWithPrec(7, 1)},
+			{Address: receiverB.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		}
+		require.NoError(t, params.Validate())
+		app.MintKeeper.SetParams(ctx, params)
+
+		// Get the balances before the mint
+		feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		feeCollectorBefore := app.BankKeeper.GetBalance(ctx, feeCollector, "ukii")
+		communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ukii")
+		supplyBefore := app.BankKeeper.GetSupply(ctx, "ukii")
+
+		// Mint and distribute, 1001 makes rounding leftovers
+		coins := sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(1001)))
+		require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+		require.NoError(t, app.MintKeeper.DistributeMintedCoins(ctx, coins))
+
+		// 100 are burned, the supply only increases by 901
+		supplyAfter := app.BankKeeper.GetSupply(ctx, "ukii")
+		require.Equal(t, sdk.NewInt(901), supplyAfter.Amount.Sub(supplyBefore.Amount))
+
+		// 200 go to the community pool
+		communityPoolAfter := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ukii")
+		require.Equal(t, sdk.NewDec(200), communityPoolAfter.Sub(communityPoolBefore))
+
+		// 200 are shared 70/30 by the developers
+		require.Equal(t, sdk.NewInt(140), app.BankKeeper.GetBalance(ctx, receiverA, "ukii").Amount)
+		require.Equal(t, sdk.NewInt(60), app.BankKeeper.GetBalance(ctx, receiverB, "ukii").Amount)
+
+		// The rest, including the leftover, goes to staking
+		feeCollectorAfter := app.BankKeeper.GetBalance(ctx, feeCollector, "ukii")
+		require.Equal(t, sdk.NewInt(501), feeCollectorAfter.Amount.Sub(feeCollectorBefore.Amount))
+
+		// Nothing is left on the mint module
+		mintModule := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+		require.True(t, app.BankKeeper.GetBalance(ctx, mintModule, "ukii").IsZero())
+
+		// One event per recipient
+		recipientTypes := []string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeMintDistribution {
+				continue
+			}
+			for _, attribute := range event.Attributes {
+				if string(attribute.Key) == types.AttributeKeyRecipientType {
+					recipientTypes = append(recipientTypes, string(attribute.Value))
+				}
+			}
+		}
+		require.Equal(t, []string{
+			types.RecipientTypeBurn,
+			types.RecipientTypeCommunityPool,
+			types.RecipientTypeDeveloperRewards,
+			types.RecipientTypeDeveloperRewards,
+			types.RecipientTypeStaking,
+		}, recipientTypes)
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochTypes "github.com/kiichain/kiichain/x/epoch/types"
+	"github.com/kiichain/kiichain/x/mint/types"
 )
 
 // BeforeEpochStart is a hook that's ran after an epoch starts
@@ -35,8 +36,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epoch epochTypes.Epoch) {
 		// The panic is captured, logged and handled on epoch hooks
 		panic(err)
 	}
-	// send the minted coins to the fee collector account
-	if err := k.AddCollectedFees(ctx, coinsToMint); err != nil {
+	// split the minted coins between the distribution recipients
+	if err := k.DistributeMintedCoins(ctx, coinsToMint); err != nil {
 		// We can panic, it's common for hooks to panic
 		// The panic is captured, logged and handled on epoch hooks
 		panic(err)
@@ -64,10 +65,18 @@ func (k Keeper) Hooks() Hooks {
 
 // Epoch hook for before epoch start
 func (h Hooks) BeforeEpochStart(ctx sdk.Context, epoch epochTypes.Epoch) {
+	// Only run on the mint epoch
+	if epoch.Identifier != types.MintEpochIdentifier {
+		return
+	}
 	h.k.BeforeEpochStart(ctx, epoch)
 }
 
 // Epoch hook for before after start
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epoch epochTypes.Epoch) {
+	// Only run on the mint epoch
+	if epoch.Identifier != types.MintEpochIdentifier {
+		return
+	}
 	h.k.AfterEpochEnd(ctx, epoch)
 }
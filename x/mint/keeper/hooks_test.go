@@ -16,6 +16,7 @@ import (
 func getEpoch(genesisTime time.Time, currTime time.Time) types.Epoch {
 	// Epochs increase every minute, so derive based on the time
 	return types.Epoch{
+		Identifier:            types.DayEpochIdentifier,
 		GenesisTime:           genesisTime,
 		EpochDuration:         time.Minute,
 		CurrentEpoch:          uint64(currTime.Sub(genesisTime).Minutes()),
@@ -60,6 +61,8 @@ func TestEndOfEpochMintedCoinDistribution(t *testing.T) {
 			"ukii",
 			tokenReleaseSchedle,
 			minttypes.DefaultInflationMax,
+			minttypes.DefaultDistributionProportions,
+			[]minttypes.WeightedAddress{},
 		)
 		kiiApp.MintKeeper.SetParams(ctx, mintParams)
 
@@ -107,6 +110,8 @@ func TestEndOfEpochMintedCoinDistribution(t *testing.T) {
 			"ukii",
 			tokenReleaseSchedle,
 			minttypes.DefaultInflationMax,
+			minttypes.DefaultDistributionProportions,
+			[]minttypes.WeightedAddress{},
 		)
 		kiiApp.MintKeeper.SetParams(ctx, mintParams)
 
@@ -169,6 +174,8 @@ func TestEndOfEpochMintedCoinDistribution(t *testing.T) {
 			"ukii",
 			tokenReleaseSchedle,
 			minttypes.DefaultInflationMax,
+			minttypes.DefaultDistributionProportions,
+			[]minttypes.WeightedAddress{},
 		)
 		kiiApp.MintKeeper.SetParams(ctx, mintParams)
 
@@ -213,6 +220,8 @@ func TestEndOfEpochMintedCoinDistribution(t *testing.T) {
 			"ukii",
 			tokenReleaseSchedule,
 			minttypes.DefaultInflationMax,
+			minttypes.DefaultDistributionProportions,
+			[]minttypes.WeightedAddress{},
 		)
 		kiiApp.MintKeeper.SetParams(ctx, mintParams)
 
@@ -324,6 +333,8 @@ func TestAfterEpochEndBadPath(t *testing.T) {
 			"ukii",
 			tokenReleaseSchedule,
 			minttypes.DefaultInflationMax,
+			minttypes.DefaultDistributionProportions,
+			[]minttypes.WeightedAddress{},
 		)
 		kiiApp.MintKeeper.SetParams(cachedCtx, mintParams)
 
@@ -260,6 +260,7 @@ func TestBaseCases(t *testing.T) {
 				mockAccountKeeper,
 				nil,
 				nil,
+				nil,
 				"invalid module",
 			)
 		})
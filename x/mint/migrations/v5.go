@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/x/mint/keeper"
+	"github.com/kiichain/kiichain/x/mint/types"
+)
+
+// V5MigrateStore apply the migration from v4 to v5 for the module
+func V5MigrateStore(ctx sdk.Context, k *keeper.Keeper) error {
+	// Keep the existing params and set the new ones with their default value
+	paramSpace := k.GetParamSpace()
+	params := types.DefaultParams()
+	paramSpace.Get(ctx, types.KeyMintDenom, &params.MintDenom)
+	paramSpace.Get(ctx, types.KeyTokenReleaseSchedule, &params.TokenReleaseSchedule)
+	paramSpace.Get(ctx, types.KeyInflationMax, &params.InflationMax)
+	k.SetParams(ctx, params)
+
+	// The mint module account needs to burn the burn proportion
+	accountKeeper := k.GetAccountKeeper()
+	moduleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if !moduleAccount.HasPermission(authtypes.Burner) {
+		baseAccount := authtypes.NewBaseAccount(
+			moduleAccount.GetAddress(),
+			moduleAccount.GetPubKey(),
+			moduleAccount.GetAccountNumber(),
+			moduleAccount.GetSequence(),
+		)
+		permissions := append(moduleAccount.GetPermissions(), authtypes.Burner)
+		accountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAccount, types.ModuleName, permissions...))
+	}
+
+	ctx.Logger().Info("Migration to v5 completed successfully")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	testkeeper "github.com/kiichain/kiichain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/kiichain/kiichain/x/mint/migrations"
+	"github.com/kiichain/kiichain/x/mint/types"
+)
+
+// TestV4toV5Migration test the v4 to v5 migration
+func TestV4toV5Migration(t *testing.T) {
+	// Get the keeper and context
+	k := testkeeper.EVMTestApp.MintKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+
+	// Set a custom inflation max to be kept
+	paramSpace := k.GetParamSpace()
+	paramSpace.Set(ctx, types.KeyInflationMax, sdk.NewDecWithPrec(5, 2))
+
+	// Remove the burner permission from the module account
+	accountKeeper := testkeeper.EVMTestApp.AccountKeeper
+	moduleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	moduleAccount.Permissions = []string{authtypes.Minter}
+	accountKeeper.SetModuleAccount(ctx, moduleAccount)
+
+	// Run the migration
+	err := migrations.V5MigrateStore(ctx, &k)
+	require.NoError(t, err)
+
+	// The old params are kept and the new ones are set
+	params := k.GetParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), params.InflationMax)
+	require.Equal(t, types.DefaultDistributionProportions, params.DistributionProportions)
+	require.Empty(t, params.WeightedDeveloperRewardsReceivers)
+
+	// The module account can now burn
+	moduleAccount = accountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	require.True(t, moduleAccount.HasPermission(authtypes.Minter))
+	require.True(t, moduleAccount.HasPermission(authtypes.Burner))
+}
@@ -2,8 +2,18 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
 
 	AttribtueMintDate  = "mint_date"
 	AttributeMintEpoch = "mint_epoch"
+
+	AttributeKeyRecipientType = "recipient_type"
+	AttributeKeyRecipient     = "recipient"
+
+	// Mint distribution recipient types
+	RecipientTypeStaking          = "staking"
+	RecipientTypeCommunityPool    = "community_pool"
+	RecipientTypeDeveloperRewards = "developer_rewards"
+	RecipientTypeBurn             = "burn"
 )
@@ -27,10 +27,16 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
+// DistributionKeeper defines the contract needed to fund the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // EpochKeeper defines the contract needed to be fulfilled for epoch keepers
 type EpochKeeper interface {
-	GetEpoch(ctx sdk.Context) epochtypes.Epoch
+	GetEpoch(ctx sdk.Context, identifier string) (epochtypes.Epoch, bool)
 }
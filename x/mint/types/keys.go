@@ -1,5 +1,7 @@
 package types
 
+import epochtypes "github.com/kiichain/kiichain/x/epoch/types"
+
 // MinterKey is the key to use for the keeper store.
 var MinterKey = []byte{0x00}
 
@@ -24,4 +26,7 @@ const (
 	// Format used for scheduling token releases
 	/*#nosec G101 Not a hard coded credential*/
 	TokenReleaseDateFormat = "2006-01-02"
+
+	// MintEpochIdentifier is the epoch identifier the mint hooks run on
+	MintEpochIdentifier = epochtypes.DayEpochIdentifier
 )
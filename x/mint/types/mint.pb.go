@@ -193,6 +193,10 @@ type Params struct {
 	TokenReleaseSchedule []ScheduledTokenRelease `protobuf:"bytes,2,rep,name=token_release_schedule,json=tokenReleaseSchedule,proto3" json:"token_release_schedule" yaml:"token_release_schedule"`
 	// Max yearly inflation rate
 	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// Proportions of each mint sent to each recipient
+	DistributionProportions DistributionProportions `protobuf:"bytes,4,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
+	// Addresses and weights that share the developer rewards
+	WeightedDeveloperRewardsReceivers []WeightedAddress `protobuf:"bytes,5,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers" yaml:"weighted_developer_rewards_receivers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -241,6 +245,111 @@ func (m *Params) GetTokenReleaseSchedule() []ScheduledTokenRelease {
 	return nil
 }
 
+func (m *Params) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *Params) GetWeightedDeveloperRewardsReceivers() []WeightedAddress {
+	if m != nil {
+		return m.WeightedDeveloperRewardsReceivers
+	}
+	return nil
+}
+
+// DistributionProportions defines the split of each mint, the proportions must sum to one
+type DistributionProportions struct {
+	// staking is the proportion sent to the fee collector and paid to stakers
+	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking" yaml:"staking"`
+	// community_pool is the proportion sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// developer_rewards is the proportion shared by the weighted developer rewards receivers
+	DeveloperRewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=developer_rewards,json=developerRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_rewards" yaml:"developer_rewards"`
+	// burn is the proportion burned right after the mint
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn" yaml:"burn"`
+}
+
+func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
+func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportions) ProtoMessage()    {}
+func (*DistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06339c129491fd39, []int{3}
+}
+func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportions.Merge(m, src)
+}
+func (m *DistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+
+// WeightedAddress is an address that receives a weight of the developer rewards
+type WeightedAddress struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06339c129491fd39, []int{4}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
+func (m *WeightedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // Minter represents the most recent
 type Version2Minter struct {
 	LastMintAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=last_mint_amount,json=lastMintAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_mint_amount" yaml:"last_mint_amount"`
@@ -253,7 +362,7 @@ func (m *Version2Minter) Reset()         { *m = Version2Minter{} }
 func (m *Version2Minter) String() string { return proto.CompactTextString(m) }
 func (*Version2Minter) ProtoMessage()    {}
 func (*Version2Minter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_06339c129491fd39, []int{3}
+	return fileDescriptor_06339c129491fd39, []int{5}
 }
 func (m *Version2Minter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +421,7 @@ func (m *Version2ScheduledTokenRelease) Reset()         { *m = Version2Scheduled
 func (m *Version2ScheduledTokenRelease) String() string { return proto.CompactTextString(m) }
 func (*Version2ScheduledTokenRelease) ProtoMessage()    {}
 func (*Version2ScheduledTokenRelease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_06339c129491fd39, []int{4}
+	return fileDescriptor_06339c129491fd39, []int{6}
 }
 func (m *Version2ScheduledTokenRelease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +475,7 @@ type Version2Params struct {
 func (m *Version2Params) Reset()      { *m = Version2Params{} }
 func (*Version2Params) ProtoMessage() {}
 func (*Version2Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_06339c129491fd39, []int{5}
+	return fileDescriptor_06339c129491fd39, []int{7}
 }
 func (m *Version2Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,6 +522,8 @@ func init() {
 	proto.RegisterType((*Minter)(nil), "kiichain.kiichain3.mint.Minter")
 	proto.RegisterType((*ScheduledTokenRelease)(nil), "kiichain.kiichain3.mint.ScheduledTokenRelease")
 	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.mint.Params")
+	proto.RegisterType((*DistributionProportions)(nil), "kiichain.kiichain3.mint.DistributionProportions")
+	proto.RegisterType((*WeightedAddress)(nil), "kiichain.kiichain3.mint.WeightedAddress")
 	proto.RegisterType((*Version2Minter)(nil), "kiichain.kiichain3.mint.Version2Minter")
 	proto.RegisterType((*Version2ScheduledTokenRelease)(nil), "kiichain.kiichain3.mint.Version2ScheduledTokenRelease")
 	proto.RegisterType((*Version2Params)(nil), "kiichain.kiichain3.mint.Version2Params")
@@ -421,47 +532,63 @@ func init() {
 func init() { proto.RegisterFile("mint/v1beta1/mint.proto", fileDescriptor_06339c129491fd39) }
 
 var fileDescriptor_06339c129491fd39 = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x24, 0x4d, 0xdb, 0xa3, 0x94, 0x62, 0x52, 0x9a, 0x82, 0x6a, 0x57, 0x27, 0xa8,
-	0xa2, 0x4a, 0xd8, 0x34, 0x91, 0x18, 0xba, 0x20, 0xac, 0x80, 0x60, 0xa8, 0x84, 0x02, 0x62, 0x60,
-	0x89, 0x2e, 0xf1, 0x91, 0x9c, 0x62, 0xdf, 0x55, 0xf6, 0x05, 0xa5, 0x33, 0x23, 0x0b, 0x12, 0x0b,
-	0x23, 0x23, 0x33, 0x12, 0xff, 0x43, 0x25, 0x96, 0x8e, 0x88, 0xc1, 0x42, 0xc9, 0x7f, 0x90, 0xbf,
-	0x00, 0xdd, 0x5d, 0x9c, 0xda, 0xa9, 0xc3, 0x0f, 0x75, 0xf2, 0xdd, 0x7b, 0x5f, 0x7f, 0xdf, 0x7b,
-	0x77, 0x1f, 0x1b, 0x6c, 0xf9, 0x84, 0x72, 0xfb, 0xed, 0x41, 0x1b, 0x73, 0x74, 0x60, 0x8b, 0x8d,
-	0x75, 0x1c, 0x30, 0xce, 0xf4, 0xad, 0x3e, 0x21, 0x9d, 0x1e, 0x22, 0xd4, 0x8a, 0x17, 0x75, 0x4b,
-	0xa4, 0x6f, 0x95, 0xbb, 0xac, 0xcb, 0xa4, 0xc6, 0x16, 0x2b, 0x25, 0x87, 0x5f, 0xf3, 0xa0, 0x74,
-	0x44, 0x28, 0xc7, 0x81, 0xbe, 0x03, 0x40, 0xc8, 0x51, 0xc0, 0x5b, 0x2e, 0xe2, 0xb8, 0xa2, 0xed,
-	0x6a, 0xd5, 0xd5, 0xe6, 0xaa, 0x8c, 0x34, 0x10, 0xc7, 0xfa, 0x36, 0x58, 0xc1, 0xd4, 0x55, 0xc9,
-	0xbc, 0x4c, 0x2e, 0x63, 0xea, 0xca, 0x54, 0x19, 0x2c, 0xb9, 0x98, 0x32, 0xbf, 0x52, 0x90, 0x71,
-	0xb5, 0xd1, 0xf7, 0xc1, 0x75, 0xce, 0x38, 0xf2, 0x5a, 0xa2, 0x7c, 0x0b, 0xf9, 0x6c, 0x40, 0x79,
-	0xa5, 0xb8, 0xab, 0x55, 0x8b, 0xcd, 0x6b, 0x32, 0x21, 0xea, 0x3e, 0x92, 0x61, 0xbd, 0x06, 0x36,
-	0x03, 0xec, 0x23, 0x42, 0x09, 0xed, 0xa6, 0xf4, 0x4b, 0x52, 0x7f, 0x63, 0x96, 0x4c, 0xbc, 0x53,
-	0x05, 0x1b, 0x1e, 0x0a, 0x79, 0x4a, 0x5e, 0x92, 0xf2, 0x75, 0x11, 0x4f, 0x28, 0xef, 0x80, 0xf5,
-	0x73, 0xa5, 0x1c, 0x60, 0x59, 0x36, 0xba, 0x16, 0xeb, 0xe4, 0x14, 0x29, 0xbf, 0x1e, 0x26, 0xdd,
-	0x1e, 0xaf, 0xac, 0xa4, 0xfd, 0x9e, 0xca, 0x28, 0x7c, 0xa7, 0x81, 0xcd, 0x17, 0x9d, 0x1e, 0x76,
-	0x07, 0x1e, 0x76, 0x5f, 0xb2, 0x3e, 0xa6, 0x4d, 0xec, 0x61, 0x14, 0xe2, 0x4b, 0x9c, 0xe1, 0x7d,
-	0x50, 0xe6, 0xc2, 0xa9, 0x15, 0x28, 0xab, 0x78, 0xa2, 0x82, 0xec, 0x40, 0xe7, 0x89, 0x2a, 0x6a,
-	0x2a, 0xf8, 0x25, 0x0f, 0x4a, 0xcf, 0x51, 0x80, 0xfc, 0x50, 0x94, 0x55, 0xb3, 0xc9, 0x5b, 0x98,
-	0x96, 0x15, 0x91, 0x86, 0xbc, 0x89, 0xf7, 0x1a, 0xb8, 0x99, 0x36, 0x0f, 0xa7, 0xdd, 0x57, 0xf2,
-	0xbb, 0x85, 0xea, 0x95, 0x9a, 0x65, 0x2d, 0xa0, 0xc6, 0xca, 0x1c, 0xd3, 0xb9, 0x7b, 0x1a, 0x99,
-	0xb9, 0x49, 0x64, 0xee, 0x9c, 0x20, 0xdf, 0x3b, 0x84, 0xd9, 0xde, 0xb0, 0x59, 0x4e, 0x76, 0x1d,
-	0x3b, 0xe9, 0x7d, 0x70, 0x95, 0xd0, 0x37, 0x1e, 0xe2, 0x84, 0xd1, 0x96, 0x8f, 0x86, 0x8a, 0x1a,
-	0xe7, 0x89, 0xf0, 0xfc, 0x19, 0x99, 0x7b, 0x5d, 0xc2, 0x7b, 0x83, 0xb6, 0xd5, 0x61, 0xbe, 0xdd,
-	0x61, 0xa1, 0xcf, 0xc2, 0xe9, 0xe3, 0x5e, 0xe8, 0xf6, 0x6d, 0x7e, 0x72, 0x8c, 0x43, 0xab, 0x81,
-	0x3b, 0x93, 0xc8, 0x2c, 0xab, 0xea, 0x29, 0x33, 0xd8, 0x5c, 0x9b, 0xed, 0x8f, 0xd0, 0xf0, 0xb0,
-	0xf8, 0xe9, 0xb3, 0x99, 0x83, 0xdf, 0xf2, 0x60, 0xfd, 0x15, 0x0e, 0x42, 0xc2, 0x68, 0x6d, 0x4a,
-	0x7b, 0x98, 0x41, 0x8f, 0x3c, 0x38, 0xe7, 0xd9, 0x7f, 0x37, 0xb2, 0xa5, 0x1a, 0x99, 0xf7, 0x83,
-	0x17, 0x40, 0x7c, 0x78, 0x01, 0x44, 0x49, 0x81, 0xb3, 0x3d, 0x89, 0xcc, 0xcd, 0x79, 0x13, 0x91,
-	0x87, 0x73, 0x8c, 0x3e, 0xce, 0x60, 0x54, 0x1c, 0x5f, 0xc1, 0xb9, 0x9d, 0xd5, 0x87, 0x52, 0xc0,
-	0x79, 0x80, 0xf5, 0xbd, 0xf8, 0x83, 0x2d, 0xca, 0xf2, 0x1b, 0x93, 0xc8, 0x5c, 0x53, 0xef, 0xca,
-	0x30, 0x9c, 0x7e, 0xc2, 0x10, 0x83, 0x9d, 0xf8, 0xd8, 0xb2, 0x79, 0xd7, 0x41, 0x31, 0x41, 0xba,
-	0x5c, 0x2f, 0x24, 0x59, 0x8c, 0x5a, 0xc8, 0x24, 0xf9, 0xbb, 0x76, 0x7e, 0x3d, 0xff, 0x46, 0xf4,
-	0xc7, 0xbf, 0x11, 0xfd, 0x60, 0x21, 0xd1, 0x7f, 0x1c, 0xe8, 0x52, 0x64, 0x2b, 0xd8, 0x9c, 0xc6,
-	0xe9, 0xc8, 0xd0, 0xce, 0x46, 0x86, 0xf6, 0x6b, 0x64, 0x68, 0x1f, 0xc6, 0x46, 0xee, 0x6c, 0x6c,
-	0xe4, 0x7e, 0x8c, 0x8d, 0xdc, 0xeb, 0xfd, 0x04, 0x51, 0x71, 0x57, 0xb3, 0x45, 0xdd, 0x1e, 0xca,
-	0xff, 0xb8, 0x22, 0xab, 0x5d, 0x92, 0xff, 0xe7, 0xfa, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac,
-	0x37, 0xc8, 0x27, 0xe9, 0x05, 0x00, 0x00,
+	// 893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xc6, 0x8e, 0xd3, 0x4c, 0x12, 0x37, 0x1d, 0x1c, 0xec, 0x82, 0x62, 0x87, 0x51, 0x29,
+	0xe6, 0xcb, 0x6e, 0x13, 0x89, 0x43, 0x25, 0x54, 0xba, 0x98, 0x4f, 0xa9, 0x52, 0x58, 0x10, 0x95,
+	0x7a, 0x59, 0x8d, 0x3d, 0x83, 0x3d, 0xf2, 0xee, 0x8c, 0x35, 0x33, 0xce, 0xc7, 0x99, 0x23, 0x17,
+	0x24, 0x38, 0xf4, 0xc8, 0x2f, 0x40, 0x02, 0x89, 0xff, 0x50, 0x89, 0x4b, 0x8f, 0x88, 0x83, 0x85,
+	0x92, 0x3f, 0x80, 0xf2, 0x0b, 0xd0, 0xce, 0xcc, 0xc6, 0x1f, 0x59, 0x97, 0x5a, 0x3d, 0x65, 0x76,
+	0xde, 0x67, 0x9f, 0xf7, 0xeb, 0xc9, 0x3e, 0x06, 0x95, 0x98, 0x71, 0xdd, 0x3a, 0xba, 0xdb, 0xa1,
+	0x1a, 0xdf, 0x6d, 0x25, 0x0f, 0xcd, 0xa1, 0x14, 0x5a, 0xc0, 0xca, 0x80, 0xb1, 0x6e, 0x1f, 0x33,
+	0xde, 0x4c, 0x0f, 0x07, 0xcd, 0x24, 0xfc, 0x5a, 0xb9, 0x27, 0x7a, 0xc2, 0x60, 0x5a, 0xc9, 0xc9,
+	0xc2, 0xd1, 0xef, 0x2b, 0xa0, 0xf8, 0x90, 0x71, 0x4d, 0x25, 0xdc, 0x05, 0x40, 0x69, 0x2c, 0x75,
+	0x48, 0xb0, 0xa6, 0x55, 0x6f, 0xcf, 0x6b, 0xac, 0x07, 0xeb, 0xe6, 0xa6, 0x8d, 0x35, 0x85, 0x37,
+	0xc1, 0x35, 0xca, 0x89, 0x0d, 0xae, 0x98, 0xe0, 0x1a, 0xe5, 0xc4, 0x84, 0xca, 0x60, 0x95, 0x50,
+	0x2e, 0xe2, 0x6a, 0xde, 0xdc, 0xdb, 0x07, 0xf8, 0x0e, 0xb8, 0xa1, 0x85, 0xc6, 0x51, 0x98, 0xa4,
+	0x0f, 0x71, 0x2c, 0x46, 0x5c, 0x57, 0x0b, 0x7b, 0x5e, 0xa3, 0x10, 0x5c, 0x37, 0x81, 0x24, 0xef,
+	0x03, 0x73, 0x0d, 0xf7, 0xc1, 0x8e, 0xa4, 0x31, 0x66, 0x9c, 0xf1, 0xde, 0x0c, 0x7e, 0xd5, 0xe0,
+	0x5f, 0xb9, 0x0c, 0x4e, 0xbd, 0xd3, 0x00, 0xdb, 0x11, 0x56, 0x7a, 0x06, 0x5e, 0x34, 0xf0, 0x52,
+	0x72, 0x3f, 0x85, 0xbc, 0x05, 0x4a, 0x13, 0xa4, 0x69, 0x60, 0xcd, 0x14, 0xba, 0x99, 0xe2, 0x4c,
+	0x17, 0x33, 0x7c, 0x7d, 0xca, 0x7a, 0x7d, 0x5d, 0xbd, 0x36, 0xcb, 0xf7, 0xb9, 0xb9, 0x45, 0xdf,
+	0x7b, 0x60, 0xe7, 0xeb, 0x6e, 0x9f, 0x92, 0x51, 0x44, 0xc9, 0x37, 0x62, 0x40, 0x79, 0x40, 0x23,
+	0x8a, 0x15, 0x7d, 0x89, 0x19, 0xde, 0x01, 0x65, 0x9d, 0x30, 0x85, 0xd2, 0x52, 0xa5, 0x1d, 0xe5,
+	0x4d, 0x05, 0x50, 0x4f, 0x65, 0xb1, 0x5d, 0xa1, 0x7f, 0x0b, 0xa0, 0x78, 0x88, 0x25, 0x8e, 0x55,
+	0x92, 0xd6, 0xf6, 0x66, 0xb6, 0xe0, 0xd2, 0x26, 0x37, 0x6d, 0xb3, 0x89, 0x1f, 0x3c, 0xf0, 0xea,
+	0x2c, 0xb9, 0x72, 0xd5, 0x57, 0x57, 0xf6, 0xf2, 0x8d, 0x8d, 0xfd, 0x66, 0x73, 0x81, 0x6a, 0x9a,
+	0x99, 0x6d, 0xfa, 0x6f, 0x3e, 0x1d, 0xd7, 0x73, 0x17, 0xe3, 0xfa, 0xee, 0x29, 0x8e, 0xa3, 0x7b,
+	0x28, 0x9b, 0x1b, 0x05, 0xe5, 0xe9, 0xaa, 0x53, 0x26, 0x38, 0x00, 0x5b, 0x8c, 0x7f, 0x17, 0x61,
+	0xcd, 0x04, 0x0f, 0x63, 0x7c, 0x62, 0x55, 0xe3, 0x7f, 0x9a, 0x70, 0xfe, 0x3d, 0xae, 0xdf, 0xee,
+	0x31, 0xdd, 0x1f, 0x75, 0x9a, 0x5d, 0x11, 0xb7, 0xba, 0x42, 0xc5, 0x42, 0xb9, 0x3f, 0xef, 0x2b,
+	0x32, 0x68, 0xe9, 0xd3, 0x21, 0x55, 0xcd, 0x36, 0xed, 0x5e, 0x8c, 0xeb, 0x65, 0x9b, 0x7d, 0x86,
+	0x0c, 0x05, 0x9b, 0x97, 0xcf, 0x0f, 0xf1, 0x09, 0xfc, 0xd9, 0x03, 0x55, 0xc2, 0x94, 0x96, 0xac,
+	0x33, 0x32, 0x98, 0xa1, 0x14, 0x43, 0x21, 0x93, 0xa3, 0x32, 0x62, 0xdc, 0xd8, 0xbf, 0xb3, 0xb0,
+	0xf9, 0xf6, 0xd4, 0x8b, 0x87, 0x93, 0xf7, 0xfc, 0xb7, 0x5c, 0xfb, 0x75, 0x5b, 0xc0, 0x22, 0x7e,
+	0x14, 0x54, 0x48, 0x36, 0x03, 0xfc, 0xd5, 0x03, 0xb7, 0x8e, 0x8d, 0x98, 0x28, 0x09, 0x09, 0x3d,
+	0xa2, 0x91, 0x18, 0x52, 0x19, 0x4a, 0x7a, 0x8c, 0x25, 0x51, 0xa1, 0xa4, 0x5d, 0xca, 0x8e, 0xa8,
+	0x54, 0xd5, 0x55, 0xb3, 0x9f, 0xc6, 0xc2, 0x12, 0x1f, 0x39, 0x92, 0x07, 0x84, 0x48, 0xaa, 0x94,
+	0x7f, 0xe0, 0x4a, 0x7b, 0xd7, 0x96, 0xf6, 0x22, 0x39, 0x50, 0xf0, 0x46, 0x0a, 0x6b, 0xa7, 0xa8,
+	0xc0, 0x82, 0x82, 0x14, 0x73, 0xaf, 0xf0, 0xe4, 0x97, 0x7a, 0x0e, 0xfd, 0x96, 0x07, 0x95, 0x05,
+	0x43, 0x81, 0x8f, 0xc1, 0x9a, 0xd2, 0x78, 0xc0, 0x78, 0xcf, 0x0a, 0xd0, 0xff, 0x68, 0xe9, 0x85,
+	0x96, 0x6c, 0xd1, 0x8e, 0x06, 0x05, 0x29, 0x21, 0xe4, 0xa0, 0xd4, 0x15, 0x71, 0x3c, 0xe2, 0x4c,
+	0x9f, 0x86, 0x43, 0x21, 0x22, 0xfb, 0xdf, 0xe3, 0x7f, 0xb6, 0x74, 0x8a, 0x1d, 0x9b, 0x62, 0x96,
+	0x0d, 0x05, 0x5b, 0x97, 0x17, 0x87, 0x42, 0x44, 0xf0, 0x18, 0xdc, 0xb8, 0x32, 0x30, 0x27, 0xd3,
+	0x2f, 0x97, 0x4e, 0x59, 0x75, 0x2a, 0x99, 0x27, 0x44, 0xc1, 0x36, 0x99, 0x9b, 0x37, 0xfc, 0x0a,
+	0x14, 0x3a, 0x23, 0xc9, 0x8d, 0x32, 0xd7, 0xfd, 0x0f, 0x97, 0xce, 0xb5, 0x61, 0x73, 0x25, 0x1c,
+	0x28, 0x30, 0x54, 0xe8, 0x89, 0x07, 0xae, 0xcf, 0xa9, 0x04, 0xbe, 0x07, 0xd6, 0xb0, 0x3d, 0xba,
+	0x5d, 0xc1, 0xc9, 0xf4, 0x5d, 0x00, 0x05, 0x29, 0x04, 0x3e, 0x02, 0x45, 0x2b, 0x10, 0x37, 0xf5,
+	0xfb, 0x4b, 0x97, 0xb5, 0x35, 0xad, 0x46, 0x14, 0x38, 0x3a, 0xf4, 0xc7, 0x0a, 0x28, 0x7d, 0x4b,
+	0xa5, 0x62, 0x82, 0xef, 0x3b, 0x13, 0x52, 0x19, 0x1f, 0x75, 0x5b, 0xe2, 0x17, 0x4b, 0x67, 0xad,
+	0xd8, 0xac, 0xf3, 0x7c, 0xe8, 0x8a, 0x3f, 0xdc, 0xbf, 0xe2, 0x0f, 0xb6, 0xd1, 0x9b, 0x13, 0xc1,
+	0xcc, 0xc6, 0xd1, 0x9c, 0x75, 0x7c, 0x92, 0x61, 0x1d, 0x89, 0x5c, 0xf2, 0xfe, 0xeb, 0x59, 0x75,
+	0xf4, 0xdd, 0x1c, 0xe6, 0x7c, 0x05, 0xde, 0x4e, 0x7d, 0xd4, 0xae, 0x7f, 0xfb, 0x62, 0x5c, 0xdf,
+	0x4c, 0xc5, 0xc3, 0x45, 0x8c, 0x9c, 0xb3, 0x22, 0x0a, 0x76, 0xd3, 0xb1, 0x65, 0xdb, 0x10, 0x04,
+	0x85, 0x29, 0x03, 0x2a, 0x90, 0xe7, 0x19, 0x4c, 0xd2, 0x6a, 0x3e, 0xd3, 0x60, 0xfe, 0xf4, 0x26,
+	0xeb, 0x79, 0x31, 0xa3, 0xf9, 0xe9, 0xff, 0x8c, 0xe6, 0x83, 0x85, 0x1f, 0xb2, 0xe7, 0x36, 0xf4,
+	0x52, 0x86, 0x63, 0xbf, 0x5d, 0xfe, 0xc7, 0x4f, 0xcf, 0x6a, 0xde, 0xb3, 0xb3, 0x9a, 0xf7, 0xcf,
+	0x59, 0xcd, 0xfb, 0xf1, 0xbc, 0x96, 0x7b, 0x76, 0x5e, 0xcb, 0xfd, 0x75, 0x5e, 0xcb, 0x3d, 0x7e,
+	0x7b, 0x4a, 0x51, 0x69, 0x55, 0x93, 0xc3, 0x89, 0xf9, 0x75, 0x65, 0x85, 0xd5, 0x29, 0x9a, 0x5f,
+	0x4d, 0x07, 0xff, 0x0d, 0x00, 0x38, 0xe6, 0x72, 0x02, 0x7f, 0x09, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -597,6 +724,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.InflationMax.Size()
 		i -= size
@@ -631,6 +782,109 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DeveloperRewards.Size()
+		i -= size
+		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Version2Minter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -848,6 +1102,46 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.InflationMax.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for _, e := range m.WeightedDeveloperRewardsReceivers {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DeveloperRewards.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -1430,6 +1724,375 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, WeightedAddress{})
+			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -17,9 +17,20 @@ var (
 	KeyTokenReleaseSchedule = []byte("TokenReleaseSchedule")
 	KeyInflationMax         = []byte("InflationMax")
 
+	KeyDistributionProportions           = []byte("DistributionProportions")
+	KeyWeightedDeveloperRewardsReceivers = []byte("WeightedDeveloperRewardsReceivers")
+
 	// Default data
 	DefaultMintDenom    = sdk.DefaultBondDenom
 	DefaultInflationMax = sdk.NewDecWithPrec(20, 2)
+
+	// By default everything goes to stakers
+	DefaultDistributionProportions = DistributionProportions{
+		Staking:          sdk.OneDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		DeveloperRewards: sdk.ZeroDec(),
+		Burn:             sdk.ZeroDec(),
+	}
 )
 
 // ParamTable for minting module.
@@ -29,20 +40,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, tokenReleaseSchedule []ScheduledTokenRelease, inflationMax sdk.Dec,
+	distributionProportions DistributionProportions, weightedDeveloperRewardsReceivers []WeightedAddress,
 ) Params {
 	return Params{
-		MintDenom:            mintDenom,
-		TokenReleaseSchedule: SortTokenReleaseCalendar(tokenReleaseSchedule),
-		InflationMax:         inflationMax,
+		MintDenom:                         mintDenom,
+		TokenReleaseSchedule:              SortTokenReleaseCalendar(tokenReleaseSchedule),
+		InflationMax:                      inflationMax,
+		DistributionProportions:           distributionProportions,
+		WeightedDeveloperRewardsReceivers: weightedDeveloperRewardsReceivers,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:            DefaultMintDenom,
-		TokenReleaseSchedule: []ScheduledTokenRelease{},
-		InflationMax:         DefaultInflationMax, // 20% per year
+		MintDenom:               DefaultMintDenom,
+		TokenReleaseSchedule:    []ScheduledTokenRelease{},
+		InflationMax:            DefaultInflationMax, // 20% per year
+		DistributionProportions: DefaultDistributionProportions,
 	}
 }
 
@@ -54,6 +69,18 @@ func (p Params) Validate() error {
 	if err := validateInflationMax(p.InflationMax); err != nil {
 		return err
 	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
+		return err
+	}
+
+	// Developer rewards must have someone to be paid to
+	if p.DistributionProportions.DeveloperRewards.IsPositive() && len(p.WeightedDeveloperRewardsReceivers) == 0 {
+		return fmt.Errorf("developer rewards proportion is set but there are no developer rewards receivers")
+	}
+
 	return ValidateTokenReleaseSchedule(p.TokenReleaseSchedule)
 }
 
@@ -74,6 +101,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
 		paramtypes.NewParamSetPair(KeyTokenReleaseSchedule, &p.TokenReleaseSchedule, ValidateTokenReleaseSchedule),
 		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
+		paramtypes.NewParamSetPair(KeyWeightedDeveloperRewardsReceivers, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 	}
 }
 
@@ -114,6 +143,76 @@ func validateInflationMax(i interface{}) error {
 	return nil
 }
 
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.(DistributionProportions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Check each proportion
+	proportions := []struct {
+		name       string
+		proportion sdk.Dec
+	}{
+		{"staking", v.Staking},
+		{"community pool", v.CommunityPool},
+		{"developer rewards", v.DeveloperRewards},
+		{"burn", v.Burn},
+	}
+	for _, p := range proportions {
+		if p.proportion.IsNil() {
+			return fmt.Errorf("%s distribution proportion cannot be nil", p.name)
+		}
+		if p.proportion.IsNegative() {
+			return fmt.Errorf("%s distribution proportion cannot be negative: %s", p.name, p.proportion)
+		}
+	}
+
+	// The proportions must cover the whole mint
+	total := v.Staking.Add(v.CommunityPool).Add(v.DeveloperRewards).Add(v.Burn)
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to 1: %s", total)
+	}
+
+	return nil
+}
+
+func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
+	v, ok := i.([]WeightedAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// An empty list is allowed while there are no developer rewards
+	if len(v) == 0 {
+		return nil
+	}
+
+	totalWeight := sdk.ZeroDec()
+	seenAddresses := make(map[string]bool)
+	for _, receiver := range v {
+		if _, err := sdk.AccAddressFromBech32(receiver.Address); err != nil {
+			return fmt.Errorf("invalid developer rewards receiver address %s: %s", receiver.Address, err)
+		}
+		if seenAddresses[receiver.Address] {
+			return fmt.Errorf("duplicated developer rewards receiver address: %s", receiver.Address)
+		}
+		seenAddresses[receiver.Address] = true
+
+		if receiver.Weight.IsNil() || !receiver.Weight.IsPositive() {
+			return fmt.Errorf("developer rewards receiver weight must be positive: %s", receiver.Weight)
+		}
+		totalWeight = totalWeight.Add(receiver.Weight)
+	}
+
+	// The weights must cover the whole developer rewards
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("developer rewards receivers weights must sum to 1: %s", totalWeight)
+	}
+
+	return nil
+}
+
 func SortTokenReleaseCalendar(tokenReleaseSchedule []ScheduledTokenRelease) []ScheduledTokenRelease {
 	sort.Slice(tokenReleaseSchedule, func(i, j int) bool {
 		startDate1, _ := time.Parse(TokenReleaseDateFormat, tokenReleaseSchedule[i].GetStartDate())
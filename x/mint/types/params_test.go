@@ -161,6 +161,10 @@ func TestValidateTokenReleaseSchedule(t *testing.T) {
 
 // Validate params
 func TestValidateParams(t *testing.T) {
+	// Developer rewards receivers
+	receiverA := sdk.AccAddress([]byte("receiverA___________")).String()
+	receiverB := sdk.AccAddress([]byte("receiverB___________")).String()
+
 	// The test cases
 	testCases := []struct {
 		name        string
@@ -173,19 +177,91 @@ func TestValidateParams(t *testing.T) {
 		},
 		{
 			name:        "Good - Bad mint denom",
-			params:      types.NewParams("test", nil, sdk.OneDec()),
+			params:      types.NewParams("test", nil, sdk.OneDec(), types.DefaultDistributionProportions, nil),
 			errContains: "mint denom must be the same as the default bond denom",
 		},
 		{
 			name:        "Good - Bad max inflation",
-			params:      types.NewParams("ukii", nil, sdk.NewDec(20)),
+			params:      types.NewParams("ukii", nil, sdk.NewDec(20), types.DefaultDistributionProportions, nil),
 			errContains: "max inflation too large",
 		},
 		{
 			name:        "Good - Bad max inflation (negative)",
-			params:      types.NewParams("ukii", nil, sdk.NewDec(-1)),
+			params:      types.NewParams("ukii", nil, sdk.NewDec(-1), types.DefaultDistributionProportions, nil),
 			errContains: "max inflation cannot be negative",
 		},
+		{
+			name: "Good - Split distribution",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				CommunityPool:    sdk.NewDecWithPrec(2, 1),
+				DeveloperRewards: sdk.NewDecWithPrec(2, 1),
+				Burn:             sdk.NewDecWithPrec(1, 1),
+			}, []types.WeightedAddress{
+				{Address: receiverA, Weight: sdk.NewDecWithPrec(7, 1)},
+				{Address: receiverB, Weight: sdk.NewDecWithPrec(3, 1)},
+			}),
+		},
+		{
+			name: "Bad - Proportions do not sum to one",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				CommunityPool:    sdk.NewDecWithPrec(2, 1),
+				DeveloperRewards: sdk.ZeroDec(),
+				Burn:             sdk.ZeroDec(),
+			}, nil),
+			errContains: "distribution proportions must sum to 1",
+		},
+		{
+			name: "Bad - Negative proportion",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(12, 1),
+				CommunityPool:    sdk.ZeroDec(),
+				DeveloperRewards: sdk.ZeroDec(),
+				Burn:             sdk.NewDecWithPrec(-2, 1),
+			}, nil),
+			errContains: "burn distribution proportion cannot be negative",
+		},
+		{
+			name: "Bad - Developer rewards without receivers",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DistributionProportions{
+				Staking:          sdk.NewDecWithPrec(5, 1),
+				CommunityPool:    sdk.ZeroDec(),
+				DeveloperRewards: sdk.NewDecWithPrec(5, 1),
+				Burn:             sdk.ZeroDec(),
+			}, nil),
+			errContains: "there are no developer rewards receivers",
+		},
+		{
+			name: "Bad - Receivers weights do not sum to one",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DefaultDistributionProportions, []types.WeightedAddress{
+				{Address: receiverA, Weight: sdk.NewDecWithPrec(7, 1)},
+			}),
+			errContains: "developer rewards receivers weights must sum to 1",
+		},
+		{
+			name: "Bad - Invalid receiver address",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DefaultDistributionProportions, []types.WeightedAddress{
+				{Address: "invalid", Weight: sdk.OneDec()},
+			}),
+			errContains: "invalid developer rewards receiver address",
+		},
+		{
+			name: "Bad - Duplicated receiver address",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DefaultDistributionProportions, []types.WeightedAddress{
+				{Address: receiverA, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: receiverA, Weight: sdk.NewDecWithPrec(5, 1)},
+			}),
+			errContains: "duplicated developer rewards receiver address",
+		},
+		{
+			name: "Bad - Zero receiver weight",
+			params: types.NewParams("ukii", nil, sdk.OneDec(), types.DefaultDistributionProportions, []types.WeightedAddress{
+				{Address: receiverA, Weight: sdk.OneDec()},
+				{Address: receiverB, Weight: sdk.ZeroDec()},
+			}),
+			errContains: "developer rewards receiver weight must be positive",
+		},
 	}
 
 	// Run the tests
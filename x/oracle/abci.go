@@ -9,6 +9,13 @@ import (
 	"github.com/kiichain/kiichain/x/oracle/utils"
 )
 
+// BeginBlocker is the function executed at the beginning of each block, it records the balance
+// of the fee collector once the mint proceeds and the previous fees were handled, so only the
+// fees collected by this block are shared with the reward pool
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SetFeeCollectorSnapshot(ctx)
+}
+
 // MidBlocker is the function executed when each block has been completed
 // this function get the votes from the validators, calculate the exchange rate using
 // weighted median logic when the vote period is almost finished
@@ -103,6 +110,9 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
 		}
 
+		// Pay the reward pool share of this vote period to the ballot winners
+		k.RewardBallotWinners(ctx, params.VotePeriod, params.RewardDistributionWindow, validatorClaimMap)
+
 		// Validate miss voting process
 		for _, claim := range validatorClaimMap {
 			if int(claim.WinCount) == len(voteTargets) {
@@ -110,6 +120,12 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				continue
 			}
 
+			// A vote that did not match its prevote counts as a miss
+			if k.HasRevealMismatch(ctx, claim.Recipient) {
+				k.IncrementMissCount(ctx, claim.Recipient)
+				continue
+			}
+
 			if !claim.DidVote {
 				k.IncrementAbstainCount(ctx, claim.Recipient)
 				continue
@@ -119,7 +135,7 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		// Clear the ballot
-		k.ClearBallots(ctx)
+		k.ClearBallots(ctx, params.VotePeriod)
 
 		// Update vote target
 		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
@@ -147,10 +163,15 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
-// Endblocker is the function that slash the validators and reset the miss counters
+// Endblocker is the function that funds the reward pool, slash the validators and reset the miss counters
 func Endblocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 
+	// Send the share of the fees collected on this block to the reward pool
+	if err := k.FundRewardPoolFromFees(ctx); err != nil {
+		k.Logger(ctx).Error("failed to fund the oracle reward pool", "error", err)
+	}
+
 	// Slash who did miss voting over threshold
 	// reset miss counter of all validators at the last block of slash window
 	if utils.IsPeriodLastBlock(ctx, params.SlashWindow) {
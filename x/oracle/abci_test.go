@@ -44,8 +44,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Multiple validators submit their votes
 		for i := 0; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
-			_, err := handler(ctx, voteMsg)
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			require.NoError(t, err)
 		}
 
@@ -73,8 +72,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Multiple validators submit their votes
 		for i := 0; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
-			_, err := handler(ctx, voteMsg)
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			require.NoError(t, err)
 		}
 
@@ -102,8 +100,7 @@ func TestMidBlocker(t *testing.T) {
 		ctx = input.Ctx.WithBlockHeight(1)
 
 		// Only one validator votes (insufficient power)
-		voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, voteMsg)
+		_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		require.NoError(t, err)
 
 		MidBlocker(ctx, oracleKeeper) // rate did not storage on KVStore, ballot below ballot threshold
@@ -128,8 +125,7 @@ func TestMidBlocker(t *testing.T) {
 
 		// Only two validators vote, one validator abstains
 		for i := 0; i < 2; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
-			_, err := handler(ctx, voteMsg)
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			require.NoError(t, err)
 		}
 
@@ -155,14 +151,12 @@ func TestMidBlocker(t *testing.T) {
 
 		// Validator submits an incorrect exchange rate
 		wrongRate := "100000000.0" + utils.MicroAtomDenom
-		voteMsg := types.NewMsgAggregateExchangeRateVote(wrongRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, voteMsg)
+		_, err := prevoteAndVote(ctx, handler, wrongRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		require.NoError(t, err)
 
 		// Other validators submit correct votes
 		for i := 1; i < 3; i++ {
-			voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
-			_, err := handler(ctx, voteMsg)
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
 			require.NoError(t, err)
 		}
 
@@ -173,6 +167,82 @@ func TestMidBlocker(t *testing.T) {
 		require.Equal(t, uint64(1), missCount) // Validator 0 has 1 Miss
 	})
 
+	t.Run("Validator reveal does not match its prevote - Should count as Miss", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		ctx := input.Ctx
+		oracleKeeper := input.OracleKeeper
+
+		// Sample exchange rate for the test
+		oracleKeeper.DeleteVoteTargets(ctx)
+		oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		ctx = input.Ctx.WithBlockHeight(1)
+
+		// Validator 0 prevotes a different salt from the one revealed
+		hash := types.GetAggregateVoteHash("2", exchangeRate, keeper.ValAddrs[0])
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx.WithBlockHeight(0), prevoteMsg)
+		require.NoError(t, err)
+		voteMsg := types.NewMsgAggregateExchangeRateVote("1", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err = handler(ctx, voteMsg)
+		require.NoError(t, err)
+
+		// Other validators submit correct votes
+		for i := 1; i < 3; i++ {
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		missCount := oracleKeeper.GetMissCount(ctx, keeper.ValAddrs[0])
+		require.Equal(t, uint64(1), missCount) // Validator 0 has 1 Miss
+		abstainCount := oracleKeeper.GetAbstainCount(ctx, keeper.ValAddrs[0])
+		require.Equal(t, uint64(0), abstainCount)                                 // a mismatch is not an abstain
+		require.False(t, oracleKeeper.HasRevealMismatch(ctx, keeper.ValAddrs[0])) // flag cleared at the end of the period
+	})
+
+	t.Run("Ballot winners - Should receive the reward pool share", func(t *testing.T) {
+		// Reset blockchain state
+		input, handler := SetUp(t)
+		ctx := input.Ctx
+		oracleKeeper := input.OracleKeeper
+
+		// Sample exchange rate for the test
+		oracleKeeper.DeleteVoteTargets(ctx)
+		oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+
+		// Fund the reward pool, a tenth of it is distributed each vote period
+		params := oracleKeeper.GetParams(ctx)
+		params.RewardDistributionWindow = 10
+		oracleKeeper.SetParams(ctx, params)
+		rewardPool := sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(3000)))
+		_, err := handler(ctx, types.NewMsgFundRewardPool(keeper.Addrs[3], rewardPool))
+		require.NoError(t, err)
+
+		ctx = input.Ctx.WithBlockHeight(1)
+
+		// Multiple validators submit their votes
+		for i := 0; i < 3; i++ {
+			_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[i], keeper.ValAddrs[i])
+			require.NoError(t, err)
+		}
+
+		MidBlocker(ctx, oracleKeeper)
+		Endblocker(ctx, oracleKeeper)
+
+		// Validate the payout (same power, same reward)
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(2700))), oracleKeeper.GetRewardPool(ctx))
+		for i := 0; i < 3; i++ {
+			rewards := input.DistKeeper.GetValidatorOutstandingRewards(ctx, keeper.ValAddrs[i]).Rewards
+			require.Equal(t, sdk.NewDec(100), rewards.AmountOf(utils.MicroKiiDenom))
+		}
+	})
+
 	t.Run("Verify upgrading the vote targets", func(t *testing.T) {
 		// Reset blockchain state
 		input, _ := SetUp(t)
@@ -233,8 +303,7 @@ func TestOracleDrop(t *testing.T) {
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
 
 	// simulate val 0 votation
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-	_, err := handler(ctx.WithBlockHeight(9), voteMsg)
+	_, err := prevoteAndVote(ctx.WithBlockHeight(9), handler, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 	require.NoError(t, err)
 
 	// Immediately swap halt after an illiquid oracle vote
@@ -46,6 +46,34 @@ func (spd SpammingPreventionDecorator) CheckOracleSpamming(ctx sdk.Context, msgs
 
 	for _, msg := range msgs {
 		switch msg := msg.(type) {
+		case *types.MsgAggregateExchangeRatePrevote:
+			// validate a valid feeder address
+			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+			if err != nil {
+				return err
+			}
+
+			// validate a valid validator address
+			valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+			if err != nil {
+				return err
+			}
+
+			// validate the feeder delegation is valid
+			err = spd.oracleKepper.ValidateFeeder(ctx, feederAddr, valAddr)
+			if err != nil {
+				return err
+			}
+
+			// check if the validator has prevoted on that block height
+			spamPreventionHeight := spd.oracleKepper.GetPrevoteSpamPreventionCounter(ctx, valAddr)
+			if spamPreventionHeight == currentHeight {
+				return sdkerrors.Wrap(sdkerrors.ErrAlreadyExists, fmt.Sprintf("the validator has already submitted a prevote at the current height=%d", currentHeight))
+			}
+
+			// set the anti spam block height
+			spd.oracleKepper.SetPrevoteSpamPreventionCounter(ctx, valAddr)
+			continue
 		case *types.MsgAggregateExchangeRateVote:
 			// validate a valid feeder address
 			feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
@@ -89,6 +117,32 @@ func (spd SpammingPreventionDecorator) AnteDeps(txDeps []sdkacltypes.AccessOpera
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
 
+		// Process the aggregate exchange rate prevote messages
+		case *types.MsgAggregateExchangeRatePrevote:
+			valAddrs, _ := sdk.ValAddressFromBech32(msg.Feeder)
+			deps = append(deps, []sdkacltypes.AccessOperation{
+				// validate feeder
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(types.GetFeederDelegationKey(valAddrs)),
+				},
+
+				// Validate the validator exists
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddrs)),
+				},
+
+				// Check prevote exists
+				{
+					ResourceType:       sdkacltypes.ResourceType_KV_ORACLE,
+					AccessType:         sdkacltypes.AccessType_READ,
+					IdentifierTemplate: hex.EncodeToString(types.GetAggregateExchangeRatePrevoteKey(valAddrs)),
+				},
+			}...)
+
 		// Process the aggregate exchange rate messages
 		case *types.MsgAggregateExchangeRateVote:
 			valAddrs, _ := sdk.ValAddressFromBech32(msg.Feeder)
@@ -140,7 +194,7 @@ func (VoteAloneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	// Iterate over all messages on the transaction
 	for _, msg := range tx.GetMsgs() {
 		switch msg.(type) {
-		case *types.MsgAggregateExchangeRateVote:
+		case *types.MsgAggregateExchangeRatePrevote, *types.MsgAggregateExchangeRateVote:
 			oracleVote = true
 		default:
 			otherMsg = true
@@ -83,8 +83,8 @@ func TestSpammingPreventionHandle(t *testing.T) {
 	randomAExchangeRate := sdk.NewDec(1700)
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
 
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-	invalidVoteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[5], keeper.ValAddrs[2]) // addr 3 has not been delegated by val 2
+	voteMsg := types.NewMsgAggregateExchangeRateVote("1", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+	invalidVoteMsg := types.NewMsgAggregateExchangeRateVote("1", exchangeRate, keeper.Addrs[5], keeper.ValAddrs[2]) // addr 3 has not been delegated by val 2
 
 	// Register anti spamming decorator
 	spammingDecorator := oracle.NewSpammingPreventionDecorator(oracleKeeper)
@@ -129,7 +129,7 @@ func TestSpammingPreventionAnteDeps(t *testing.T) {
 	// Create test exchange rate
 	randomAExchangeRate := sdk.NewDec(1700)
 	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0]) // create aggregate exchange rate msg
+	voteMsg := types.NewMsgAggregateExchangeRateVote("1", exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0]) // create aggregate exchange rate msg
 
 	// Register spamming decorator
 	spammingDecorator := oracle.NewSpammingPreventionDecorator(oracleKeeper)
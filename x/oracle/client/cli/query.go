@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryParams(),
 		CmdQueryFeederDelegation(),
 		CmdQueryVotePenaltyCounter(),
+		CmdQueryAggregatePrevotes(),
+		CmdQueryRewardPool(),
 	)
 
 	return oracleQueryCmd
@@ -130,6 +132,19 @@ func CmdQueryParams() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryRewardPool is the command executed when users type reward-pool command
+func CmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the balance of the oracle reward pool",
+		RunE:  getRewardPool,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryFeederDelegation is the command executed when users type feeder [validator]
 func CmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
@@ -164,6 +179,27 @@ $kiichaind query oracle vote-penalty-counter kiivaloper...`),
 	return cmd
 }
 
+// CmdQueryAggregatePrevotes is the command executed when users type aggregate-prevotes [validator]
+func CmdQueryAggregatePrevotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevotes [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the pending aggregate prevotes",
+		Long: strings.TrimSpace(`
+Query the pending aggregate prevotes of all validators
+
+$kiichaind query oracle aggregate-prevotes
+
+Or filter by validator running
+
+$kiichaind query oracle aggregate-prevotes kiivaloper...`),
+		RunE: getAggregatePrevotes,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdQueryVoteTargets is the command executed when users type vote-targets
 func CmdQueryVoteTargets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -295,6 +331,26 @@ func getParams(cmd *cobra.Command, args []string) error {
 	return clientCtx.PrintProto(res) // print msg response
 }
 
+// getRewardPool returns the balance of the oracle reward pool
+func getRewardPool(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// get reward pool
+	res, err := queryClient.RewardPool(context.Background(), &types.QueryRewardPoolRequest{})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
 // getFeederDelegation returns the validator's delegated account
 func getFeederDelegation(cmd *cobra.Command, arg []string) error {
 	// get ctx
@@ -349,6 +405,43 @@ func getVotePenaltyCounter(cmd *cobra.Command, arg []string) error {
 	return clientCtx.PrintProto(res) // print msg response
 }
 
+// getAggregatePrevotes returns all pending aggregate prevotes or the prevote of an specific
+// validator if the user add it on the command
+func getAggregatePrevotes(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// create query client
+	queryClient := types.NewQueryClient(clientCtx)
+
+	// Return all prevotes
+	if len(args) == 0 {
+		res, err := queryClient.AggregatePrevotes(context.Background(), &types.QueryAggregatePrevotesRequest{})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res) // print msg response
+	}
+
+	// get validator address
+	validator, err := sdk.ValAddressFromBech32(args[0])
+	if err != nil {
+		return err
+	}
+
+	// Return specific validator prevote
+	res, err := queryClient.AggregatePrevote(context.Background(), &types.QueryAggregatePrevoteRequest{ValidatorAddr: validator.String()})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res) // print msg response
+}
+
 // getVoteTargets returs the current vote targets
 func getVoteTargets(cmd *cobra.Command, arg []string) error {
 	// get ctx
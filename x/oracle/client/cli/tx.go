@@ -26,7 +26,9 @@ func GetTxCmd() *cobra.Command {
 	// Add Tx commands
 	oracleTxCmd.AddCommand(
 		CmdDelegateFeederPermission(),
+		CmdAggregateExchangeRatePrevote(),
 		CmdAggregateExchangeRateVote(),
+		CmdFundRewardPool(),
 	)
 
 	return oracleTxCmd
@@ -54,23 +56,50 @@ where "kii1..." is the address you want to delegate your voting rights to.`),
 	return cmd
 }
 
-// CmdAggregateExchangeRateVote is the command executed when users type ""
+// CmdAggregateExchangeRatePrevote is the command executed when users type "$ kiichaind tx oracle aggregate-prevote ..."
+// on the CLI
+func CmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote with the hash of the exchange rates",
+		Long: strings.TrimSpace(`
+Submit an aggregate prevote with the hash of the exchange rates that will be revealed on the next vote period.
+The hash is the truncated sha256 of the string "{salt}:{exchange-rates}:{validator}".
+		
+$kiichaind tx oracle aggregate-prevote 1234 123.45ukii,678.90uatom...
+		
+where "ukii,uatom,ueth..." are the denominating currencies and 123.45,678.90 are the exchange rates of micro USD in micro denoms
+		
+If voting from a delegate account, set "validator" to the address of the validator you are voting on behalf of, i.e:
+		
+$ kiichaind tx oracle aggregate-prevote 1234 123.45ukii,678.90uatom... kiivaloper1...`),
+		RunE: aggregatePrevote,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAggregateExchangeRateVote is the command executed when users type "$ kiichaind tx oracle aggregate-vote ..."
 // on the CLI
 func CmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "aggregate-vote [exchange-rates] [validator]",
-		Args:  cobra.RangeArgs(1, 2),
+		Use:   "aggregate-vote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
 		Short: "Submit an oracle aggregate vote with the exchange rates",
 		Long: strings.TrimSpace(`
-Submit an aggregate vote with the exchange rates.
+Submit an aggregate vote with the exchange rates, revealing the prevote submitted on the previous vote period.
+The salt and the exchange rates must be the same used on the prevote.
 		
-$kiichaind tx oracle aggregate-vote 123.45ukii,678.90uatom...
+$kiichaind tx oracle aggregate-vote 1234 123.45ukii,678.90uatom...
 		
 where "ukii,uatom,ueth..." are the denominating currencies and 123.45,678.90 are the exchange rates of micro USD in micro denoms
 		
 If voting from a delegate account, set "validator" to the address of the validator you are voting on behalf of, i.e:
 		
-$ kiichaind oracle aggregate-vote 123.45ukii,678.90uatom... kiivaloper1...`),
+$ kiichaind tx oracle aggregate-vote 1234 123.45ukii,678.90uatom... kiivaloper1...`),
 		RunE: aggregateVote,
 	}
 
@@ -79,6 +108,25 @@ $ kiichaind oracle aggregate-vote 123.45ukii,678.90uatom... kiivaloper1...`),
 	return cmd
 }
 
+// CmdFundRewardPool is the command executed when users type "$ kiichaind tx oracle fund-reward-pool [amount]"
+// on the CLI
+func CmdFundRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-pool [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deposit coins on the oracle reward pool",
+		Long: strings.TrimSpace(`
+Deposit coins on the oracle reward pool. The pool is paid out to the validators
+that vote within the reward band over the reward distribution window.
+
+$ kiichaind tx oracle fund-reward-pool 1000000ukii`),
+		RunE: fundRewardPool,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // setFeeder is executed with the command "set-feeder [feeder]". It delegates
 // the permission to submit exchange rate to an address
 func setFeeder(cmd *cobra.Command, args []string) error {
@@ -111,7 +159,50 @@ func setFeeder(cmd *cobra.Command, args []string) error {
 	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 }
 
-// aggregateVote is executed with the command "aggregate-vote [exchange-rates] [validator]"
+// aggregatePrevote is executed with the command "aggregate-prevote [salt] [exchange-rates] [validator]"
+// it sends the hash of the exchange rates that will be revealed on the next vote period
+func aggregatePrevote(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get salt and exchange rates
+	salt := args[0]
+	exchangeRatesStr := args[1]
+	_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+	if err != nil {
+		return err
+	}
+
+	// Get from address
+	voter := clientCtx.GetFromAddress()
+
+	// by default the voter is voting on bhalf of itself
+	valAddress := sdk.ValAddress(voter)
+
+	// overide validator if validator's address is given
+	if len(args) == 3 {
+		parsedVal, err := sdk.ValAddressFromBech32(args[2])
+		if err != nil {
+			return errors.Wrap(err, "validator address is invalid")
+		}
+		valAddress = parsedVal
+	}
+
+	// Create aggregate exchange rate prevote message
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, valAddress)
+	msg := types.NewMsgAggregateExchangeRatePrevote(hash, voter, valAddress)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// aggregateVote is executed with the command "aggregate-vote [salt] [exchange-rates] [validator]"
 // it sends the exchange rate voting message
 func aggregateVote(cmd *cobra.Command, args []string) error {
 	// get ctx
@@ -120,8 +211,9 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Get exchange rates
-	exchangeRatesStr := args[0]
+	// Get salt and exchange rates
+	salt := args[0]
+	exchangeRatesStr := args[1]
 	_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
 	if err != nil {
 		return err
@@ -134,8 +226,8 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 	valAddress := sdk.ValAddress(voter)
 
 	// overide validator if validator's address is given
-	if len(args) == 2 {
-		parsedVal, err := sdk.ValAddressFromBech32(args[1])
+	if len(args) == 3 {
+		parsedVal, err := sdk.ValAddressFromBech32(args[2])
 		if err != nil {
 			return errors.Wrap(err, "validator address is invalid")
 		}
@@ -143,7 +235,32 @@ func aggregateVote(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create aggregate exchange rate vote message
-	msg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, valAddress)
+	msg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, voter, valAddress)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// fundRewardPool is executed with the command "fund-reward-pool [amount]"
+// it deposits the coins from the sender account on the oracle reward pool
+func fundRewardPool(cmd *cobra.Command, args []string) error {
+	// get ctx
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get the coins to deposit
+	amount, err := sdk.ParseCoinsNormalized(args[0])
+	if err != nil {
+		return err
+	}
+
+	// Create fund reward pool message
+	msg := types.NewMsgFundRewardPool(clientCtx.GetFromAddress(), amount)
 	err = msg.ValidateBasic()
 	if err != nil {
 		return err
@@ -60,6 +60,16 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddress, aggregateExchange)
 	}
 
+	// Add the AggregateExchangeRatePrevotes to the KVStore defined on the input object
+	for _, aggregatePrevote := range data.AggregateExchangeRatePrevotes {
+		valAddress, err := sdk.ValAddressFromBech32(aggregatePrevote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddress, aggregatePrevote)
+	}
+
 	// Add the price snapshots to the KVStore defined on the input object
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
@@ -110,6 +120,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 
+	// Extract Aggregate exchange rate prevotes
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	// Extract priceSnapshots
 	priceSnapshots := []types.PriceSnapshot{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
@@ -125,6 +142,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	})
 
 	// Send data
-	return *types.NewGenesisState(params, exchangeRates, feederDelegations, penaltyCounters, aggregateExchangeRateVotes, priceSnapshots, votePenaltyCounters)
+	return *types.NewGenesisState(params, exchangeRates, feederDelegations, penaltyCounters, aggregateExchangeRateVotes, priceSnapshots, votePenaltyCounters, aggregateExchangeRatePrevotes)
 
 }
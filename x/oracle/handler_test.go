@@ -15,7 +15,7 @@ import (
 func TestOracleFilters(t *testing.T) {
 	// Prepare env
 	input, handler := SetUp(t)
-	ctx := input.Ctx
+	ctx := input.Ctx.WithBlockHeight(1)
 	oracleKeeper := input.OracleKeeper
 
 	// set voting targets
@@ -30,8 +30,7 @@ func TestOracleFilters(t *testing.T) {
 
 	t.Run("oracle message received", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		require.NoError(t, err)
 	})
 
@@ -39,15 +38,77 @@ func TestOracleFilters(t *testing.T) {
 		nonValidatorPub := secp256k1.GenPrivKey().PubKey()
 		nonValidatorAddr := nonValidatorPub.Address()
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRate, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr))
+		voteMsg := types.NewMsgAggregateExchangeRateVote("1", exchangeRate, sdk.AccAddress(nonValidatorAddr), sdk.ValAddress(nonValidatorAddr))
 		require.Panics(t, func() { handler(ctx, voteMsg) })
 	})
 }
 
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	// Prepare env
+	input, handler := SetUp(t)
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracleKeeper := input.OracleKeeper
+
+	// set voting targets
+	oracleKeeper.DeleteVoteTargets(ctx)
+	oracleKeeper.SetVoteTarget(ctx, utils.MicroAtomDenom)
+
+	salt := "1"
+	exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRate, keeper.ValAddrs[0])
+
+	t.Run("vote without prevote", func(t *testing.T) {
+		voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx, voteMsg)
+		require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+	})
+
+	t.Run("reveal on the same vote period", func(t *testing.T) {
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx, prevoteMsg)
+		require.NoError(t, err)
+
+		voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err = handler(ctx, voteMsg)
+		require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+	})
+
+	t.Run("reveal on the next vote period", func(t *testing.T) {
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err := handler(ctx, prevoteMsg)
+		require.NoError(t, err)
+
+		voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err = handler(ctx.WithBlockHeight(2), voteMsg)
+		require.NoError(t, err)
+
+		// the prevote must be consumed and the vote stored
+		_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, keeper.ValAddrs[0])
+		require.Error(t, err)
+		_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, keeper.ValAddrs[0])
+		require.NoError(t, err)
+	})
+
+	t.Run("reveal does not match the prevote", func(t *testing.T) {
+		prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[1])
+		_, err := handler(ctx, prevoteMsg)
+		require.NoError(t, err)
+
+		voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, keeper.Addrs[1], keeper.ValAddrs[1])
+		_, err = handler(ctx.WithBlockHeight(2), voteMsg)
+		require.NoError(t, err)
+
+		// the vote is not stored and the mismatch is registered
+		_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, keeper.ValAddrs[1])
+		require.Error(t, err)
+		require.True(t, oracleKeeper.HasRevealMismatch(ctx, keeper.ValAddrs[1]))
+	})
+}
+
 func TestMsgDelegateFeedConsent(t *testing.T) {
 	// Prepare env
 	input, handler := SetUp(t)
-	ctx := input.Ctx
+	ctx := input.Ctx.WithBlockHeight(1)
 	oracleKeeper := input.OracleKeeper
 
 	// set voting targets
@@ -62,15 +123,13 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 
 	t.Run("success vote without delegation", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[0])
 		require.NoError(t, err)
 	})
 
 	t.Run("fail vote with failed delegation ", func(t *testing.T) {
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msg := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[1], keeper.ValAddrs[0])
-		_, err := handler(ctx, msg)
+		_, err := prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[1], keeper.ValAddrs[0])
 		require.Error(t, err)
 	})
 
@@ -82,8 +141,7 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 
 		// vote
 		exchangeRate := randomAExchangeRate.String() + utils.MicroAtomDenom
-		msgVote := types.NewMsgAggregateExchangeRateVote(exchangeRate, keeper.Addrs[0], keeper.ValAddrs[1])
-		_, err = handler(ctx, msgVote)
+		_, err = prevoteAndVote(ctx, handler, exchangeRate, keeper.Addrs[0], keeper.ValAddrs[1])
 		require.NoError(t, err)
 	})
 }
@@ -47,13 +47,26 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all votes from the KV Store
-func (k Keeper) ClearBallots(ctx sdk.Context) {
+// ClearBallots clears all votes, the reveal mismatch marks and the prevotes
+// that can not be revealed anymore (older than the vote period) from the KV Store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear expired aggregate prevotes, a prevote can only be revealed on the
+	// period after its submission, which is over at SubmitBlock+votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		if ctx.BlockHeight() >= int64(aggregatePrevote.SubmitBlock+votePeriod) {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) bool {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
 		return false
 	})
+
+	// Clear the reveal mismatch marks
+	k.ClearRevealMismatches(ctx)
 }
 
 // ApplyWhitelist update the vote target on the KVStore if there are new desired denoms on the parameters
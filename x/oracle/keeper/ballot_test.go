@@ -160,14 +160,51 @@ func TestClearBallots(t *testing.T) {
 	oracleKeeper.SetAggregateExchangeRateVote(ctx, ValAddrs[1], exchangeRateVote2)
 	require.NoError(t, err)
 
+	// Simulate prevotes, one expired and one from the current vote period
+	votePeriod := uint64(5)
+	ctx = ctx.WithBlockHeight(10)
+	hash := types.GetAggregateVoteHash("1", exchangeRate1.String(), ValAddrs[0])
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 4))
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[1], 9))
+	oracleKeeper.SetRevealMismatch(ctx, ValAddrs[1])
+
 	// Delete the added exchange rate
-	oracleKeeper.ClearBallots(ctx)
+	oracleKeeper.ClearBallots(ctx, votePeriod)
 
 	// Validate process
 	_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[0])
 	require.Error(t, err)
 	_, err = oracleKeeper.GetAggregateExchangeRateVote(ctx, ValAddrs[1])
 	require.Error(t, err)
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.Error(t, err) // expired prevote removed
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[1])
+	require.NoError(t, err) // prevote still can be revealed
+	require.False(t, oracleKeeper.HasRevealMismatch(ctx, ValAddrs[1]))
+}
+
+func TestClearBallotsPrevoteExpiry(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	// Prevote on the last block of the period [5, 9], revealed on [10, 14]
+	votePeriod := uint64(5)
+	exchangeRate := types.ExchangeRateTuples{{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDec(1)}}
+	hash := types.GetAggregateVoteHash("1", exchangeRate.String(), ValAddrs[0])
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 9))
+
+	// The prevote is kept while its reveal window is open
+	oracleKeeper.ClearBallots(ctx.WithBlockHeight(13), votePeriod)
+	_, err := oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+
+	// The prevote is cleared at SubmitBlock+VotePeriod, the last block of the
+	// reveal period
+	oracleKeeper.ClearBallots(ctx.WithBlockHeight(14), votePeriod)
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.Error(t, err)
 }
 
 func TestApplyWhitelist(t *testing.T) {
@@ -23,6 +23,7 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 	StakingKeeper types.StakingKeeper
 
 	distrName string
@@ -30,8 +31,8 @@ type Keeper struct {
 
 // NewKeeper creates an oracle Keeper instance
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, memKey sdk.StoreKey, paramSpace paramstypes.Subspace,
-	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, StakingKeeper types.StakingKeeper,
-	distrName string) Keeper {
+	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
+	StakingKeeper types.StakingKeeper, distrName string) Keeper {
 	// Ensure oracle module account is set
 	addr := accountKeeper.GetModuleAddress(types.ModuleName)
 	if addr == nil {
@@ -50,6 +51,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, memKey sdk.StoreKey
 		paramSpace:    paramSpace,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		StakingKeeper: StakingKeeper,
 		distrName:     distrName,
 	}
@@ -339,6 +341,75 @@ func (k Keeper) IterateAggregateExchangeRateVotes(ctx sdk.Context, handler func(
 	}
 }
 
+// ****************************************************************************
+
+// **************************** Aggregate Exchange Rate Prevote logic *********
+
+// GetAggregateExchangeRatePrevote returns the prevote hash submitted by an specific voter
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (types.AggregateExchangeRatePrevote, error) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if byteData == nil {
+		err := sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return types.AggregateExchangeRatePrevote{}, err // Return custom error
+	}
+
+	// Decode information
+	aggregatePrevote := types.AggregateExchangeRatePrevote{}
+	k.cdc.MustUnmarshal(byteData, &aggregatePrevote)
+	return aggregatePrevote, nil
+}
+
+// SetAggregateExchangeRatePrevote adds an oracle exchange rate prevote to the KVStore
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	byteData := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), byteData)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle exchange rate prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates over exchange rate prevotes in the store and perform vallback function
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		aggregatePrevote := types.AggregateExchangeRatePrevote{}
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
+// SetRevealMismatch marks the voter as a validator whose vote did not match its prevote on the current vote period
+func (k Keeper) SetRevealMismatch(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRevealMismatchKey(voter), []byte{1})
+}
+
+// HasRevealMismatch returns true if the voter revealed a vote that did not match its prevote on the current vote period
+func (k Keeper) HasRevealMismatch(ctx sdk.Context, voter sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRevealMismatchKey(voter))
+}
+
+// ClearRevealMismatches deletes all reveal mismatch marks from the KVStore
+func (k Keeper) ClearRevealMismatches(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range k.getAllKeysForPrefix(store, types.RevealMismatchKey) {
+		store.Delete(key)
+	}
+}
+
 // RemoveExcessFeeds deletes the exchange rates added to the KVStore but not require on the whitelist
 func (k Keeper) RemoveExcessFeeds(ctx sdk.Context) {
 	// get exchange rates stored on the KVStore
@@ -554,6 +625,27 @@ func (k Keeper) SetSpamPreventionCounter(ctx sdk.Context, valAddr sdk.ValAddress
 	store.Set(types.GetSpamPreventionCounterKey(valAddr), byteData) // store the current block height
 }
 
+// GetPrevoteSpamPreventionCounter returns the stored block heigh by the validator (in that heigh the validator prevoted)
+func (k Keeper) GetPrevoteSpamPreventionCounter(ctx sdk.Context, valAddr sdk.ValAddress) int64 {
+	store := ctx.KVStore(k.memKey) // Get oracle module's KVStore
+	byteData := store.Get(types.GetPrevoteSpamPreventionCounterKey(valAddr))
+	if byteData == nil {
+		return -1 // Return invalid counter
+	}
+
+	return int64(sdk.BigEndianToUint64(byteData)) // return the counter by validator address
+}
+
+// SetPrevoteSpamPreventionCounter stores the block heigh by the validator as an anti prevoting spam mecanism
+func (k Keeper) SetPrevoteSpamPreventionCounter(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.memKey)
+
+	height := ctx.BlockHeight() // Get current block height
+	byteData := sdk.Uint64ToBigEndian(uint64(height))
+
+	store.Set(types.GetPrevoteSpamPreventionCounterKey(valAddr), byteData) // store the current block height
+}
+
 // ****************************************************************************
 
 // **************************** Helper Functions logic ************************
@@ -27,6 +27,7 @@ func TestNewKeeper(t *testing.T) {
 			init.OracleKeeper.paramSpace,
 			init.AccountKeeper,
 			init.BankKeeper,
+			init.DistKeeper,
 			init.StakingKeeper,
 			distTypes.ModuleName,
 		)
@@ -40,6 +41,7 @@ func TestNewKeeper(t *testing.T) {
 		init.OracleKeeper.paramSpace,
 		init.AccountKeeper,
 		init.BankKeeper,
+		init.DistKeeper,
 		init.StakingKeeper,
 		distTypes.ModuleName,
 	)
@@ -168,16 +170,20 @@ func TestParams(t *testing.T) {
 	minValPerWindow := sdk.NewDecWithPrec(1, 4) // 0.0001
 	whiteList := types.DenomList{{Name: utils.MicroKiiDenom}, {Name: utils.MicroAtomDenom}}
 	lookbackDuration := uint64(3600)
+	rewardDistributionWindow := uint64(10000)
+	rewardPoolFeeShare := sdk.NewDecWithPrec(1, 1) // 0.1
 
 	params := types.Params{
-		VotePeriod:        votePeriod,
-		VoteThreshold:     voteThreshold,
-		RewardBand:        rewardBand,
-		Whitelist:         whiteList,
-		SlashFraction:     slashFraccion,
-		SlashWindow:       slashwindow,
-		MinValidPerWindow: minValPerWindow,
-		LookbackDuration:  lookbackDuration,
+		VotePeriod:               votePeriod,
+		VoteThreshold:            voteThreshold,
+		RewardBand:               rewardBand,
+		Whitelist:                whiteList,
+		SlashFraction:            slashFraccion,
+		SlashWindow:              slashwindow,
+		MinValidPerWindow:        minValPerWindow,
+		LookbackDuration:         lookbackDuration,
+		RewardDistributionWindow: rewardDistributionWindow,
+		RewardPoolFeeShare:       rewardPoolFeeShare,
 	}
 	oracleKeeper.SetParams(ctx, params)
 
@@ -422,6 +428,47 @@ func TestIterateAggregateExchangeRateVotes(t *testing.T) {
 	oracleKeeper.IterateAggregateExchangeRateVotes(ctx, handler)
 }
 
+func TestAggregateExchangeRatePrevoteLogic(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	// Create and set prevotes
+	hash1 := types.GetAggregateVoteHash("1", "1.0BTC/USD", ValAddrs[0])
+	hash2 := types.GetAggregateVoteHash("2", "2.0BTC/USD", ValAddrs[1])
+	prevote1 := types.NewAggregateExchangeRatePrevote(hash1, ValAddrs[0], 10)
+	prevote2 := types.NewAggregateExchangeRatePrevote(hash2, ValAddrs[1], 11)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[0], prevote1)
+	oracleKeeper.SetAggregateExchangeRatePrevote(ctx, ValAddrs[1], prevote2)
+
+	// Get the prevote and validate
+	gotPrevote, err := oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, prevote1, gotPrevote)
+
+	// Iterate prevotes
+	prevotes := []types.AggregateExchangeRatePrevote{}
+	oracleKeeper.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		require.Equal(t, aggregatePrevote.Voter, voterAddr.String())
+		prevotes = append(prevotes, aggregatePrevote)
+		return false
+	})
+	require.ElementsMatch(t, []types.AggregateExchangeRatePrevote{prevote1, prevote2}, prevotes)
+
+	// Delete prevote
+	oracleKeeper.DeleteAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// Reveal mismatch flags
+	require.False(t, oracleKeeper.HasRevealMismatch(ctx, ValAddrs[0]))
+	oracleKeeper.SetRevealMismatch(ctx, ValAddrs[0])
+	require.True(t, oracleKeeper.HasRevealMismatch(ctx, ValAddrs[0]))
+	oracleKeeper.ClearRevealMismatches(ctx)
+	require.False(t, oracleKeeper.HasRevealMismatch(ctx, ValAddrs[0]))
+}
+
 func TestRemoveExcessFeeds(t *testing.T) {
 	// Prepare the test environment
 	init := CreateTestInput(t)
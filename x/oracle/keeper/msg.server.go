@@ -23,8 +23,60 @@ func NewMsgServer(keeper Keeper) types.MsgServer {
 	}
 }
 
+// AggregateExchangeRatePrevote receive the hash of the exchange rates that will be revealed on the next vote period,
+// validate the feeder address (if it is allowed to perform that operation) and store the hash into the KVStore
+func (ms msgServer) AggregateExchangeRatePrevote(ctx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	// Get cosmos sdk context from golang context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get the validator address who send the prevote from the input data
+	valAddress, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert feeder address to Account data type
+	feederAddress, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate feeder address
+	err = ms.ValidateFeeder(sdkCtx, feederAddress, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the hex hash to the hash data type
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	// store the prevote with the current block height
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddress, uint64(sdkCtx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(sdkCtx, valAddress, aggregatePrevote)
+
+	// Trigger events (prevote saved and the feeder address)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent( // Event with the prevote hash registered on the module
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyHash, msg.Hash),
+		),
+		sdk.NewEvent( //the Event with the information who send the information (the feeder address and the module name)
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 // AggregateExchangeRateVote receive the exchange rate information, validate the feeder address (if it is allowed to perform that operation),
-// then, check if the information is valid and finally add it into the exchange rate KVStore
+// then, check if the information matches the prevote submitted on the previous vote period and finally add it into the exchange rate KVStore.
+// A reveal that does not match its prevote is not added to the ballot and is counted as a miss at the end of the vote period
 func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	// Get cosmos sdk context from golang context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -47,6 +99,40 @@ func (ms msgServer) AggregateExchangeRateVote(ctx context.Context, msg *types.Ms
 		return nil, err
 	}
 
+	// Get the prevote submitted by the validator
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(sdkCtx, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The prevote must have been submitted on the previous vote period
+	params := ms.GetParams(sdkCtx)
+	if (uint64(sdkCtx.BlockHeight())/params.VotePeriod)-(aggregatePrevote.SubmitBlock/params.VotePeriod) != 1 {
+		return nil, types.ErrRevealPeriodMissMatch
+	}
+
+	// Verify the revealed vote matches the prevote hash, the prevote is consumed either way
+	ms.DeleteAggregateExchangeRatePrevote(sdkCtx, valAddress)
+	voteHash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddress)
+	if aggregatePrevote.Hash != voteHash.String() {
+		ms.SetRevealMismatch(sdkCtx, valAddress)
+
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent( // Event with the validator whose vote did not match its prevote
+				types.EventTypeRevealMismatch,
+				sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+				sdk.NewAttribute(types.AttributeKeyHash, aggregatePrevote.Hash),
+			),
+			sdk.NewEvent( //the Event with the information who send the information (the feeder address and the module name)
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+			),
+		})
+
+		return &types.MsgAggregateExchangeRateVoteResponse{}, nil
+	}
+
 	// Convert string exchange rates to specific data types
 	exchangeRates, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
 	if err != nil {
@@ -126,3 +212,37 @@ func (ms msgServer) DelegateFeedConsent(ctx context.Context, msg *types.MsgDeleg
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+// FundRewardPool deposits coins from the depositor account on the oracle reward pool
+func (ms msgServer) FundRewardPool(ctx context.Context, msg *types.MsgFundRewardPool) (*types.MsgFundRewardPoolResponse, error) {
+	// Get cosmos sdk context from golang context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get the depositor address from the message
+	depositorAddress, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the coins to the oracle module account (the reward pool)
+	err = ms.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, depositorAddress, types.ModuleName, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Trigger events (coins deposited and the depositor address)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent( // Event with the coins deposited on the reward pool
+			types.EventTypeFundRewardPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent( //the Event with the information who send the information (the depositor address and the module name)
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	})
+
+	return &types.MsgFundRewardPoolResponse{}, nil
+}
@@ -32,13 +32,29 @@ func TestAggregateExchangeRateVote(t *testing.T) {
 	// execute staking endblocker to start validators bonding
 	staking.EndBlocker(ctx, stakingKeeper)
 
-	// send messages
+	// send prevote
+	salt := "1"
 	exchangeRate := sdk.NewDec(12).String() + utils.MicroUsdcDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRate, ValAddrs[0])
 	context := sdk.WrapSDKContext(ctx)
-	_, err = msgServer.AggregateExchangeRateVote(context, types.NewMsgAggregateExchangeRateVote(exchangeRate, Addrs[0], ValAddrs[0]))
+	_, err = msgServer.AggregateExchangeRatePrevote(context, types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// query the prevote
+	querier := NewQueryServer(oracleKeeper)
+	res, err := querier.AggregatePrevote(context, &types.QueryAggregatePrevoteRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, hash.String(), res.AggregatePrevote.Hash)
+
+	// reveal the vote on the next vote period
+	votePeriod := oracleKeeper.GetParams(ctx).VotePeriod
+	context = sdk.WrapSDKContext(ctx.WithBlockHeight(int64(votePeriod)))
+	_, err = msgServer.AggregateExchangeRateVote(context, types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, Addrs[0], ValAddrs[0]))
 
 	// validation
 	require.NoError(t, err)
+	_, err = oracleKeeper.GetAggregateExchangeRatePrevote(ctx, ValAddrs[0])
+	require.Error(t, err) // prevote consumed by the reveal
 }
 
 func TestDelegateFeedConsent(t *testing.T) {
@@ -76,3 +92,32 @@ func TestDelegateFeedConsent(t *testing.T) {
 	// validation
 	require.Equal(t, Addrs[0].String(), res.FeedAddr)
 }
+
+func TestFundRewardPool(t *testing.T) {
+	// prepare env
+	input := CreateTestInput(t)
+	oracleKeeper := input.OracleKeeper
+	ctx := input.Ctx
+
+	// create msg server
+	msgServer := NewMsgServer(oracleKeeper)
+
+	// send messages
+	amount := sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(100)))
+	context := sdk.WrapSDKContext(ctx)
+	_, err := msgServer.FundRewardPool(context, types.NewMsgFundRewardPool(Addrs[0], amount))
+	require.NoError(t, err)
+
+	// query the reward pool
+	querier := NewQueryServer(oracleKeeper)
+	res, err := querier.RewardPool(context, &types.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+
+	// validation
+	require.Equal(t, amount, res.RewardPool)
+	require.Equal(t, InitialCoins.Sub(amount), input.BankKeeper.GetAllBalances(ctx, Addrs[0]))
+
+	// deposit more than the balance
+	_, err = msgServer.FundRewardPool(context, types.NewMsgFundRewardPool(Addrs[0], InitialCoins))
+	require.Error(t, err)
+}
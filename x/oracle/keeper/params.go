@@ -70,3 +70,15 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	k.paramSpace.Get(ctx, types.KeyLookbackDuration, &res)
 	return
 }
+
+// RewardDistributionWindow returns the number of blocks over which the reward pool is paid out
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
+	return
+}
+
+// RewardPoolFeeShare returns the share of the collected fees sent to the reward pool
+func (k Keeper) RewardPoolFeeShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyRewardPoolFeeShare, &res)
+	return
+}
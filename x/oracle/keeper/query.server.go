@@ -171,6 +171,47 @@ func (qs queryServer) VotePenaltyCounter(ctx context.Context, req *types.QueryVo
 
 }
 
+// AggregatePrevote queries the pending aggregate prevote submitted by a validator
+func (qs queryServer) AggregatePrevote(ctx context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
+	// Validate request information
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Get the prevote by the validator address
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	prevote, err := qs.Keeper.GetAggregateExchangeRatePrevote(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregatePrevoteResponse{AggregatePrevote: prevote}, nil
+}
+
+// AggregatePrevotes queries the pending aggregate prevotes of all validators
+func (qs queryServer) AggregatePrevotes(ctx context.Context, req *types.QueryAggregatePrevotesRequest) (*types.QueryAggregatePrevotesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	prevotes := []types.AggregateExchangeRatePrevote{}
+	qs.Keeper.IterateAggregateExchangeRatePrevotes(sdkCtx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		prevotes = append(prevotes, aggregatePrevote)
+		return false
+	})
+
+	return &types.QueryAggregatePrevotesResponse{AggregatePrevotes: prevotes}, nil
+}
+
+// RewardPool queries the balance of the oracle reward pool
+func (qs queryServer) RewardPool(ctx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryRewardPoolResponse{RewardPool: qs.Keeper.GetRewardPool(sdkCtx)}, nil
+}
+
 // SlashWindow queries the
 func (qs queryServer) SlashWindow(ctx context.Context, req *types.QuerySlashWindowRequest) (*types.QuerySlashWindowResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// GetRewardPool returns the balance of the oracle module account, used as reward pool
+func (k Keeper) GetRewardPool(ctx sdk.Context) sdk.Coins {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	return k.bankKeeper.GetAllBalances(ctx, moduleAddress)
+}
+
+// FundRewardPoolFromFees sends the RewardPoolFeeShare of the fees collected on the
+// current block from the fee collector to the reward pool
+func (k Keeper) FundRewardPoolFromFees(ctx sdk.Context) error {
+	feeShare := k.RewardPoolFeeShare(ctx)
+	if !feeShare.IsPositive() {
+		return nil
+	}
+
+	// Get the fees collected on the block, the balance held at the beginning of the block
+	// (e.g. the mint proceeds) is not part of them
+	collectedFees := sdk.Coins{}
+	snapshot := k.GetFeeCollectorSnapshot(ctx)
+	for _, coin := range k.getFeeCollectorBalance(ctx) {
+		if collected := coin.Amount.Sub(snapshot.AmountOf(coin.Denom)); collected.IsPositive() {
+			collectedFees = collectedFees.Add(sdk.NewCoin(coin.Denom, collected))
+		}
+	}
+	if collectedFees.IsZero() {
+		return nil
+	}
+
+	// Calculate the share of the fees (truncated, the remainder stays on the fee collector)
+	poolFees, _ := sdk.NewDecCoinsFromCoins(collectedFees...).MulDecTruncate(feeShare).TruncateDecimal()
+	if poolFees.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, poolFees)
+}
+
+// SetFeeCollectorSnapshot stores the current balance of the fee collector on the memory store
+func (k Keeper) SetFeeCollectorSnapshot(ctx sdk.Context) {
+	store := ctx.KVStore(k.memKey)
+	store.Set(types.FeeCollectorSnapshotKey, []byte(k.getFeeCollectorBalance(ctx).String()))
+}
+
+// GetFeeCollectorSnapshot returns the balance of the fee collector stored at the beginning of the block
+func (k Keeper) GetFeeCollectorSnapshot(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.memKey)
+	byteData := store.Get(types.FeeCollectorSnapshotKey)
+	if byteData == nil {
+		return sdk.Coins{}
+	}
+
+	snapshot, err := sdk.ParseCoinsNormalized(string(byteData))
+	if err != nil {
+		panic(err)
+	}
+	return snapshot
+}
+
+// getFeeCollectorBalance returns the balance of the fee collector module account
+func (k Keeper) getFeeCollectorBalance(ctx sdk.Context) sdk.Coins {
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	return k.bankKeeper.GetAllBalances(ctx, feeCollector)
+}
+
+// RewardBallotWinners pays the reward of the vote period to the validators who voted within the reward band.
+// The reward of the period is votePeriod / rewardDistributionWindow of the reward pool, distributed pro-rata
+// to the claim weight of each winner
+func (k Keeper) RewardBallotWinners(ctx sdk.Context, votePeriod uint64, rewardDistributionWindow uint64, validatorClaimMap map[string]types.Claim) {
+	// Sum the weight of the winners
+	ballotPowerSum := int64(0)
+	for _, winner := range validatorClaimMap {
+		ballotPowerSum += winner.Weight
+	}
+
+	// Nobody is rewarded on this period
+	if ballotPowerSum == 0 {
+		return
+	}
+
+	// Calculate the rewards of the vote period
+	rewardPool := k.GetRewardPool(ctx)
+	if rewardPool.IsZero() {
+		return
+	}
+	distributionRatio := sdk.NewDecFromInt(sdk.NewIntFromUint64(votePeriod)).QuoInt(sdk.NewIntFromUint64(rewardDistributionWindow))
+	periodRewards := sdk.NewDecCoinsFromCoins(rewardPool...).MulDecTruncate(distributionRatio)
+
+	// Sort the winners to distribute in a deterministic order
+	winners := make([]string, 0, len(validatorClaimMap))
+	for operator, winner := range validatorClaimMap {
+		if winner.Weight > 0 {
+			winners = append(winners, operator)
+		}
+	}
+	sort.Strings(winners)
+
+	// Pay the rewards to each winner
+	distributedReward := sdk.Coins{}
+	for _, operator := range winners {
+		winner := validatorClaimMap[operator]
+		receiverVal := k.StakingKeeper.Validator(ctx, winner.Recipient)
+		if receiverVal == nil {
+			continue
+		}
+
+		// Reward proportional to the claim weight
+		rewardCoins, _ := periodRewards.MulDecTruncate(sdk.NewDec(winner.Weight)).QuoDecTruncate(sdk.NewDec(ballotPowerSum)).TruncateDecimal()
+		if rewardCoins.IsZero() {
+			continue
+		}
+
+		k.distrKeeper.AllocateTokensToValidator(ctx, receiverVal, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		distributedReward = distributedReward.Add(rewardCoins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOracleReward,
+				sdk.NewAttribute(types.AttributeKeyValidator, operator),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewardCoins.String()),
+			),
+		)
+	}
+
+	// Move the distributed rewards to the distribution module account
+	if distributedReward.IsZero() {
+		return
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/kiichain/kiichain/x/oracle/types"
+	"github.com/kiichain/kiichain/x/oracle/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardBallotWinners(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	stakingKeeper := init.StakingKeeper
+	ctx := init.Ctx
+
+	// Create validators
+	stakingHandler := staking.NewHandler(stakingKeeper)
+	stakingAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	val0 := NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], stakingAmount)
+	val1 := NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], stakingAmount)
+	_, err := stakingHandler(ctx, val0)
+	require.NoError(t, err)
+	_, err = stakingHandler(ctx, val1)
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// Fund the reward pool
+	rewardPool := sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(1000)))
+	err = init.BankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, types.ModuleName, rewardPool)
+	require.NoError(t, err)
+	require.Equal(t, rewardPool, oracleKeeper.GetRewardPool(ctx))
+
+	// Val 0 has three times the weight of val 1
+	validatorClaimMap := map[string]types.Claim{
+		ValAddrs[0].String(): {Power: 10, Weight: 30, WinCount: 1, DidVote: true, Recipient: ValAddrs[0]},
+		ValAddrs[1].String(): {Power: 10, Weight: 10, WinCount: 1, DidVote: true, Recipient: ValAddrs[1]},
+	}
+
+	// Distribute a tenth of the pool (vote period 1, window 10)
+	oracleKeeper.RewardBallotWinners(ctx, 1, 10, validatorClaimMap)
+
+	// Validate the pool balance and the validator rewards
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(900))), oracleKeeper.GetRewardPool(ctx))
+	rewards0 := init.DistKeeper.GetValidatorOutstandingRewards(ctx, ValAddrs[0]).Rewards
+	rewards1 := init.DistKeeper.GetValidatorOutstandingRewards(ctx, ValAddrs[1]).Rewards
+	require.Equal(t, sdk.NewDec(75), rewards0.AmountOf(utils.MicroKiiDenom))
+	require.Equal(t, sdk.NewDec(25), rewards1.AmountOf(utils.MicroKiiDenom))
+
+	// Without winners nothing is distributed
+	validatorClaimMap = map[string]types.Claim{
+		ValAddrs[0].String(): {Power: 10, Weight: 0, WinCount: 0, DidVote: true, Recipient: ValAddrs[0]},
+	}
+	oracleKeeper.RewardBallotWinners(ctx, 1, 10, validatorClaimMap)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(900))), oracleKeeper.GetRewardPool(ctx))
+}
+
+func TestFundRewardPoolFromFees(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx
+
+	// Simulate mint proceeds held by the fee collector at the beginning of the block
+	minted := sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(5000)))
+	err := init.BankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, authTypes.FeeCollectorName, minted)
+	require.NoError(t, err)
+	oracleKeeper.SetFeeCollectorSnapshot(ctx)
+	require.Equal(t, minted, oracleKeeper.GetFeeCollectorSnapshot(ctx))
+
+	// Simulate collected fees
+	fees := sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(1000)))
+	err = init.BankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, authTypes.FeeCollectorName, fees)
+	require.NoError(t, err)
+
+	// Without fee share the pool is not funded
+	err = oracleKeeper.FundRewardPoolFromFees(ctx)
+	require.NoError(t, err)
+	require.True(t, oracleKeeper.GetRewardPool(ctx).IsZero())
+
+	// Send 10% of the fees to the reward pool
+	params := oracleKeeper.GetParams(ctx)
+	params.RewardPoolFeeShare = sdk.NewDecWithPrec(1, 1)
+	oracleKeeper.SetParams(ctx, params)
+
+	err = oracleKeeper.FundRewardPoolFromFees(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(100))), oracleKeeper.GetRewardPool(ctx))
+
+	feeCollector := init.AccountKeeper.GetModuleAddress(authTypes.FeeCollectorName)
+	require.Equal(t, sdk.NewInt(5900), init.BankKeeper.GetBalance(ctx, feeCollector, utils.MicroKiiDenom).Amount)
+
+	// Only the fees collected since the snapshot are shared
+	oracleKeeper.SetFeeCollectorSnapshot(ctx)
+	err = oracleKeeper.FundRewardPoolFromFees(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroKiiDenom, sdk.NewInt(100))), oracleKeeper.GetRewardPool(ctx))
+}
@@ -223,7 +223,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	// Set Oracle module
 	oracleKeeper := NewKeeper(appCodec, keyOracle, memKeys[types.MemStoreKey], paramsKeeper.Subspace(types.ModuleName),
-		accountKeeper, bankKeeper, stakingKeeper, distTypes.ModuleName)
+		accountKeeper, bankKeeper, distKeeper, stakingKeeper, distTypes.ModuleName)
 
 	oracleParams := types.DefaultParams()
 	oracleKeeper.SetParams(ctx, oracleParams)
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// V7MigrateStore apply the migration from v6 to v7 for the module
+func V7MigrateStore(ctx sdk.Context, k *keeper.Keeper) error {
+	// Keep the current parameters and set the new reward pool parameters with its default value
+	params := types.Params{
+		VotePeriod:               k.VotePeriod(ctx),
+		VoteThreshold:            k.VoteThreshold(ctx),
+		RewardBand:               k.RewardBand(ctx),
+		Whitelist:                k.Whitelist(ctx),
+		SlashFraction:            k.SlashFraction(ctx),
+		SlashWindow:              k.SlashWindow(ctx),
+		MinValidPerWindow:        k.MinValidPerWindow(ctx),
+		LookbackDuration:         k.LookbackDuration(ctx),
+		RewardDistributionWindow: types.DefaultRewardDistributionWindow,
+		RewardPoolFeeShare:       types.DefaultRewardPoolFeeShare,
+	}
+	k.SetParams(ctx, params)
+
+	ctx.Logger().Info("Migration to v7 completed successfully")
+
+	return nil
+}
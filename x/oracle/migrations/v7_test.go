@@ -0,0 +1,37 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/migrations"
+	"github.com/kiichain/kiichain/x/oracle/types"
+)
+
+// TestV6toV7Migration test the v6 to v7 migration
+func TestV6toV7Migration(t *testing.T) {
+	// Get the keeper and context
+	input := keeper.CreateTestInput(t)
+	k := input.OracleKeeper
+	ctx := input.Ctx
+
+	// Set a custom vote period, it must be kept after the migration
+	params := k.GetParams(ctx)
+	params.VotePeriod = 5
+	params.SlashWindow = 10
+	k.SetParams(ctx, params)
+
+	// Run the migration
+	err := migrations.V7MigrateStore(ctx, &k)
+	require.NoError(t, err)
+
+	// Check the params
+	newParams := k.GetParams(ctx)
+	require.Equal(t, uint64(5), newParams.VotePeriod)
+	require.Equal(t, uint64(10), newParams.SlashWindow)
+	require.Equal(t, types.DefaultRewardDistributionWindow, newParams.RewardDistributionWindow)
+	require.Equal(t, sdk.ZeroDec(), newParams.RewardPoolFeeShare)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/kiichain/kiichain/x/oracle/client/cli"
 	"github.com/kiichain/kiichain/x/oracle/client/rest"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/migrations"
 	"github.com/kiichain/kiichain/x/oracle/types"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -119,12 +120,25 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper types.Acc
 
 // ********************* IMPLEMENT AppModule INTERFACE ************************
 // ConsensusVersion returns the version the module's version
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // RegisterServices registers the module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.Kepper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.Kepper))
+
+	// Register the v6 to v7 migration
+	err := cfg.RegisterMigration(types.ModuleName, 6, func(ctx sdk.Context) error {
+		// Migrate the store
+		if err := migrations.V7MigrateStore(ctx, &am.Kepper); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants
@@ -168,7 +182,9 @@ func (am AppModule) Route() sdk.Route {
 func (am AppModule) QuerierRoute() string { return types.QuerierRoute }
 
 // BeginBlock returns the module's begin blocker
-func (am AppModule) BeginBlock(_ sdk.Context, _ int64) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.Kepper)
+}
 
 // MidBlock returns the module's mid blocker
 func (am AppModule) MidBlock(ctx sdk.Context, _ int64) {
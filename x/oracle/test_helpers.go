@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/kiichain/kiichain/x/oracle/keeper"
+	"github.com/kiichain/kiichain/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -53,3 +54,21 @@ func SetUp(t *testing.T) (keeper.TestInput, sdk.Handler) {
 
 	return input, oracleHandler
 }
+
+// prevoteAndVote submits the prevote on the previous block (previous vote period with VotePeriod = 1)
+// and reveals it with the vote on the current block height
+func prevoteAndVote(ctx sdk.Context, handler sdk.Handler, exchangeRate string, feeder sdk.AccAddress, validator sdk.ValAddress) (*sdk.Result, error) {
+	salt := "1"
+	hash := types.GetAggregateVoteHash(salt, exchangeRate, validator)
+
+	// send the prevote on the previous vote period
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, feeder, validator)
+	_, err := handler(ctx.WithBlockHeight(ctx.BlockHeight()-1), prevoteMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// reveal the exchange rate on the current vote period
+	voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRate, feeder, validator)
+	return handler(ctx, voteMsg)
+}
@@ -15,6 +15,9 @@ var (
 	ErrInvalidHash              = sdkerrors.Register(ModuleName, 6, "invalid hash")
 	ErrInvalidHashLength        = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", ed25519.TruncatedSize))
 	ErrVerificationFailed       = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch    = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote does not match with registered prevote")
+	ErrInvalidSaltLength        = sdkerrors.Register(ModuleName, 10, "invalid salt length")
+	ErrNoAggregatePrevote       = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote          = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoVoteTarget             = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom             = sdkerrors.Register(ModuleName, 14, "unknown denom")
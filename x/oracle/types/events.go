@@ -6,7 +6,11 @@ const (
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
+	EventTypeRevealMismatch     = "reveal_mismatch"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeOracleReward       = "oracle_reward"
+	EventTypeFundRewardPool     = "fund_reward_pool"
 )
 
 // Oracle module Attribute key
@@ -21,6 +25,9 @@ const (
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyHash          = "hash"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyDepositor     = "depositor"
 
 	AttributeValueCategory = ModuleName
 )
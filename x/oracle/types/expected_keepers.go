@@ -29,9 +29,16 @@ type AccountKeeper interface {
 // BankKeeper is expected keeper for bank module, because I need to handle
 // coins, get balance, receive and send coins
 type BankKeeper interface {
-	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin                                           //Check the oracle module account balance by denom
-	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins                                                    // Check the oracle module account balance all denom
-	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amount sdk.Coins) error // Transfer tokens between module accounts (e.g., moving slashed tokens)
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin                                               //Check the oracle module account balance by denom
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins                                                        // Check the oracle module account balance all denom
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amount sdk.Coins) error     // Transfer tokens between module accounts (e.g., moving slashed tokens)
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error // Deposit tokens on the reward pool
 	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 }
+
+// DistributionKeeper is expected keeper for distribution module, because I need to
+// pay the oracle rewards to the validators and their delegators
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) // Allocate rewards to a validator
+}
@@ -8,28 +8,31 @@ import (
 
 // NewGenesisState creates a new GenesisState object with the imput parameters
 func NewGenesisState(params Params, exchangeRateTuple []ExchangeRateTuple, feederDelegation []FeederDelegation,
-	penaltyCounters []PenaltyCounter, aggregateExchangeRateVote []AggregateExchangeRateVote, priceSnapshot PriceSnapshots, votePenaltyCounters []VotePenaltyCounter) *GenesisState {
+	penaltyCounters []PenaltyCounter, aggregateExchangeRateVote []AggregateExchangeRateVote, priceSnapshot PriceSnapshots, votePenaltyCounters []VotePenaltyCounter,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote) *GenesisState {
 	return &GenesisState{
-		Params:                     params,
-		ExchangeRates:              exchangeRateTuple,
-		FeederDelegations:          feederDelegation,
-		PenaltyCounters:            penaltyCounters,
-		AggregateExchangeRateVotes: aggregateExchangeRateVote,
-		PriceSnapshots:             priceSnapshot,
-		VotePenaltyCounters:        votePenaltyCounters,
+		Params:                        params,
+		ExchangeRates:                 exchangeRateTuple,
+		FeederDelegations:             feederDelegation,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVote,
+		PriceSnapshots:                priceSnapshot,
+		VotePenaltyCounters:           votePenaltyCounters,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 	}
 }
 
 // DefaultGenesisState creates a new genesis with the default parameters
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                     DefaultParams(),
-		ExchangeRates:              []ExchangeRateTuple{},
-		FeederDelegations:          []FeederDelegation{},
-		PenaltyCounters:            []PenaltyCounter{},
-		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
-		PriceSnapshots:             PriceSnapshots{},
-		VotePenaltyCounters:        []VotePenaltyCounter{},
+		Params:                        DefaultParams(),
+		ExchangeRates:                 []ExchangeRateTuple{},
+		FeederDelegations:             []FeederDelegation{},
+		PenaltyCounters:               []PenaltyCounter{},
+		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		PriceSnapshots:                PriceSnapshots{},
+		VotePenaltyCounters:           []VotePenaltyCounter{},
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 	}
 }
 
@@ -38,6 +38,8 @@ type GenesisState struct {
 	PriceSnapshots PriceSnapshots `protobuf:"bytes,6,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
 	// penalty_counters represents the array with the penalty counter by validator
 	PenaltyCounters []PenaltyCounter `protobuf:"bytes,7,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	// aggregate_exchange_rate_prevotes represents the array with the pending prevotes by validator
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,8,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +124,13 @@ func (m *GenesisState) GetPenaltyCounters() []PenaltyCounter {
 	return nil
 }
 
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
 // FeederDelegation is the structure on the genesis regarding the delegation process
 type FeederDelegation struct {
 	// feeder_address is the address delegated
@@ -239,39 +248,40 @@ func init() {
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xfd, 0x29, 0xc2, 0x63, 0x59, 0xe7, 0x15, 0x14, 0x2a, 0x91, 0x8d, 0x4a, 0x48,
-	0x45, 0x1b, 0x89, 0xb4, 0x72, 0x47, 0x2b, 0x30, 0xae, 0x53, 0x86, 0x38, 0xec, 0x12, 0xbc, 0xe4,
-	0x6d, 0x1a, 0x91, 0xc5, 0x96, 0xed, 0x56, 0xdb, 0x81, 0xef, 0xc0, 0x27, 0xe0, 0x03, 0xf0, 0x49,
-	0x76, 0x1c, 0x37, 0x4e, 0x80, 0xda, 0x2f, 0x82, 0x62, 0xbb, 0x65, 0x59, 0xdb, 0x48, 0xbb, 0x39,
-	0xaf, 0x9f, 0xe7, 0xfd, 0x39, 0xaf, 0x1f, 0x19, 0x35, 0x29, 0x27, 0x51, 0x06, 0x7e, 0x02, 0x39,
-	0x88, 0x54, 0x78, 0x8c, 0x53, 0x49, 0xf1, 0xd3, 0x2f, 0x69, 0x1a, 0x0d, 0x48, 0x9a, 0x7b, 0xd3,
-	0x45, 0xd7, 0xd3, 0xc2, 0x56, 0x33, 0xa1, 0x09, 0x55, 0x2a, 0xbf, 0x58, 0x69, 0x43, 0x6b, 0xc7,
-	0xb4, 0x61, 0x84, 0x93, 0x0b, 0xd3, 0xa5, 0xfd, 0x73, 0x1d, 0x3d, 0xfa, 0xa0, 0xfb, 0x9e, 0x4a,
-	0x22, 0x01, 0xbf, 0x41, 0x75, 0x2d, 0x70, 0xac, 0x3d, 0xab, 0xb3, 0x71, 0xf8, 0xdc, 0x5b, 0xca,
-	0xf1, 0x4e, 0x94, 0xb0, 0xb7, 0x76, 0xfd, 0x7b, 0xb7, 0x16, 0x18, 0x1b, 0xa6, 0xc8, 0x86, 0xcb,
-	0x68, 0x40, 0xf2, 0x04, 0x42, 0x4e, 0x24, 0x08, 0x67, 0x65, 0x6f, 0xb5, 0xb3, 0x71, 0x78, 0x50,
-	0xd1, 0xe8, 0xbd, 0x31, 0x04, 0x44, 0xc2, 0xc7, 0x21, 0xcb, 0xa0, 0xd7, 0x2a, 0x7a, 0xfe, 0xf8,
-	0xb3, 0x8b, 0xe7, 0xb6, 0x44, 0xb0, 0x09, 0xb7, 0x6a, 0x02, 0x7f, 0x46, 0xb8, 0x0f, 0x10, 0x03,
-	0x0f, 0x63, 0xc8, 0x20, 0x21, 0x32, 0xa5, 0xb9, 0x70, 0x56, 0x15, 0x74, 0xbf, 0x02, 0x7a, 0xac,
-	0x4c, 0xef, 0x66, 0x1e, 0xf3, 0x1f, 0xdb, 0xfd, 0x3b, 0x75, 0x81, 0x13, 0xf4, 0x78, 0x44, 0x25,
-	0x84, 0x0c, 0x72, 0x92, 0xc9, 0xab, 0x30, 0xa2, 0xc3, 0x5c, 0x02, 0x17, 0xce, 0x9a, 0x82, 0xbc,
-	0xaa, 0x80, 0x7c, 0xa2, 0x12, 0x4e, 0xb4, 0xed, 0xad, 0x76, 0x19, 0xcc, 0xce, 0x68, 0x6e, 0x47,
-	0xe0, 0xaf, 0xe8, 0x19, 0x49, 0x12, 0x5e, 0x80, 0x21, 0x2c, 0x4d, 0x31, 0x2c, 0xe4, 0xc2, 0x59,
-	0x57, 0xc0, 0xd7, 0x15, 0xc0, 0xa3, 0xa9, 0xff, 0xf6, 0xe0, 0x8a, 0x53, 0x18, 0x6e, 0x8b, 0x2c,
-	0x13, 0x08, 0x9c, 0xa2, 0x2d, 0xc6, 0xd3, 0x08, 0x42, 0x91, 0x13, 0x26, 0x06, 0x54, 0x0a, 0xa7,
-	0xae, 0x80, 0x9d, 0xaa, 0x10, 0x14, 0x8e, 0x53, 0x63, 0xe8, 0x3d, 0x31, 0xf7, 0x66, 0x97, 0xca,
-	0x22, 0xb0, 0x59, 0xe9, 0x1b, 0x9f, 0xa1, 0xc6, 0xdc, 0x34, 0x1f, 0x28, 0xd6, 0xcb, 0x2a, 0xd6,
-	0xa2, 0x49, 0x6e, 0xb1, 0xf2, 0x14, 0xdb, 0x7d, 0xd4, 0xb8, 0x7b, 0xb7, 0xf8, 0x05, 0xb2, 0x4d,
-	0x48, 0x48, 0x1c, 0x73, 0x10, 0x3a, 0xde, 0x0f, 0x83, 0x4d, 0x5d, 0x3d, 0xd2, 0x45, 0xbc, 0x8f,
-	0xb6, 0x47, 0x24, 0x4b, 0x63, 0x22, 0xe9, 0x7f, 0xe5, 0x8a, 0x52, 0x36, 0x66, 0x1b, 0x46, 0xdc,
-	0xfe, 0x6e, 0x21, 0xbb, 0x7c, 0xa2, 0xc5, 0x7e, 0x6b, 0xb1, 0x1f, 0x87, 0xa8, 0xb9, 0x28, 0x56,
-	0x8a, 0x77, 0xdf, 0x54, 0x05, 0x78, 0x3e, 0x4f, 0xbd, 0xe3, 0xeb, 0xb1, 0x6b, 0xdd, 0x8c, 0x5d,
-	0xeb, 0xef, 0xd8, 0xb5, 0xbe, 0x4d, 0xdc, 0xda, 0xcd, 0xc4, 0xad, 0xfd, 0x9a, 0xb8, 0xb5, 0xb3,
-	0x83, 0x24, 0x95, 0x83, 0xe1, 0xb9, 0x17, 0xd1, 0x0b, 0x7f, 0xda, 0x7d, 0xb6, 0xe8, 0xfa, 0x97,
-	0xbe, 0x79, 0x2b, 0xe4, 0x15, 0x03, 0x71, 0x5e, 0x57, 0x6f, 0x45, 0xf7, 0x5f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x0e, 0x1c, 0xd8, 0x5d, 0x89, 0x04, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xb6, 0x04, 0xd8, 0x52, 0x37, 0xdd, 0x06, 0x64, 0x22, 0xd5, 0x0d, 0x91, 0x90,
+	0x82, 0x02, 0x8e, 0xd4, 0x22, 0x71, 0x44, 0x0d, 0x14, 0xae, 0x91, 0x8b, 0x38, 0xf4, 0x62, 0xb6,
+	0xf6, 0xc4, 0xb1, 0x70, 0xbd, 0xab, 0xdd, 0x4d, 0xd4, 0x1e, 0xb8, 0x72, 0xe6, 0x09, 0x78, 0x00,
+	0x9e, 0xa4, 0xc7, 0x1e, 0x11, 0x07, 0x40, 0xc9, 0x8b, 0x54, 0xde, 0xdd, 0xb4, 0xcd, 0x3f, 0x4b,
+	0xbd, 0x6d, 0x66, 0xbe, 0x6f, 0x7e, 0xf1, 0xe7, 0xf1, 0xa2, 0x2a, 0xe5, 0x24, 0x4c, 0xa1, 0x1d,
+	0x43, 0x06, 0x22, 0x11, 0x1e, 0xe3, 0x54, 0x52, 0xfc, 0xf4, 0x6b, 0x92, 0x84, 0x7d, 0x92, 0x64,
+	0xde, 0xe4, 0xb0, 0xef, 0x69, 0x61, 0xad, 0x1a, 0xd3, 0x98, 0x2a, 0x55, 0x3b, 0x3f, 0x69, 0x43,
+	0x6d, 0xdb, 0x8c, 0x61, 0x84, 0x93, 0x53, 0x33, 0xa5, 0xf1, 0xa7, 0x8c, 0x1e, 0x7d, 0xd4, 0x73,
+	0x8f, 0x24, 0x91, 0x80, 0xdf, 0xa2, 0xb2, 0x16, 0x38, 0x56, 0xdd, 0x6a, 0xae, 0xef, 0x3d, 0xf3,
+	0x96, 0x72, 0xbc, 0xae, 0x12, 0x76, 0xd6, 0x2e, 0xfe, 0xee, 0x96, 0x7c, 0x63, 0xc3, 0x14, 0xd9,
+	0x70, 0x16, 0xf6, 0x49, 0x16, 0x43, 0xc0, 0x89, 0x04, 0xe1, 0xac, 0xd4, 0x57, 0x9b, 0xeb, 0x7b,
+	0x2f, 0x0b, 0x06, 0x1d, 0x1a, 0x83, 0x4f, 0x24, 0x7c, 0x1a, 0xb0, 0x14, 0x3a, 0xb5, 0x7c, 0xe6,
+	0xaf, 0x7f, 0xbb, 0x78, 0xae, 0x25, 0xfc, 0x0d, 0xb8, 0x55, 0x13, 0xf8, 0x0b, 0xc2, 0x3d, 0x80,
+	0x08, 0x78, 0x10, 0x41, 0x0a, 0x31, 0x91, 0x09, 0xcd, 0x84, 0xb3, 0xaa, 0xa0, 0xad, 0x02, 0xe8,
+	0x07, 0x65, 0x7a, 0x7f, 0xed, 0x31, 0xcf, 0xb1, 0xd5, 0x9b, 0xa9, 0x0b, 0x1c, 0xa3, 0xc7, 0x43,
+	0x2a, 0x21, 0x60, 0x90, 0x91, 0x54, 0x9e, 0x07, 0x21, 0x1d, 0x64, 0x12, 0xb8, 0x70, 0xd6, 0x14,
+	0xe4, 0x55, 0x01, 0xe4, 0x33, 0x95, 0xd0, 0xd5, 0xb6, 0x77, 0xda, 0x65, 0x30, 0xdb, 0xc3, 0xb9,
+	0x8e, 0xc0, 0xdf, 0xd0, 0x0e, 0x89, 0x63, 0x9e, 0x83, 0x21, 0x98, 0x4a, 0x31, 0xc8, 0xe5, 0xc2,
+	0xb9, 0xa7, 0x80, 0xaf, 0x0b, 0x80, 0x07, 0x13, 0xff, 0xed, 0xe0, 0xf2, 0x7f, 0x61, 0xb8, 0x35,
+	0xb2, 0x4c, 0x20, 0x70, 0x82, 0x36, 0x19, 0x4f, 0x42, 0x08, 0x44, 0x46, 0x98, 0xe8, 0x53, 0x29,
+	0x9c, 0xb2, 0x02, 0x36, 0x8b, 0x96, 0x20, 0x77, 0x1c, 0x19, 0x43, 0xe7, 0x89, 0x79, 0x6f, 0xf6,
+	0x54, 0x59, 0xf8, 0x36, 0x9b, 0xfa, 0x8d, 0x8f, 0x51, 0x65, 0x2e, 0xcd, 0xfb, 0x8a, 0xf5, 0xa2,
+	0x88, 0xb5, 0x28, 0xc9, 0x4d, 0x36, 0x93, 0xe2, 0x77, 0x0b, 0xd5, 0x97, 0xc5, 0xc8, 0x38, 0xe8,
+	0x24, 0x1f, 0x28, 0xd8, 0x9b, 0xbb, 0x26, 0xd9, 0xd5, 0x7e, 0x83, 0xde, 0x21, 0x05, 0x1a, 0xd1,
+	0xe8, 0xa1, 0xca, 0xec, 0x92, 0xe1, 0xe7, 0xc8, 0x36, 0xdb, 0x4a, 0xa2, 0x88, 0x83, 0xd0, 0xdf,
+	0xd9, 0x43, 0x7f, 0x43, 0x57, 0x0f, 0x74, 0x11, 0xb7, 0xd0, 0xd6, 0x90, 0xa4, 0x49, 0x44, 0x24,
+	0xbd, 0x51, 0xae, 0x28, 0x65, 0xe5, 0xba, 0x61, 0xc4, 0x8d, 0x9f, 0x16, 0xb2, 0xa7, 0xa3, 0x59,
+	0xec, 0xb7, 0x16, 0xfb, 0x71, 0x80, 0xaa, 0x8b, 0xf6, 0x5b, 0xf1, 0xee, 0xba, 0xde, 0x3e, 0x9e,
+	0x5f, 0xec, 0xce, 0xe1, 0xc5, 0xc8, 0xb5, 0x2e, 0x47, 0xae, 0xf5, 0x7f, 0xe4, 0x5a, 0x3f, 0xc6,
+	0x6e, 0xe9, 0x72, 0xec, 0x96, 0x7e, 0x8f, 0xdd, 0xd2, 0x71, 0x2b, 0x4e, 0x64, 0x7f, 0x70, 0xe2,
+	0x85, 0xf4, 0xb4, 0x3d, 0x99, 0x7e, 0x73, 0x38, 0x6b, 0x9b, 0x3b, 0x4b, 0x9e, 0x33, 0x10, 0x27,
+	0x65, 0x75, 0x67, 0xed, 0x5f, 0x0d, 0x00, 0x97, 0xe2, 0x0f, 0x2c, 0x11, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -294,6 +304,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PenaltyCounters) > 0 {
 		for iNdEx := len(m.PenaltyCounters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -525,6 +549,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -834,6 +864,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
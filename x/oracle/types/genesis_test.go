@@ -15,18 +15,20 @@ func TestNewGenesisState(t *testing.T) {
 	aggregateExchangeRateVote := []AggregateExchangeRateVote{}
 	priceSnapshot := PriceSnapshots{}
 	votePenaltyCounters := []VotePenaltyCounter{}
+	aggregateExchangeRatePrevotes := []AggregateExchangeRatePrevote{}
 
-	newGenesis := NewGenesisState(params, exchangeRateTuple, feederDelegation, penaltyCounters, aggregateExchangeRateVote, priceSnapshot, votePenaltyCounters)
+	newGenesis := NewGenesisState(params, exchangeRateTuple, feederDelegation, penaltyCounters, aggregateExchangeRateVote, priceSnapshot, votePenaltyCounters, aggregateExchangeRatePrevotes)
 
 	// expected result
 	expected := &GenesisState{
-		Params:                     params,
-		ExchangeRates:              exchangeRateTuple,
-		FeederDelegations:          feederDelegation,
-		AggregateExchangeRateVotes: aggregateExchangeRateVote,
-		PriceSnapshots:             priceSnapshot,
-		VotePenaltyCounters:        votePenaltyCounters,
-		PenaltyCounters:            penaltyCounters,
+		Params:                        params,
+		ExchangeRates:                 exchangeRateTuple,
+		FeederDelegations:             feederDelegation,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVote,
+		PriceSnapshots:                priceSnapshot,
+		VotePenaltyCounters:           votePenaltyCounters,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 	}
 
 	// validation
@@ -42,15 +44,17 @@ func TestDefaultGenesisState(t *testing.T) {
 	aggregateExchangeRateVote := []AggregateExchangeRateVote{}
 	priceSnapshot := PriceSnapshots{}
 	votePenaltyCounters := []VotePenaltyCounter{}
+	aggregateExchangeRatePrevotes := []AggregateExchangeRatePrevote{}
 
 	expected := &GenesisState{
-		Params:                     params,
-		ExchangeRates:              exchangeRateTuple,
-		FeederDelegations:          feederDelegation,
-		AggregateExchangeRateVotes: aggregateExchangeRateVote,
-		PriceSnapshots:             priceSnapshot,
-		VotePenaltyCounters:        votePenaltyCounters,
-		PenaltyCounters:            penaltyCounters,
+		Params:                        params,
+		ExchangeRates:                 exchangeRateTuple,
+		FeederDelegations:             feederDelegation,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVote,
+		PriceSnapshots:                priceSnapshot,
+		VotePenaltyCounters:           votePenaltyCounters,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 	}
 
 	// Create default genesis
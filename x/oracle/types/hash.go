@@ -0,0 +1,91 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AggregateVoteHash is the hash submitted on the prevote phase, it is the truncated
+// sha256 of the string "{salt}:{exchange rates}:{validator}"
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes the hash of an aggregate vote revealed on the next vote period
+func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
+	return tmhash.Sum([]byte(sourceStr))[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString converts the hex string into an AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements fmt.Stringer interface
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal does a byte comparison between two AggregateVoteHash
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
+
+// Empty returns true if the hash has no data
+func (h AggregateVoteHash) Empty() bool {
+	return len(h) == 0
+}
+
+// Bytes returns the raw hash
+func (h AggregateVoteHash) Bytes() []byte {
+	return h
+}
+
+// Format implements the fmt.Formatter interface
+func (h AggregateVoteHash) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		_, _ = s.Write([]byte(h.String()))
+	case 'p':
+		_, _ = s.Write([]byte(fmt.Sprintf("%p", h)))
+	default:
+		_, _ = s.Write([]byte(fmt.Sprintf("%X", []byte(h))))
+	}
+}
+
+// MarshalJSON marshals to JSON using the hex representation
+func (h AggregateVoteHash) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+// MarshalYAML marshals to YAML using the hex representation
+func (h AggregateVoteHash) MarshalYAML() (interface{}, error) {
+	return h.String(), nil
+}
+
+// UnmarshalJSON unmarshals from the JSON hex representation
+func (h *AggregateVoteHash) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	h2, err := AggregateVoteHashFromHexString(s)
+	if err != nil {
+		return err
+	}
+
+	*h = h2
+	return nil
+}
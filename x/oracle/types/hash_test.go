@@ -0,0 +1,34 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("addr1___________"))
+
+	// the hash must be deterministic and truncated
+	hash := GetAggregateVoteHash("salt", "12.00atom,1234.12eth", valAddr)
+	require.Len(t, hash.Bytes(), 20)
+	require.True(t, hash.Equal(GetAggregateVoteHash("salt", "12.00atom,1234.12eth", valAddr)))
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt2", "12.00atom,1234.12eth", valAddr)))
+	require.False(t, hash.Empty())
+
+	// hex conversion
+	decoded, err := AggregateVoteHashFromHexString(hash.String())
+	require.NoError(t, err)
+	require.Equal(t, hash, decoded)
+	_, err = AggregateVoteHashFromHexString("invalid")
+	require.Error(t, err)
+
+	// json conversion
+	bz, err := json.Marshal(hash)
+	require.NoError(t, err)
+	var unmarshalled AggregateVoteHash
+	require.NoError(t, json.Unmarshal(bz, &unmarshalled))
+	require.Equal(t, hash, unmarshalled)
+}
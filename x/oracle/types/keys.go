@@ -26,13 +26,17 @@ const (
 
 var (
 	// Prefixes to store the data
-	ExchangeRateKey              = []byte{0x01} // The latest exchange rate for each token (e.g., "BTC/USD" price)
-	FeederDelegationKey          = []byte{0x02} // The account delegated to submit oracle votes for a validator
-	VotePenaltyCounterKey        = []byte{0x03} // Tracks missed vote counts for validators
-	AggregateExchangeRateVoteKey = []byte{0x04} // Stores the exchange rate votes submitted by validators
-	VoteTargetKey                = []byte{0x05} // Stores the list of assets that validators must submit votes for
-	PriceSnapshotKey             = []byte{0x06} // Stores historical price snapshots at specific timestamps
-	SpamPreventionCounter        = []byte{0x07} // Stores repeated submissions by validator.
+	ExchangeRateKey                 = []byte{0x01} // The latest exchange rate for each token (e.g., "BTC/USD" price)
+	FeederDelegationKey             = []byte{0x02} // The account delegated to submit oracle votes for a validator
+	VotePenaltyCounterKey           = []byte{0x03} // Tracks missed vote counts for validators
+	AggregateExchangeRateVoteKey    = []byte{0x04} // Stores the exchange rate votes submitted by validators
+	VoteTargetKey                   = []byte{0x05} // Stores the list of assets that validators must submit votes for
+	PriceSnapshotKey                = []byte{0x06} // Stores historical price snapshots at specific timestamps
+	SpamPreventionCounter           = []byte{0x07} // Stores repeated submissions by validator.
+	AggregateExchangeRatePrevoteKey = []byte{0x08} // Stores the prevote hashes submitted by validators
+	RevealMismatchKey               = []byte{0x09} // Stores the validators whose vote did not match its prevote on the current period
+	PrevoteSpamPreventionCounter    = []byte{0x0A} // Stores repeated prevote submissions by validator.
+	FeeCollectorSnapshotKey         = []byte{0x0B} // Stores the fee collector balance at the beginning of the block (memory store)
 )
 
 // GetExchangeRateKey returns the key to search the latest exchange rate by denom
@@ -56,6 +60,21 @@ func GetAggregateExchangeRateVoteKey(valAddr sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(valAddr)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey returns the key to search the prevote hash submitted by validator address
+func GetAggregateExchangeRatePrevoteKey(valAddr sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetRevealMismatchKey returns the key to search if the validator revealed a vote that did not match its prevote
+func GetRevealMismatchKey(valAddr sdk.ValAddress) []byte {
+	return append(RevealMismatchKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetPrevoteSpamPreventionCounterKey returns the key to search the prevote spam prevention counter by validator address
+func GetPrevoteSpamPreventionCounterKey(valAddr sdk.ValAddress) []byte {
+	return append(PrevoteSpamPreventionCounter, address.MustLengthPrefix(valAddr)...)
+}
+
 // GetSpamPreventionCounterKey returns the key to search the spam prevention counter by validator address
 func GetSpamPreventionCounterKey(valAddr sdk.ValAddress) []byte {
 	return append(SpamPreventionCounter, address.MustLengthPrefix(valAddr)...)
@@ -1,19 +1,76 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxSaltLength is the max length of the salt used to build the prevote hash
+const MaxSaltLength = 64
+
 // ensure Msg interface be implemented at compile time
 var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
+	_ sdk.Msg = &MsgFundRewardPool{}
 )
 
+// NewMsgAggregateExchangeRatePrevote creates a MsgAggregateExchangeRatePrevote instance
+func NewMsgAggregateExchangeRatePrevote(hash AggregateVoteHash, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRatePrevote {
+	return &MsgAggregateExchangeRatePrevote{
+		Hash:      hash.String(),
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+	}
+}
+
+// GetSigners implements sdk.Msg interface
+// Returns the signer of the transaction which is the feeder
+func (msg MsgAggregateExchangeRatePrevote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg interface
+// ValidateBasic validates the message content (valid addresses and a valid hash)
+func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
+	// Check valid hash
+	hash, err := AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
+	}
+
+	// Check hash size
+	if len(hash) != tmhash.TruncatedSize {
+		return ErrInvalidHashLength
+	}
+
+	// Check valid feeder address
+	_, err = sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	// Check valid validator address
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	return nil
+}
+
 // NewMsgAggregateExchangeRateVote creates a MsgAggregateExchangeRateVote instance
-func NewMsgAggregateExchangeRateVote(exchangeRate string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
+func NewMsgAggregateExchangeRateVote(salt string, exchangeRate string, feeder sdk.AccAddress, validator sdk.ValAddress) *MsgAggregateExchangeRateVote {
 	return &MsgAggregateExchangeRateVote{
+		Salt:          salt,
 		ExchangeRates: exchangeRate,
 		Feeder:        feeder.String(),
 		Validator:     validator.String(),
@@ -67,6 +124,12 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 			return sdkerrors.Wrap(ErrInvalidExchangeRate, "overflow exchange rate")
 		}
 	}
+
+	// Check the salt used on the prevote hash
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxSaltLength {
+		return sdkerrors.Wrapf(ErrInvalidSaltLength, "salt length must be between 1 and %d", MaxSaltLength)
+	}
+
 	return nil
 }
 
@@ -106,3 +169,39 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgFundRewardPool creates a MsgFundRewardPool instance
+func NewMsgFundRewardPool(depositor sdk.AccAddress, amount sdk.Coins) *MsgFundRewardPool {
+	return &MsgFundRewardPool{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// GetSigners implements sdk.Msg interface
+// Returns the signer of the transaction which is the depositor
+func (msg MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// ValidateBasic implements sdk.Msg interface
+// ValidateBasic validates the message content (valid address and amount)
+func (msg MsgFundRewardPool) ValidateBasic() error {
+	// Validate depositor address
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid depositor address (%s)", err)
+	}
+
+	// Validate the deposited coins
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 
 	type test struct {
 		voter         sdk.AccAddress
+		salt          string
 		exchangeRates string
 		expectPass    bool
 	}
@@ -25,16 +27,18 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	overFlowExchangeRates := "1000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000.0atom,123.13eth"
 
 	tests := []test{
-		{addrs[0], exchangeRates, true},
-		{addrs[0], invalidExchangeRates, false},
-		{addrs[0], abstainExchangeRates, true},
-		{addrs[0], overFlowExchangeRates, false},
-		{sdk.AccAddress{}, exchangeRates, false},
+		{addrs[0], "1", exchangeRates, true},
+		{addrs[0], "1", invalidExchangeRates, false},
+		{addrs[0], "1", abstainExchangeRates, true},
+		{addrs[0], "1", overFlowExchangeRates, false},
+		{addrs[0], "", exchangeRates, false},
+		{addrs[0], strings.Repeat("a", MaxSaltLength+1), exchangeRates, false},
+		{sdk.AccAddress{}, "1", exchangeRates, false},
 	}
 
 	// validation
 	for i, test := range tests {
-		msg := NewMsgAggregateExchangeRateVote(test.exchangeRates, test.voter, sdk.ValAddress(test.voter))
+		msg := NewMsgAggregateExchangeRateVote(test.salt, test.exchangeRates, test.voter, sdk.ValAddress(test.voter))
 		if test.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 
@@ -49,6 +53,24 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	}
 }
 
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1___________"))
+	hash := GetAggregateVoteHash("1", "12.00atom,1234.12eth", sdk.ValAddress(addr))
+
+	// valid prevote
+	msg := NewMsgAggregateExchangeRatePrevote(hash, addr, sdk.ValAddress(addr))
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	// invalid hash
+	msg = NewMsgAggregateExchangeRatePrevote(AggregateVoteHash([]byte("invalid")), addr, sdk.ValAddress(addr))
+	require.Error(t, msg.ValidateBasic())
+
+	// invalid feeder
+	msg = NewMsgAggregateExchangeRatePrevote(hash, sdk.AccAddress{}, sdk.ValAddress(addr))
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgDelegateFeedConsent(t *testing.T) {
 	type test struct {
 		delegator  sdk.ValAddress
@@ -85,3 +107,21 @@ func TestMsgDelegateFeedConsent(t *testing.T) {
 	}
 
 }
+
+func TestMsgFundRewardPool(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1___________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukii", 100))
+
+	// valid deposit
+	msg := NewMsgFundRewardPool(addr, amount)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	// empty amount
+	msg = NewMsgFundRewardPool(addr, sdk.Coins{})
+	require.Error(t, msg.ValidateBasic())
+
+	// invalid depositor
+	msg = NewMsgFundRewardPool(sdk.AccAddress{}, amount)
+	require.Error(t, msg.ValidateBasic())
+}
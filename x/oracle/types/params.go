@@ -19,6 +19,9 @@ var (
 	KeySlashWindow       = []byte("SlashWindow")
 	KeyMinValidPerWindow = []byte("MinValidPerWindow")
 	KeyLookbackDuration  = []byte("LookbackDuration")
+
+	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
+	KeyRewardPoolFeeShare       = []byte("RewardPoolFeeShare")
 )
 
 // Default parameter value
@@ -39,6 +42,9 @@ var (
 	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00 | 0%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 0.05 | 5%
 	DefaultLookbackDuration  = uint64(3600)
+
+	DefaultRewardDistributionWindow = utils.BlocksPerYear // reward pool paid out over a year
+	DefaultRewardPoolFeeShare       = sdk.ZeroDec()       // 0.00 | 0%
 )
 
 // Implement the interface ParamSet
@@ -55,6 +61,9 @@ func DefaultParams() Params {
 		SlashWindow:       DefaultSlashWindow,
 		MinValidPerWindow: DefaultMinValidPerWindow,
 		LookbackDuration:  DefaultLookbackDuration,
+
+		RewardDistributionWindow: DefaultRewardDistributionWindow,
+		RewardPoolFeeShare:       DefaultRewardPoolFeeShare,
 	}
 }
 
@@ -75,6 +84,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyRewardPoolFeeShare, &p.RewardPoolFeeShare, validateRewardPoolFeeShare),
 	}
 }
 
@@ -113,6 +124,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
+	}
+
+	if p.RewardPoolFeeShare.GT(sdk.OneDec()) || p.RewardPoolFeeShare.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardPoolFeeShare must be between [0, 1]")
+	}
+
 	for _, denom := range p.Whitelist {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
@@ -237,3 +256,33 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64) // Data type must be uint64
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardPoolFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec) // Data type must be Decimal from cosmos sdk
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward pool fee share must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) { // Parameter cannot be greater than 1.00
+		return fmt.Errorf("reward pool fee share is too large: %s", v)
+	}
+
+	return nil
+}
@@ -47,6 +47,13 @@ type Params struct {
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
 	// How far back (in blocks) the module can compute historical price metrics
 	LookbackDuration uint64 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// Number of blocks over which the reward pool is paid out to the ballot winners. On each vote period
+	// a fraction of vote_period / reward_distribution_window of the pool balance is distributed
+	RewardDistributionWindow uint64 `protobuf:"varint,10,opt,name=reward_distribution_window,json=rewardDistributionWindow,proto3" json:"reward_distribution_window,omitempty" yaml:"reward_distribution_window"`
+	// Share of the collected transaction fees sent to the reward pool on each block. For instance,
+	// if reward_pool_fee_share = 0.1, 10% of the fees are sent to the oracle reward pool
+	// "github.com/cosmos/cosmos-sdk/types.Dec" = Cosmos SDK decimal data type
+	RewardPoolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=reward_pool_fee_share,json=rewardPoolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_pool_fee_share" yaml:"reward_pool_fee_share"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +116,13 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardDistributionWindow() uint64 {
+	if m != nil {
+		return m.RewardDistributionWindow
+	}
+	return 0
+}
+
 // Data type which has the name of the currency
 type Denom struct {
 	// Stores the name of a token pair, e.g: "BTC/USD"
@@ -186,6 +200,46 @@ func (m *AggregateExchangeRateVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
 
+// Data type that stores the salted hash of the aggregate vote submitted by a validator
+// on the prevote phase, the vote must be revealed on the next vote period
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2db12dafa3fbe2a3, []int{3}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 // Data type that represet a signle exchange rate vote inside AggregateExchangeRateVote
 type ExchangeRateTuple struct {
 	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
@@ -195,7 +249,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{3}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +289,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{4}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{5}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +328,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{5}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{6}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +382,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{6}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{7}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +437,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{7}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{8}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +491,7 @@ func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
 func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
 func (*VotePenaltyCounter) ProtoMessage()    {}
 func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2db12dafa3fbe2a3, []int{8}
+	return fileDescriptor_2db12dafa3fbe2a3, []int{9}
 }
 func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,6 +545,7 @@ func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.oracle.Params")
 	proto.RegisterType((*Denom)(nil), "kiichain.kiichain3.oracle.Denom")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "kiichain.kiichain3.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "kiichain.kiichain3.oracle.AggregateExchangeRatePrevote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "kiichain.kiichain3.oracle.ExchangeRateTuple")
 	proto.RegisterType((*OracleExchangeRate)(nil), "kiichain.kiichain3.oracle.OracleExchangeRate")
 	proto.RegisterType((*PriceSnapshotItem)(nil), "kiichain.kiichain3.oracle.PriceSnapshotItem")
@@ -502,68 +557,76 @@ func init() {
 func init() { proto.RegisterFile("oracle/params.proto", fileDescriptor_2db12dafa3fbe2a3) }
 
 var fileDescriptor_2db12dafa3fbe2a3 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x8f, 0x1b, 0x45,
-	0x14, 0xf6, 0xde, 0x2f, 0xf0, 0xd8, 0x26, 0xe7, 0x39, 0x07, 0x7c, 0x90, 0x78, 0xad, 0x89, 0x88,
-	0x0e, 0x29, 0xb1, 0xa5, 0x5c, 0x81, 0xb0, 0x44, 0x81, 0x39, 0x0e, 0x9d, 0x14, 0xc4, 0x31, 0x31,
-	0x87, 0x44, 0xb3, 0x1a, 0xef, 0x0e, 0xf6, 0xc8, 0xbb, 0x3b, 0xab, 0x9d, 0x71, 0x9c, 0x2b, 0x40,
-	0x94, 0x94, 0x29, 0xa1, 0xbb, 0x9a, 0x3f, 0x80, 0xbf, 0x21, 0x05, 0x45, 0x4a, 0x44, 0xb1, 0xa0,
-	0xbb, 0x86, 0x86, 0xc6, 0x1d, 0x1d, 0x9a, 0xd9, 0x59, 0x7b, 0xef, 0xf6, 0x12, 0x64, 0xa1, 0x54,
-	0xbb, 0xef, 0x7b, 0x6f, 0xbe, 0xf7, 0xe6, 0x9b, 0xf7, 0x46, 0x03, 0x76, 0x78, 0x4c, 0x5c, 0x9f,
-	0x76, 0x23, 0x12, 0x93, 0x40, 0x74, 0xa2, 0x98, 0x4b, 0x0e, 0x77, 0x27, 0x8c, 0xb9, 0x63, 0xc2,
-	0xc2, 0x4e, 0xf6, 0xb3, 0xdf, 0x49, 0xe3, 0xde, 0x6e, 0x8c, 0xf8, 0x88, 0xeb, 0xa8, 0xae, 0xfa,
-	0x4b, 0x17, 0xa0, 0xef, 0xb7, 0xc0, 0xd6, 0xb1, 0x66, 0x80, 0xef, 0x83, 0xca, 0x63, 0x2e, 0xa9,
-	0x13, 0xd1, 0x98, 0x71, 0xaf, 0x69, 0xb5, 0xad, 0xbd, 0x8d, 0xfe, 0x9b, 0xf3, 0xc4, 0x86, 0xa7,
-	0x24, 0xf0, 0x7b, 0x28, 0xe7, 0x44, 0x18, 0x28, 0xeb, 0x58, 0x1b, 0x30, 0x04, 0x6f, 0x68, 0x9f,
-	0x1c, 0xc7, 0x54, 0x8c, 0xb9, 0xef, 0x35, 0xd7, 0xda, 0xd6, 0x5e, 0xb9, 0xff, 0xe9, 0xb3, 0xc4,
-	0x2e, 0xfd, 0x9e, 0xd8, 0x77, 0x47, 0x4c, 0x8e, 0xa7, 0xc3, 0x8e, 0xcb, 0x83, 0xae, 0xcb, 0x45,
-	0xc0, 0x85, 0xf9, 0xdc, 0x17, 0xde, 0xa4, 0x2b, 0x4f, 0x23, 0x2a, 0x3a, 0x07, 0xd4, 0x9d, 0x27,
-	0xf6, 0xcd, 0x5c, 0xa6, 0x05, 0x1b, 0xc2, 0x35, 0x05, 0x0c, 0x32, 0x1b, 0x52, 0x50, 0x89, 0xe9,
-	0x8c, 0xc4, 0x9e, 0x33, 0x24, 0xa1, 0xd7, 0x5c, 0xd7, 0xc9, 0x0e, 0x56, 0x4e, 0x66, 0xb6, 0x95,
-	0xa3, 0x42, 0x18, 0xa4, 0x56, 0x9f, 0x84, 0x2a, 0x4d, 0x79, 0x36, 0x66, 0x92, 0xfa, 0x4c, 0xc8,
-	0xe6, 0x46, 0x7b, 0x7d, 0xaf, 0xf2, 0xa0, 0xdd, 0x79, 0xa1, 0xbe, 0x9d, 0x03, 0x1a, 0xf2, 0xa0,
-	0xff, 0xae, 0x2a, 0x63, 0x9e, 0xd8, 0xdb, 0x29, 0xf9, 0x82, 0x00, 0xfd, 0xfc, 0x87, 0x5d, 0xd6,
-	0x21, 0x0f, 0x99, 0x90, 0x78, 0xc9, 0xac, 0xd4, 0x13, 0x3e, 0x11, 0x63, 0xe7, 0x9b, 0x98, 0xb8,
-	0x92, 0xf1, 0xb0, 0xb9, 0xf9, 0xff, 0xd4, 0xbb, 0xcc, 0x86, 0x70, 0x4d, 0x03, 0x87, 0xc6, 0x86,
-	0x3d, 0x50, 0x4d, 0x23, 0x66, 0x2c, 0xf4, 0xf8, 0xac, 0xb9, 0xa5, 0xcf, 0xf9, 0xad, 0x79, 0x62,
-	0xef, 0xe4, 0xd7, 0xa7, 0x5e, 0x84, 0x2b, 0xda, 0xfc, 0x4a, 0x5b, 0xf0, 0x3b, 0xd0, 0x08, 0x58,
-	0xe8, 0x3c, 0x26, 0x3e, 0xf3, 0x54, 0x2b, 0x64, 0x1c, 0xaf, 0xe9, 0x8a, 0x3f, 0x5b, 0xb9, 0xe2,
-	0x77, 0xd2, 0x8c, 0xd7, 0x71, 0x22, 0x5c, 0x0f, 0x58, 0x78, 0xa2, 0xd0, 0x63, 0x1a, 0x9b, 0xfc,
-	0x47, 0xa0, 0xee, 0x73, 0x3e, 0x19, 0x12, 0x77, 0xe2, 0x78, 0xd3, 0x98, 0x68, 0xb9, 0xca, 0x7a,
-	0x03, 0xb7, 0xe6, 0x89, 0xdd, 0x4c, 0xe9, 0x0a, 0x21, 0x08, 0x6f, 0x67, 0xd8, 0x81, 0x81, 0x7a,
-	0xaf, 0xff, 0x78, 0x66, 0x97, 0xfe, 0x3a, 0xb3, 0x2d, 0xd4, 0x03, 0x9b, 0xfa, 0x60, 0xe0, 0x1d,
-	0xb0, 0x11, 0x92, 0x80, 0xea, 0xce, 0x2f, 0xf7, 0x6f, 0xcc, 0x13, 0xbb, 0x92, 0x12, 0x2a, 0x14,
-	0x61, 0xed, 0xec, 0x55, 0x7f, 0x38, 0xb3, 0x4b, 0x66, 0x6d, 0x09, 0xfd, 0x6d, 0x81, 0xdd, 0x8f,
-	0x46, 0xa3, 0x98, 0x8e, 0x88, 0xa4, 0x9f, 0x3c, 0x71, 0xc7, 0x24, 0x1c, 0x51, 0x4c, 0x24, 0x3d,
-	0xe1, 0x92, 0xc2, 0x9f, 0x2c, 0xd0, 0xa0, 0x06, 0x74, 0x62, 0xa2, 0x9a, 0x7a, 0x1a, 0xf9, 0x54,
-	0x34, 0x2d, 0xdd, 0x4d, 0xf7, 0x5e, 0xd2, 0x4d, 0x79, 0xae, 0x81, 0x5a, 0xd4, 0xff, 0xc0, 0x74,
-	0x96, 0xd1, 0xec, 0x3a, 0x5e, 0xd5, 0x64, 0xb0, 0xb0, 0x52, 0x60, 0x48, 0x0b, 0x18, 0xbc, 0x0b,
-	0x36, 0xd5, 0x54, 0xc5, 0x66, 0x56, 0xb7, 0xe7, 0x89, 0x5d, 0x5d, 0x4e, 0x5f, 0x8c, 0x70, 0xea,
-	0xbe, 0xb2, 0xdf, 0x5f, 0x2c, 0x50, 0x2f, 0x24, 0x50, 0x5c, 0x9e, 0x52, 0xd0, 0x28, 0x97, 0xe3,
-	0xd2, 0x30, 0xc2, 0xa9, 0x1b, 0x4e, 0x40, 0xed, 0x52, 0xd9, 0x26, 0xf7, 0xe1, 0xca, 0x7d, 0xd3,
-	0xb8, 0x46, 0x03, 0x84, 0xab, 0xf9, 0x6d, 0x5e, 0x29, 0xfc, 0xd7, 0x35, 0x00, 0x3f, 0xd7, 0xd2,
-	0xe6, 0xcb, 0x2f, 0x56, 0x64, 0xbd, 0xba, 0x8a, 0xd4, 0xbd, 0xe5, 0x13, 0x21, 0x9d, 0x69, 0xe4,
-	0x2d, 0x37, 0xbf, 0xca, 0xbd, 0x75, 0x14, 0xca, 0xe5, 0xbd, 0x95, 0xa3, 0x42, 0x18, 0x28, 0xeb,
-	0x4b, 0x6d, 0xc0, 0x01, 0xb8, 0x99, 0xf3, 0x39, 0x92, 0x05, 0x54, 0x48, 0x12, 0x44, 0xfa, 0xa2,
-	0x5c, 0xef, 0xb7, 0xe7, 0x89, 0x7d, 0xab, 0x40, 0xb1, 0x0c, 0x43, 0x78, 0x67, 0x49, 0x36, 0xc8,
-	0xd0, 0x2b, 0x72, 0x3e, 0xb5, 0x40, 0xfd, 0x38, 0x66, 0x2e, 0x7d, 0x14, 0x92, 0x48, 0x8c, 0xb9,
-	0x3c, 0x92, 0x34, 0x80, 0x8d, 0x4b, 0x7d, 0x90, 0x9d, 0x3a, 0x05, 0x8d, 0xb4, 0xa9, 0x9d, 0xe2,
-	0xe1, 0x57, 0x1e, 0xdc, 0x7f, 0xc9, 0x10, 0x14, 0x0f, 0xac, 0xbf, 0xa1, 0xe4, 0xc2, 0x90, 0x17,
-	0x3c, 0xe8, 0x1f, 0x0b, 0xd4, 0x2e, 0x95, 0x04, 0x1f, 0x02, 0x28, 0xcc, 0x7f, 0x4e, 0x05, 0x4b,
-	0xab, 0x70, 0x7b, 0x9e, 0xd8, 0xbb, 0xe6, 0xbe, 0x2b, 0xc4, 0x20, 0x5c, 0xcf, 0xc0, 0x85, 0x00,
-	0x7a, 0x98, 0x23, 0xc5, 0xef, 0x2c, 0x16, 0x30, 0x49, 0x03, 0xd1, 0x5c, 0xfb, 0xcf, 0x61, 0x2e,
-	0x28, 0x75, 0x75, 0x98, 0xaf, 0xe3, 0xd5, 0xc3, 0x5c, 0x58, 0x29, 0x30, 0x8c, 0x0a, 0x18, 0x3a,
-	0xb3, 0x00, 0x48, 0xc5, 0x1a, 0xcc, 0x48, 0xf4, 0x82, 0x73, 0xf8, 0x02, 0x6c, 0xc8, 0x19, 0x89,
-	0x4c, 0xdf, 0x7d, 0xb8, 0x72, 0x8b, 0x9b, 0xcb, 0x50, 0x71, 0x20, 0xac, 0xa9, 0xe0, 0x7b, 0x60,
-	0x71, 0xb1, 0x3a, 0x82, 0xba, 0x3c, 0xf4, 0x44, 0xda, 0x65, 0xf8, 0x46, 0x86, 0x3f, 0x4a, 0x61,
-	0xf4, 0x2d, 0x80, 0x27, 0xfa, 0xc9, 0x10, 0x12, 0x5f, 0x9e, 0x7e, 0xcc, 0xa7, 0xa1, 0xa4, 0x31,
-	0xbc, 0x0d, 0x40, 0xc0, 0x84, 0x70, 0x5c, 0x65, 0xa7, 0x4f, 0x0e, 0x5c, 0x56, 0x88, 0x0e, 0x80,
-	0x77, 0x40, 0x8d, 0x0c, 0x85, 0x24, 0x2c, 0x34, 0x11, 0x6b, 0x3a, 0xa2, 0x6a, 0xc0, 0x45, 0x90,
-	0x98, 0xba, 0x2e, 0x5d, 0xd0, 0xac, 0xa7, 0x41, 0x06, 0xd4, 0x41, 0xfd, 0xc3, 0x67, 0xe7, 0x2d,
-	0xeb, 0xf9, 0x79, 0xcb, 0xfa, 0xf3, 0xbc, 0x65, 0x3d, 0xbd, 0x68, 0x95, 0x9e, 0x5f, 0xb4, 0x4a,
-	0xbf, 0x5d, 0xb4, 0x4a, 0x5f, 0xdf, 0xcb, 0x09, 0x90, 0x9d, 0xdc, 0xe2, 0x67, 0xbf, 0xfb, 0xa4,
-	0x6b, 0xde, 0x59, 0x5a, 0x8a, 0xe1, 0x96, 0x7e, 0x36, 0xed, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x84, 0xfe, 0xca, 0x18, 0x7e, 0x09, 0x00, 0x00,
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6f, 0x23, 0xc5,
+	0x17, 0xf7, 0xe6, 0xd7, 0x7d, 0x3d, 0x4e, 0xbe, 0x97, 0x4c, 0x1c, 0xd8, 0x1c, 0x39, 0x6f, 0x98,
+	0xd3, 0x9d, 0x82, 0xe0, 0x1c, 0xe9, 0xae, 0x40, 0x44, 0xa2, 0xc0, 0xe4, 0x82, 0x22, 0x1d, 0x60,
+	0x26, 0x21, 0x48, 0x34, 0xab, 0xf1, 0xee, 0x9c, 0x3d, 0xf2, 0xee, 0xce, 0x6a, 0x67, 0x7c, 0xbe,
+	0x14, 0x20, 0xd1, 0x51, 0x5e, 0x09, 0x5d, 0x6a, 0x7a, 0xf8, 0x1b, 0xae, 0xa0, 0xb8, 0x12, 0x51,
+	0x2c, 0x28, 0x69, 0xa0, 0xa0, 0x71, 0x47, 0x87, 0x66, 0x76, 0x6c, 0xaf, 0xbd, 0xbe, 0x03, 0x0b,
+	0x51, 0xed, 0xbe, 0xcf, 0x7b, 0xf3, 0x99, 0x37, 0x9f, 0xf7, 0xde, 0xec, 0x82, 0x4d, 0x9e, 0x10,
+	0x2f, 0xa0, 0xfb, 0x31, 0x49, 0x48, 0x28, 0xea, 0x71, 0xc2, 0x25, 0x87, 0xdb, 0x5d, 0xc6, 0xbc,
+	0x0e, 0x61, 0x51, 0x7d, 0xf8, 0x72, 0xbf, 0x9e, 0xc5, 0xdd, 0xa8, 0xb6, 0x79, 0x9b, 0xeb, 0xa8,
+	0x7d, 0xf5, 0x96, 0x2d, 0x40, 0xbf, 0x5f, 0x03, 0x2b, 0x4d, 0xcd, 0x00, 0xdf, 0x06, 0x95, 0xc7,
+	0x5c, 0x52, 0x37, 0xa6, 0x09, 0xe3, 0xbe, 0x6d, 0xed, 0x5a, 0x7b, 0x4b, 0x8d, 0x57, 0x06, 0xa9,
+	0x03, 0xcf, 0x49, 0x18, 0x1c, 0xa0, 0x9c, 0x13, 0x61, 0xa0, 0xac, 0xa6, 0x36, 0x60, 0x04, 0xfe,
+	0xaf, 0x7d, 0xb2, 0x93, 0x50, 0xd1, 0xe1, 0x81, 0x6f, 0x2f, 0xec, 0x5a, 0x7b, 0xe5, 0xc6, 0x07,
+	0xcf, 0x52, 0xa7, 0xf4, 0x73, 0xea, 0xdc, 0x69, 0x33, 0xd9, 0xe9, 0xb5, 0xea, 0x1e, 0x0f, 0xf7,
+	0x3d, 0x2e, 0x42, 0x2e, 0xcc, 0xe3, 0xae, 0xf0, 0xbb, 0xfb, 0xf2, 0x3c, 0xa6, 0xa2, 0x7e, 0x48,
+	0xbd, 0x41, 0xea, 0x6c, 0xe5, 0x76, 0x1a, 0xb1, 0x21, 0xbc, 0xa6, 0x80, 0xd3, 0xa1, 0x0d, 0x29,
+	0xa8, 0x24, 0xb4, 0x4f, 0x12, 0xdf, 0x6d, 0x91, 0xc8, 0xb7, 0x17, 0xf5, 0x66, 0x87, 0x73, 0x6f,
+	0x66, 0x8e, 0x95, 0xa3, 0x42, 0x18, 0x64, 0x56, 0x83, 0x44, 0x6a, 0x9b, 0x72, 0xbf, 0xc3, 0x24,
+	0x0d, 0x98, 0x90, 0xf6, 0xd2, 0xee, 0xe2, 0x5e, 0xe5, 0xde, 0x6e, 0xfd, 0x85, 0xfa, 0xd6, 0x0f,
+	0x69, 0xc4, 0xc3, 0xc6, 0x6d, 0x95, 0xc6, 0x20, 0x75, 0xd6, 0x33, 0xf2, 0x11, 0x01, 0xfa, 0xee,
+	0x17, 0xa7, 0xac, 0x43, 0x1e, 0x32, 0x21, 0xf1, 0x98, 0x59, 0xa9, 0x27, 0x02, 0x22, 0x3a, 0xee,
+	0xa3, 0x84, 0x78, 0x92, 0xf1, 0xc8, 0x5e, 0xfe, 0x77, 0xea, 0x4d, 0xb2, 0x21, 0xbc, 0xa6, 0x81,
+	0x23, 0x63, 0xc3, 0x03, 0xb0, 0x9a, 0x45, 0xf4, 0x59, 0xe4, 0xf3, 0xbe, 0xbd, 0xa2, 0xeb, 0xfc,
+	0xea, 0x20, 0x75, 0x36, 0xf3, 0xeb, 0x33, 0x2f, 0xc2, 0x15, 0x6d, 0x7e, 0xa6, 0x2d, 0xf8, 0x25,
+	0xa8, 0x86, 0x2c, 0x72, 0x1f, 0x93, 0x80, 0xf9, 0xaa, 0x15, 0x86, 0x1c, 0xd7, 0x74, 0xc6, 0x1f,
+	0xce, 0x9d, 0xf1, 0x6b, 0xd9, 0x8e, 0xb3, 0x38, 0x11, 0xde, 0x08, 0x59, 0x74, 0xa6, 0xd0, 0x26,
+	0x4d, 0xcc, 0xfe, 0xc7, 0x60, 0x23, 0xe0, 0xbc, 0xdb, 0x22, 0x5e, 0xd7, 0xf5, 0x7b, 0x09, 0xd1,
+	0x72, 0x95, 0xf5, 0x01, 0x76, 0x06, 0xa9, 0x63, 0x67, 0x74, 0x85, 0x10, 0x84, 0xd7, 0x87, 0xd8,
+	0xa1, 0x81, 0xa0, 0x07, 0x6e, 0x98, 0xca, 0xfb, 0x4c, 0xc8, 0x84, 0xb5, 0x7a, 0x0a, 0x1e, 0x1e,
+	0x08, 0x68, 0xce, 0xdb, 0x83, 0xd4, 0x79, 0x7d, 0xa2, 0x4b, 0x66, 0xc4, 0x22, 0x6c, 0x67, 0xce,
+	0xc3, 0x9c, 0xcf, 0xe4, 0xfb, 0x95, 0x05, 0xb6, 0xcc, 0xca, 0x98, 0xf3, 0xc0, 0x7d, 0x44, 0xa9,
+	0x2b, 0x3a, 0x24, 0xa1, 0x76, 0x45, 0x2b, 0xf6, 0xd1, 0xdc, 0x8a, 0xed, 0x4c, 0xa4, 0x33, 0x49,
+	0x8a, 0x30, 0xcc, 0xf0, 0x26, 0xe7, 0xc1, 0x11, 0xa5, 0x27, 0x0a, 0x3c, 0xf8, 0xdf, 0x37, 0x17,
+	0x4e, 0xe9, 0xb7, 0x0b, 0xc7, 0x42, 0x07, 0x60, 0x59, 0x77, 0x20, 0xbc, 0x05, 0x96, 0x22, 0x12,
+	0x52, 0x3d, 0xe2, 0xe5, 0xc6, 0xf5, 0x41, 0xea, 0x54, 0x32, 0x5a, 0x85, 0x22, 0xac, 0x9d, 0x07,
+	0xab, 0x5f, 0x5f, 0x38, 0x25, 0xb3, 0xb6, 0x84, 0xfe, 0xb0, 0xc0, 0xf6, 0x7b, 0xed, 0x76, 0x42,
+	0xdb, 0x44, 0xd2, 0x07, 0x4f, 0xbc, 0x0e, 0x89, 0xda, 0x14, 0x13, 0x49, 0xcf, 0xb8, 0xa4, 0xf0,
+	0x5b, 0x0b, 0x54, 0xa9, 0x01, 0xdd, 0x84, 0xa8, 0xe9, 0xed, 0xc5, 0x01, 0x15, 0xb6, 0xa5, 0xc7,
+	0xe6, 0xad, 0x97, 0x8c, 0x4d, 0x9e, 0xeb, 0x54, 0x2d, 0x6a, 0xbc, 0x63, 0x46, 0xc8, 0x34, 0xc7,
+	0x2c, 0x5e, 0x35, 0x4d, 0xb0, 0xb0, 0x52, 0x60, 0x48, 0x0b, 0x18, 0xbc, 0x03, 0x96, 0xd5, 0xf5,
+	0x91, 0x98, 0x4b, 0x69, 0x7d, 0x90, 0x3a, 0xab, 0xe3, 0x6b, 0x26, 0x41, 0x38, 0x73, 0x4f, 0x9d,
+	0xf7, 0x7b, 0x0b, 0xec, 0xcc, 0x3c, 0x6f, 0x33, 0xa1, 0x2a, 0x5e, 0x69, 0xd8, 0x21, 0xa2, 0x53,
+	0xd4, 0x50, 0xa1, 0x08, 0x6b, 0xe7, 0x3f, 0xdd, 0x5b, 0xcf, 0x64, 0xaf, 0x15, 0x32, 0xe9, 0xb6,
+	0x02, 0xee, 0x75, 0xed, 0xc5, 0xc2, 0x4c, 0xe6, 0xbc, 0x6a, 0x26, 0xb5, 0xd9, 0x50, 0xd6, 0x54,
+	0xde, 0x3f, 0x58, 0x60, 0xa3, 0x20, 0x8c, 0xca, 0xc3, 0x57, 0x95, 0xb7, 0xad, 0xe9, 0x3c, 0x34,
+	0x8c, 0x70, 0xe6, 0x86, 0x5d, 0xb0, 0x36, 0x21, 0xb7, 0xc9, 0xfb, 0x68, 0xee, 0x36, 0xad, 0xce,
+	0xa8, 0x1d, 0xc2, 0xab, 0xf9, 0xf2, 0x4c, 0x25, 0xfe, 0xe3, 0x02, 0x80, 0x1f, 0xeb, 0x96, 0xc8,
+	0xa7, 0x5f, 0xcc, 0xc8, 0xfa, 0xef, 0x32, 0x52, 0x1f, 0x96, 0x80, 0x08, 0xe9, 0xf6, 0x62, 0x7f,
+	0x7c, 0xf8, 0x79, 0x3e, 0x2c, 0xc7, 0x91, 0x1c, 0x7f, 0x58, 0x72, 0x54, 0x08, 0x03, 0x65, 0x7d,
+	0xaa, 0x0d, 0x78, 0x0a, 0xb6, 0x72, 0x3e, 0x57, 0xb2, 0x90, 0x0a, 0x49, 0xc2, 0x58, 0x97, 0x7d,
+	0xb1, 0xb1, 0x3b, 0x1e, 0xf3, 0x99, 0x61, 0x08, 0x6f, 0x8e, 0xc9, 0x4e, 0x87, 0xe8, 0x94, 0x9c,
+	0x4f, 0x2d, 0xb0, 0xd1, 0x4c, 0x98, 0x47, 0x4f, 0x22, 0x12, 0x8b, 0x0e, 0x97, 0xc7, 0x92, 0x86,
+	0xb0, 0x3a, 0xd1, 0x07, 0xc3, 0xaa, 0x53, 0x50, 0xcd, 0x86, 0xd1, 0x2d, 0x16, 0xbf, 0x72, 0xef,
+	0xee, 0x4b, 0x86, 0xb7, 0x58, 0xb0, 0xc6, 0x92, 0x92, 0x0b, 0x43, 0x5e, 0xf0, 0xa0, 0x3f, 0x2d,
+	0xb0, 0x36, 0x91, 0x12, 0x7c, 0x08, 0xa0, 0x30, 0xef, 0x39, 0x15, 0x2c, 0xad, 0xc2, 0xcd, 0x41,
+	0xea, 0x6c, 0x9b, 0xe6, 0x2f, 0xc4, 0x20, 0xbc, 0x31, 0x04, 0x47, 0x02, 0xe8, 0x4b, 0x28, 0x56,
+	0xfc, 0xee, 0x68, 0x01, 0x93, 0x34, 0x14, 0xf6, 0xc2, 0xdf, 0x5e, 0x42, 0x05, 0xa5, 0xa6, 0x2f,
+	0xa1, 0x59, 0xbc, 0xfa, 0x12, 0x2a, 0xac, 0x14, 0x18, 0xc6, 0x05, 0x0c, 0x5d, 0x58, 0x00, 0x64,
+	0x62, 0x9d, 0xf6, 0x49, 0xfc, 0x82, 0x3a, 0x7c, 0x02, 0x96, 0x64, 0x9f, 0xc4, 0xa6, 0xef, 0xde,
+	0x9d, 0xbb, 0xc5, 0xcd, 0x05, 0xa4, 0x38, 0x10, 0xd6, 0x54, 0xf0, 0x0d, 0x30, 0xfa, 0xf2, 0xb9,
+	0x82, 0x7a, 0x3c, 0xf2, 0x45, 0xd6, 0x65, 0xf8, 0xfa, 0x10, 0x3f, 0xc9, 0x60, 0xf4, 0x05, 0x80,
+	0x67, 0xfa, 0x9f, 0x2e, 0x22, 0x81, 0x3c, 0x7f, 0x9f, 0xf7, 0x22, 0x75, 0x33, 0xdd, 0x04, 0x20,
+	0x64, 0x42, 0xb8, 0x9e, 0xb2, 0xb3, 0x7f, 0x42, 0x5c, 0x56, 0x88, 0x0e, 0x80, 0xb7, 0xc0, 0x1a,
+	0x69, 0x09, 0x49, 0x58, 0x64, 0x22, 0x16, 0x74, 0xc4, 0xaa, 0x01, 0x47, 0x41, 0xa2, 0xe7, 0x79,
+	0x74, 0x44, 0xb3, 0x98, 0x05, 0x19, 0x50, 0x07, 0x35, 0x1e, 0x3c, 0xbb, 0xac, 0x59, 0xcf, 0x2f,
+	0x6b, 0xd6, 0xaf, 0x97, 0x35, 0xeb, 0xe9, 0x55, 0xad, 0xf4, 0xfc, 0xaa, 0x56, 0xfa, 0xe9, 0xaa,
+	0x56, 0xfa, 0xfc, 0xcd, 0x9c, 0x00, 0xc3, 0xca, 0x8d, 0x5f, 0x9e, 0xec, 0x9b, 0xff, 0x60, 0xad,
+	0x44, 0x6b, 0x45, 0xff, 0xd6, 0xde, 0xff, 0x6b, 0x00, 0xe7, 0x7c, 0xc9, 0xba, 0x1e, 0x0b, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -614,6 +677,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.LookbackDuration != that1.LookbackDuration {
 		return false
 	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if !this.RewardPoolFeeShare.Equal(that1.RewardPoolFeeShare) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -636,6 +705,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.RewardPoolFeeShare.Size()
+		i -= size
+		if _, err := m.RewardPoolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.LookbackDuration != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.LookbackDuration))
 		i--
@@ -782,6 +866,48 @@ func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1075,6 +1201,11 @@ func (m *Params) Size() (n int) {
 	if m.LookbackDuration != 0 {
 		n += 1 + sovParams(uint64(m.LookbackDuration))
 	}
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovParams(uint64(m.RewardDistributionWindow))
+	}
+	l = m.RewardPoolFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1110,6 +1241,26 @@ func (m *AggregateExchangeRateVote) Size() (n int) {
 	return n
 }
 
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovParams(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
 func (m *ExchangeRateTuple) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1472,6 +1623,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPoolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1691,6 +1895,139 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
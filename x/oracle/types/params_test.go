@@ -63,6 +63,18 @@ func TestParamsValid(t *testing.T) {
 	p9 := DefaultParams()
 	require.NotNil(t, p9.ParamSetPairs())
 	require.NotNil(t, p9.String())
+
+	// reward distribution window smaller than vote period
+	p10 := DefaultParams()
+	p10.RewardDistributionWindow = p10.VotePeriod - 1
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// reward pool fee share greater than one
+	p11 := DefaultParams()
+	p11.RewardPoolFeeShare = sdk.NewDecWithPrec(11, 1)
+	err = p11.Validate()
+	require.Error(t, err)
 }
 
 func TestDefaultParams(t *testing.T) {
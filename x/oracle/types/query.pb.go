@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -745,6 +747,257 @@ func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCo
 	return nil
 }
 
+// QueryAggregatePrevoteRequest is the request for the Query/AggregatePrevote rpc
+type QueryAggregatePrevoteRequest struct {
+	// validator address to query for
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrevoteRequest{} }
+func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{17}
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatePrevoteRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevoteResponse is the response for the Query/AggregatePrevote rpc
+type QueryAggregatePrevoteResponse struct {
+	// aggregate_prevote is the pending prevote of the validator
+	AggregatePrevote AggregateExchangeRatePrevote `protobuf:"bytes,1,opt,name=aggregate_prevote,json=aggregatePrevote,proto3" json:"aggregate_prevote"`
+}
+
+func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePrevoteResponse{} }
+func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{18}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatePrevoteResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevoteResponse) GetAggregatePrevote() AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevote
+	}
+	return AggregateExchangeRatePrevote{}
+}
+
+// QueryAggregatePrevotesRequest is the request for the Query/AggregatePrevotes rpc
+type QueryAggregatePrevotesRequest struct {
+}
+
+func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePrevotesRequest{} }
+func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
+func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{19}
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.Merge(m, src)
+}
+func (m *QueryAggregatePrevotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatePrevotesRequest proto.InternalMessageInfo
+
+// QueryAggregatePrevotesResponse is the response for the Query/AggregatePrevotes rpc
+type QueryAggregatePrevotesResponse struct {
+	// aggregate_prevotes is the list of pending prevotes
+	AggregatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,1,rep,name=aggregate_prevotes,json=aggregatePrevotes,proto3" json:"aggregate_prevotes"`
+}
+
+func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePrevotesResponse{} }
+func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
+func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{20}
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatePrevotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.Merge(m, src)
+}
+func (m *QueryAggregatePrevotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatePrevotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatePrevotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatePrevotesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatePrevotesResponse) GetAggregatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregatePrevotes
+	}
+	return nil
+}
+
+// QueryRewardPoolRequest is the request for the Query/RewardPool rpc
+type QueryRewardPoolRequest struct {
+}
+
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{21}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+// QueryRewardPoolResponse is the response for the Query/RewardPool rpc
+type QueryRewardPoolResponse struct {
+	// reward_pool is the balance of the oracle module account
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+}
+
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{22}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
 // QuerySlashWindowRequest is the request for the Query/SlashWindow rpc
 type QuerySlashWindowRequest struct {
 }
@@ -753,7 +1006,7 @@ func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest
 func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowRequest) ProtoMessage()    {}
 func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{17}
+	return fileDescriptor_562b782cb9ac197e, []int{23}
 }
 func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,7 +1045,7 @@ func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowRespon
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{18}
+	return fileDescriptor_562b782cb9ac197e, []int{24}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1089,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{19}
+	return fileDescriptor_562b782cb9ac197e, []int{25}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1128,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+	return fileDescriptor_562b782cb9ac197e, []int{26}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,6 +1182,12 @@ func init() {
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "kiichain.kiichain3.oracle.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterRequest")
 	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "kiichain.kiichain3.oracle.QueryVotePenaltyCounterResponse")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "kiichain.kiichain3.oracle.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.kiichain3.oracle.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.kiichain3.oracle.QueryRewardPoolResponse")
 	proto.RegisterType((*QuerySlashWindowRequest)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowRequest")
 	proto.RegisterType((*QuerySlashWindowResponse)(nil), "kiichain.kiichain3.oracle.QuerySlashWindowResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.oracle.QueryParamsRequest")
@@ -938,76 +1197,91 @@ func init() {
 func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
 
 var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0x6d, 0x9e, 0x9b, 0xb4, 0x4c, 0x0c, 0x49, 0xb6, 0xa9, 0xdd, 0xac, 0x9a,
-	0x36, 0x48, 0x89, 0x37, 0x72, 0x08, 0xb4, 0x69, 0x41, 0x4a, 0x52, 0x2a, 0x38, 0xd5, 0x75, 0x2a,
-	0x90, 0xe0, 0xb0, 0x9a, 0x78, 0xa7, 0xf6, 0x2a, 0xce, 0xce, 0x66, 0x67, 0x93, 0x34, 0x8a, 0x72,
-	0xe9, 0xa9, 0x87, 0x1e, 0x90, 0x2a, 0xb8, 0x81, 0x7a, 0xe0, 0x84, 0x38, 0xf0, 0x17, 0x70, 0xe0,
-	0x14, 0x6e, 0x95, 0x90, 0x10, 0x07, 0x54, 0x50, 0xc2, 0x81, 0x3f, 0x03, 0x79, 0xf6, 0xad, 0xb3,
-	0x5b, 0xef, 0xfa, 0x47, 0xd4, 0x93, 0x77, 0xdf, 0xcf, 0xef, 0xdb, 0x79, 0xf3, 0x3e, 0x19, 0xa8,
-	0xf0, 0x58, 0xb5, 0xc1, 0x8d, 0xad, 0x6d, 0xee, 0xed, 0x15, 0x5d, 0x4f, 0xf8, 0x82, 0x4e, 0x6c,
-	0xd8, 0x76, 0xb5, 0xce, 0x6c, 0xa7, 0x18, 0x3e, 0x2c, 0x14, 0x83, 0x30, 0x2d, 0x57, 0x13, 0x35,
-	0xa1, 0xa2, 0x8c, 0xe6, 0x53, 0x90, 0xa0, 0x4d, 0xd6, 0x84, 0xa8, 0x35, 0xb8, 0xc1, 0x5c, 0xdb,
-	0x60, 0x8e, 0x23, 0x7c, 0xe6, 0xdb, 0xc2, 0x91, 0xe8, 0x1d, 0xc5, 0x16, 0x2e, 0xf3, 0xd8, 0x26,
-	0x1a, 0xf5, 0x25, 0x18, 0x7f, 0xd0, 0x6c, 0xf9, 0xc9, 0xe3, 0x6a, 0x9d, 0x39, 0x35, 0x5e, 0x61,
-	0x3e, 0xaf, 0xf0, 0xad, 0x6d, 0x2e, 0x7d, 0x9a, 0x83, 0x01, 0x8b, 0x3b, 0x62, 0x73, 0x9c, 0x5c,
-	0x25, 0x33, 0x43, 0x95, 0xe0, 0x65, 0xe9, 0xfc, 0xd3, 0x17, 0x85, 0xcc, 0x7f, 0x2f, 0x0a, 0x19,
-	0xfd, 0x19, 0x81, 0x89, 0x84, 0x64, 0xe9, 0x0a, 0x47, 0x72, 0xca, 0x21, 0x17, 0x34, 0x34, 0x39,
-	0xba, 0x4d, 0x8f, 0xf9, 0x5c, 0x15, 0xcb, 0x96, 0xe6, 0x8a, 0xa9, 0xe4, 0x8a, 0xf7, 0xd5, 0x4f,
-	0xb4, 0xe8, 0xca, 0xd9, 0xc3, 0x57, 0x05, 0x52, 0xc1, 0x8f, 0x14, 0xf5, 0x44, 0xe0, 0x5c, 0x4e,
-	0x40, 0x23, 0x91, 0x8b, 0xfe, 0x0b, 0x01, 0x2d, 0xc9, 0x8b, 0x60, 0xbf, 0x21, 0xa0, 0x29, 0x7a,
-	0x66, 0x0a, 0xe6, 0xb7, 0x66, 0xb2, 0xa5, 0x52, 0x07, 0xcc, 0x77, 0x9b, 0xc9, 0x09, 0xc0, 0xaf,
-	0x1d, 0xbe, 0x2a, 0x64, 0x7e, 0xfc, 0xbb, 0x30, 0x99, 0x12, 0x50, 0x66, 0xb6, 0x27, 0x2b, 0x63,
-	0x56, 0xb2, 0x37, 0xc2, 0xee, 0x1d, 0x18, 0x55, 0xf8, 0x97, 0xab, 0xbe, 0xbd, 0x73, 0xc2, 0x6b,
-	0x1e, 0x72, 0x71, 0x33, 0x12, 0x1a, 0x87, 0x73, 0x2c, 0x30, 0x29, 0xf0, 0x43, 0x95, 0xf0, 0x55,
-	0xff, 0x96, 0xc0, 0x58, 0x0a, 0x98, 0xe4, 0x13, 0x4f, 0x3d, 0xc9, 0x33, 0x6f, 0xf4, 0x24, 0xf5,
-	0x09, 0x18, 0x53, 0x54, 0x3e, 0x17, 0x3e, 0x7f, 0xc8, 0xbc, 0x1a, 0xf7, 0x5b, 0x2c, 0x3f, 0xc2,
-	0x29, 0x8d, 0xb9, 0x90, 0xe9, 0x14, 0x5c, 0xd8, 0x11, 0x3e, 0x37, 0xfd, 0xc0, 0x8e, 0x74, 0xb3,
-	0x3b, 0x27, 0xa1, 0xba, 0x0e, 0x57, 0x55, 0x7a, 0xd9, 0xb3, 0xab, 0x7c, 0xcd, 0x61, 0xae, 0xac,
-	0x0b, 0xff, 0x53, 0x5b, 0xfa, 0xc2, 0xdb, 0x0b, 0x5b, 0x3c, 0x23, 0x30, 0xd5, 0x21, 0x08, 0x9b,
-	0xd5, 0x60, 0xc4, 0x6d, 0xfa, 0x4d, 0x89, 0x01, 0x38, 0x1a, 0x33, 0x1d, 0x3e, 0x42, 0xac, 0xe0,
-	0xca, 0xbb, 0x38, 0x10, 0x23, 0x31, 0xb3, 0xac, 0x0c, 0xbb, 0xd1, 0x77, 0xfd, 0x63, 0x78, 0x5b,
-	0xa1, 0x79, 0xb8, 0xcb, 0xdc, 0xf0, 0x33, 0xd0, 0xf7, 0xe0, 0x52, 0x43, 0x88, 0x8d, 0x75, 0x56,
-	0xdd, 0x30, 0x25, 0xaf, 0x0a, 0xc7, 0x92, 0xea, 0xa4, 0xce, 0x56, 0x2e, 0x86, 0xf6, 0xb5, 0xc0,
-	0xac, 0x6f, 0x01, 0x8d, 0xe6, 0x23, 0xfc, 0xaf, 0x20, 0x8b, 0x27, 0xe9, 0xef, 0x32, 0x17, 0xb1,
-	0x4f, 0x77, 0x3d, 0xc0, 0x66, 0x91, 0x95, 0x51, 0x04, 0x9e, 0x3d, 0xb1, 0xc9, 0x0a, 0x88, 0xd6,
-	0x8b, 0x7e, 0x1f, 0x26, 0x55, 0xcb, 0x7b, 0x9c, 0x5b, 0xdc, 0xbb, 0xcb, 0x1b, 0xbc, 0xa6, 0xf6,
-	0x4f, 0x88, 0x7e, 0x1a, 0x46, 0x76, 0x58, 0xc3, 0xb6, 0x98, 0x2f, 0x3c, 0x93, 0x59, 0x96, 0x87,
-	0x53, 0x36, 0xdc, 0xb2, 0x2e, 0x5b, 0x96, 0x17, 0x19, 0xf9, 0x3b, 0x70, 0x25, 0xa5, 0x20, 0xd2,
-	0xb9, 0x0c, 0x43, 0x8f, 0x38, 0xb7, 0xa2, 0xc5, 0xce, 0x37, 0x0d, 0xcd, 0x3a, 0xfa, 0x03, 0xc8,
-	0xb7, 0x66, 0xa6, 0xcc, 0x1d, 0xd6, 0xf0, 0xf7, 0x56, 0xc5, 0xb6, 0xe3, 0x73, 0xef, 0xd4, 0x80,
-	0x9e, 0x10, 0x28, 0xa4, 0xd6, 0x44, 0x4c, 0x26, 0xe4, 0xd4, 0x38, 0xba, 0x81, 0xdb, 0xac, 0x06,
-	0xfe, 0x1e, 0xd6, 0x5e, 0x42, 0x51, 0xba, 0xd3, 0x66, 0x6b, 0x5d, 0x93, 0xb5, 0x06, 0x93, 0xf5,
-	0x2f, 0x6c, 0xc7, 0x12, 0xbb, 0xe1, 0x0c, 0xaf, 0xe2, 0x35, 0x89, 0xb9, 0x10, 0xd7, 0x0d, 0xb8,
-	0xb8, 0xab, 0x2c, 0xa6, 0xeb, 0x89, 0x9a, 0xc7, 0x65, 0x38, 0x3a, 0x23, 0x81, 0xb9, 0x8c, 0x56,
-	0x3d, 0x87, 0x93, 0x53, 0x56, 0x32, 0x11, 0x96, 0x2e, 0xe3, 0xfa, 0x09, 0xad, 0x58, 0xf5, 0x16,
-	0x0c, 0x06, 0x72, 0x82, 0xfc, 0xa6, 0x3a, 0xdd, 0x83, 0x20, 0x15, 0x13, 0x4a, 0x7f, 0x0d, 0xc3,
-	0x80, 0x2a, 0x49, 0x7f, 0x26, 0x70, 0x21, 0xb6, 0x86, 0x16, 0x3a, 0x54, 0x49, 0x53, 0x2b, 0xed,
-	0xfd, 0xfe, 0x92, 0x02, 0x02, 0xfa, 0xe2, 0x93, 0xdf, 0xff, 0x7d, 0x7e, 0xc6, 0xa0, 0x73, 0x46,
-	0x98, 0x64, 0xa0, 0x4c, 0xaa, 0xdd, 0x27, 0x8d, 0x7d, 0xf5, 0x7b, 0x60, 0xc4, 0x56, 0x1f, 0xfd,
-	0x89, 0xc0, 0x70, 0x4c, 0x49, 0x68, 0x5f, 0xed, 0xc3, 0xcf, 0xaa, 0x2d, 0xf6, 0x99, 0x85, 0xa8,
-	0x8b, 0x0a, 0xf5, 0x0c, 0xbd, 0x9e, 0x86, 0x3a, 0x86, 0x56, 0xd2, 0xe7, 0x04, 0xce, 0xa1, 0x42,
-	0xd0, 0x62, 0xb7, 0x96, 0x71, 0x85, 0xd1, 0x8c, 0x9e, 0xe3, 0x11, 0xdc, 0x0d, 0x05, 0x6e, 0x8a,
-	0x16, 0xd2, 0xc0, 0xa1, 0x12, 0xd1, 0x1f, 0x08, 0x64, 0x23, 0x1b, 0x9d, 0x96, 0xba, 0x75, 0x6a,
-	0x57, 0x06, 0x6d, 0xa1, 0xaf, 0x1c, 0x44, 0x38, 0xab, 0x10, 0x5e, 0xa7, 0xd7, 0xd2, 0x10, 0x46,
-	0x05, 0x85, 0xfe, 0x46, 0x20, 0x97, 0x24, 0x0a, 0xf4, 0x76, 0xb7, 0xde, 0x1d, 0xf4, 0x46, 0xbb,
-	0x73, 0xba, 0x64, 0x64, 0xf0, 0x81, 0x62, 0x30, 0x4f, 0x8b, 0x69, 0x0c, 0xe2, 0x2a, 0x65, 0xd6,
-	0x11, 0xf2, 0xf7, 0x04, 0x06, 0xd4, 0xe6, 0xa6, 0xb3, 0xdd, 0xfa, 0x47, 0x95, 0x47, 0x9b, 0xeb,
-	0x31, 0x1a, 0xe1, 0xdd, 0x54, 0xf0, 0x4a, 0x74, 0x3e, 0x0d, 0x5e, 0x53, 0x7e, 0xa4, 0xb1, 0xff,
-	0xba, 0x9a, 0x1d, 0xd0, 0x5f, 0x09, 0x5c, 0x7a, 0x7d, 0xdf, 0xd3, 0x0f, 0xbb, 0x75, 0x4f, 0x91,
-	0x1c, 0xed, 0x66, 0xff, 0x89, 0xc8, 0xe0, 0xb6, 0x62, 0xb0, 0x48, 0x17, 0xda, 0x18, 0xb4, 0xc4,
-	0x41, 0x1a, 0xfb, 0x71, 0xf9, 0x38, 0x30, 0x1e, 0xa9, 0x72, 0xf4, 0x0f, 0x02, 0xb4, 0x7d, 0x9b,
-	0xd3, 0x5b, 0xbd, 0xcc, 0x6a, 0xa2, 0x54, 0x69, 0x4b, 0xa7, 0x49, 0x45, 0x2a, 0x9f, 0x29, 0x2a,
-	0xab, 0x74, 0xb9, 0x2f, 0x2a, 0x49, 0x22, 0x46, 0xbf, 0x23, 0x90, 0x8d, 0x88, 0x4b, 0xf7, 0x1b,
-	0xdb, 0x2e, 0x52, 0xdd, 0x6f, 0x6c, 0x82, 0x7a, 0xe9, 0xd3, 0x8a, 0x43, 0x81, 0x5e, 0x69, 0xe3,
-	0x20, 0x9b, 0xd1, 0x66, 0xa0, 0x61, 0xf4, 0x29, 0x81, 0xc1, 0x40, 0x66, 0x68, 0xd7, 0x89, 0x8d,
-	0xe9, 0x9b, 0x56, 0xec, 0x35, 0x1c, 0x01, 0x15, 0x14, 0xa0, 0x09, 0x3a, 0xd6, 0x06, 0x28, 0x90,
-	0xb7, 0x95, 0x7b, 0x87, 0x47, 0x79, 0xf2, 0xf2, 0x28, 0x4f, 0xfe, 0x39, 0xca, 0x93, 0xaf, 0x8f,
-	0xf3, 0x99, 0x97, 0xc7, 0xf9, 0xcc, 0x9f, 0xc7, 0xf9, 0xcc, 0x97, 0xb3, 0x35, 0xdb, 0xaf, 0x6f,
-	0xaf, 0x17, 0xab, 0x62, 0xf3, 0x24, 0xb9, 0xd5, 0xd4, 0x78, 0x1c, 0x16, 0xf2, 0xf7, 0x5c, 0x2e,
-	0xd7, 0x07, 0xd5, 0xff, 0xb4, 0x85, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x27, 0xd2, 0x49, 0xc8,
-	0x21, 0x0e, 0x00, 0x00,
+	// 1344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0xa5, 0x3f, 0x9f, 0xdb, 0x34, 0x99, 0x98, 0x26, 0xd9, 0xa6, 0x76, 0xb3, 0xea,
+	0x8f, 0x20, 0x9a, 0xdd, 0xd4, 0xa1, 0xf4, 0x27, 0x15, 0x49, 0xda, 0x0a, 0x4e, 0x75, 0xdd, 0x0a,
+	0x24, 0x38, 0xac, 0x26, 0xde, 0xa9, 0xb3, 0xc4, 0xd9, 0xd9, 0xee, 0x6c, 0x92, 0x46, 0x55, 0x2f,
+	0xbd, 0x50, 0xa9, 0x15, 0x42, 0xaa, 0x40, 0xe2, 0x00, 0xea, 0x81, 0x13, 0xe2, 0xc0, 0x5f, 0x80,
+	0x10, 0xa7, 0x72, 0x40, 0xaa, 0x84, 0x84, 0x38, 0x15, 0xd4, 0x72, 0xe0, 0xcf, 0x40, 0x9e, 0x7d,
+	0xbb, 0xd9, 0xcd, 0x7a, 0xed, 0x38, 0x70, 0xb2, 0xf7, 0xbd, 0x79, 0x6f, 0x3e, 0xdf, 0x9d, 0xf1,
+	0xcc, 0x57, 0x06, 0x2a, 0x7c, 0xd6, 0x68, 0x71, 0xf3, 0xce, 0x0a, 0xf7, 0xd7, 0x0d, 0xcf, 0x17,
+	0x81, 0xa0, 0x63, 0x4b, 0x8e, 0xd3, 0x58, 0x64, 0x8e, 0x6b, 0x44, 0x5f, 0x66, 0x8c, 0x70, 0x98,
+	0x56, 0x6a, 0x8a, 0xa6, 0x50, 0xa3, 0xcc, 0xf6, 0xb7, 0xb0, 0x40, 0x1b, 0x6f, 0x0a, 0xd1, 0x6c,
+	0x71, 0x93, 0x79, 0x8e, 0xc9, 0x5c, 0x57, 0x04, 0x2c, 0x70, 0x84, 0x2b, 0x31, 0x5b, 0x6e, 0x08,
+	0xb9, 0x2c, 0xa4, 0xb9, 0xc0, 0x24, 0x37, 0x57, 0x4f, 0x2f, 0xf0, 0x80, 0x9d, 0x36, 0x1b, 0xc2,
+	0x71, 0x31, 0x3f, 0x8c, 0x08, 0x1e, 0xf3, 0xd9, 0x32, 0x16, 0xe9, 0x17, 0x60, 0xf4, 0x46, 0x1b,
+	0xe9, 0xea, 0xdd, 0xc6, 0x22, 0x73, 0x9b, 0xbc, 0xce, 0x02, 0x5e, 0xe7, 0x77, 0x56, 0xb8, 0x0c,
+	0x68, 0x09, 0x76, 0xd9, 0xdc, 0x15, 0xcb, 0xa3, 0xe4, 0x28, 0x99, 0xdc, 0x57, 0x0f, 0x1f, 0x2e,
+	0xec, 0x7d, 0xf8, 0xb4, 0x52, 0xf8, 0xe7, 0x69, 0xa5, 0xa0, 0x3f, 0x26, 0x30, 0xd6, 0xa1, 0x58,
+	0x7a, 0xc2, 0x95, 0x9c, 0x72, 0x28, 0x85, 0x13, 0x5a, 0x1c, 0xd3, 0x96, 0xcf, 0x02, 0xae, 0x9a,
+	0x15, 0xab, 0x53, 0x46, 0xae, 0x78, 0xe3, 0xba, 0xfa, 0x48, 0x36, 0x9d, 0xdb, 0xf9, 0xec, 0x45,
+	0x85, 0xd4, 0xa9, 0xc8, 0x64, 0x12, 0x38, 0x87, 0x3b, 0xd0, 0x48, 0xd4, 0xa2, 0xff, 0x48, 0x40,
+	0xeb, 0x94, 0x45, 0xd8, 0x2f, 0x08, 0x68, 0x4a, 0x9e, 0x95, 0xc3, 0xfc, 0xda, 0x64, 0xb1, 0x5a,
+	0xed, 0xc2, 0x7c, 0xa5, 0x5d, 0xdc, 0x01, 0xfc, 0xd8, 0xb3, 0x17, 0x95, 0xc2, 0x77, 0x7f, 0x56,
+	0xc6, 0x73, 0x06, 0xd4, 0x98, 0xe3, 0xcb, 0xfa, 0x88, 0xdd, 0x39, 0x9b, 0x50, 0xf7, 0x3a, 0x0c,
+	0x2b, 0xfe, 0xd9, 0x46, 0xe0, 0xac, 0x6e, 0xe8, 0x9a, 0x86, 0x52, 0x3a, 0x8c, 0x82, 0x46, 0x61,
+	0x0f, 0x0b, 0x43, 0x0a, 0x7e, 0x5f, 0x3d, 0x7a, 0xd4, 0xbf, 0x24, 0x30, 0x92, 0x03, 0xd3, 0x79,
+	0xc5, 0x73, 0x57, 0x72, 0xc7, 0xff, 0xba, 0x92, 0xfa, 0x18, 0x8c, 0x28, 0x29, 0x1f, 0x88, 0x80,
+	0xdf, 0x62, 0x7e, 0x93, 0x07, 0xb1, 0xca, 0x77, 0x60, 0x34, 0x9b, 0x42, 0xa5, 0x13, 0xb0, 0x7f,
+	0x55, 0x04, 0xdc, 0x0a, 0xc2, 0x38, 0xca, 0x2d, 0xae, 0x6e, 0x0c, 0xd5, 0x75, 0x38, 0xaa, 0xca,
+	0x6b, 0xbe, 0xd3, 0xe0, 0x37, 0x5d, 0xe6, 0xc9, 0x45, 0x11, 0xbc, 0xe7, 0xc8, 0x40, 0xf8, 0xeb,
+	0xd1, 0x14, 0x8f, 0x09, 0x4c, 0x74, 0x19, 0x84, 0x93, 0x35, 0x61, 0xc0, 0x6b, 0xe7, 0x2d, 0x89,
+	0x03, 0x70, 0x6b, 0x4c, 0x76, 0x79, 0x09, 0xa9, 0x86, 0x73, 0x87, 0x70, 0x43, 0x0c, 0xa4, 0xc2,
+	0xb2, 0x7e, 0xc0, 0x4b, 0x3e, 0xeb, 0x97, 0x61, 0x48, 0xd1, 0xdc, 0x5a, 0x63, 0x5e, 0xf4, 0x1a,
+	0xe8, 0x1b, 0x30, 0xd8, 0x12, 0x62, 0x69, 0x81, 0x35, 0x96, 0x2c, 0xc9, 0x1b, 0xc2, 0xb5, 0xa5,
+	0x5a, 0xa9, 0x9d, 0xf5, 0x83, 0x51, 0xfc, 0x66, 0x18, 0xd6, 0xef, 0x00, 0x4d, 0xd6, 0x23, 0xfe,
+	0xc7, 0x50, 0xc4, 0x95, 0x0c, 0xd6, 0x98, 0x87, 0xec, 0xc7, 0x7b, 0x2e, 0x60, 0xbb, 0xc9, 0xdc,
+	0x30, 0x82, 0x17, 0x37, 0x62, 0xb2, 0x0e, 0x22, 0x7e, 0xd0, 0xaf, 0xc3, 0xb8, 0x9a, 0xf2, 0x1a,
+	0xe7, 0x36, 0xf7, 0xaf, 0xf0, 0x16, 0x6f, 0xaa, 0xf3, 0x29, 0xa2, 0x3f, 0x0e, 0x03, 0xab, 0xac,
+	0xe5, 0xd8, 0x2c, 0x10, 0xbe, 0xc5, 0x6c, 0xdb, 0xc7, 0x5d, 0x76, 0x20, 0x8e, 0xce, 0xda, 0xb6,
+	0x9f, 0xd8, 0xf2, 0x97, 0xe0, 0x48, 0x4e, 0x43, 0x94, 0x73, 0x18, 0xf6, 0xdd, 0xe6, 0xdc, 0x4e,
+	0x36, 0xdb, 0xdb, 0x0e, 0xb4, 0xfb, 0xe8, 0x37, 0xa0, 0x1c, 0xef, 0x99, 0x1a, 0x77, 0x59, 0x2b,
+	0x58, 0x9f, 0x17, 0x2b, 0x6e, 0xc0, 0xfd, 0x6d, 0x03, 0x3d, 0x20, 0x50, 0xc9, 0xed, 0x89, 0x4c,
+	0x16, 0x94, 0xd4, 0x76, 0xf4, 0xc2, 0xb4, 0xd5, 0x08, 0xf3, 0x5b, 0x38, 0xf6, 0x3a, 0x34, 0xa5,
+	0xab, 0x99, 0x58, 0xfc, 0x9a, 0x67, 0x9b, 0x4d, 0xbf, 0xfd, 0x42, 0x78, 0xcd, 0xe7, 0xed, 0x61,
+	0xdb, 0x56, 0xf5, 0x88, 0xc0, 0x91, 0x9c, 0x8e, 0xa8, 0xe9, 0x13, 0x18, 0x62, 0x51, 0xce, 0xf2,
+	0xc2, 0x24, 0x0a, 0x3a, 0xdb, 0x45, 0x50, 0xdc, 0x2f, 0x75, 0xe0, 0x85, 0xe5, 0xea, 0x1c, 0x28,
+	0xd4, 0x07, 0xd9, 0xa6, 0x39, 0xf5, 0x4a, 0x0e, 0x4c, 0x7c, 0x16, 0x7c, 0x46, 0xa0, 0x9c, 0x37,
+	0x02, 0x79, 0x5b, 0x40, 0x33, 0xbc, 0x12, 0x77, 0xfb, 0x7f, 0x04, 0x1e, 0xda, 0x0c, 0x2c, 0xf5,
+	0x51, 0x38, 0xa4, 0x78, 0xea, 0x7c, 0x8d, 0xf9, 0x76, 0x4d, 0x88, 0x56, 0x84, 0xfa, 0x29, 0x81,
+	0x91, 0x4c, 0x2a, 0x66, 0x2c, 0xfa, 0x2a, 0x6a, 0x79, 0x42, 0xb4, 0x10, 0x6e, 0xcc, 0x08, 0xef,
+	0x70, 0xa3, 0x7d, 0x87, 0x1b, 0x78, 0x87, 0x1b, 0xf3, 0xc2, 0x71, 0xe7, 0xa6, 0xf1, 0xe7, 0x37,
+	0xd9, 0x74, 0x82, 0xc5, 0x95, 0x05, 0xa3, 0x21, 0x96, 0x4d, 0xbc, 0xf0, 0xc3, 0x8f, 0x29, 0x69,
+	0x2f, 0x99, 0xc1, 0xba, 0xc7, 0xa5, 0x2a, 0x90, 0x75, 0xf0, 0xe3, 0x59, 0xe3, 0xb3, 0xf5, 0x66,
+	0x8b, 0xc9, 0xc5, 0x0f, 0x1d, 0xd7, 0x16, 0x6b, 0x11, 0xe4, 0x3c, 0x8c, 0x66, 0x53, 0x08, 0x79,
+	0x12, 0x0e, 0xae, 0xa9, 0x88, 0xe5, 0xf9, 0xa2, 0xe9, 0x73, 0x19, 0x9d, 0x37, 0x03, 0x61, 0xb8,
+	0x86, 0x51, 0xbd, 0x84, 0xc7, 0x4d, 0x4d, 0x79, 0x8b, 0xa8, 0x75, 0x0d, 0x86, 0x53, 0x51, 0xec,
+	0x7a, 0x1e, 0x76, 0x87, 0x1e, 0x04, 0xf7, 0xd0, 0x44, 0xb7, 0xc3, 0x33, 0x2c, 0xc5, 0x82, 0xea,
+	0x23, 0x0a, 0xbb, 0x54, 0x4b, 0xfa, 0x03, 0x81, 0xfd, 0xa9, 0xbb, 0x6b, 0xa6, 0x4b, 0x97, 0x3c,
+	0x8b, 0xa3, 0xbd, 0xd5, 0x5f, 0x51, 0x28, 0x40, 0x3f, 0xf3, 0xe0, 0xb7, 0xbf, 0x9f, 0xec, 0x30,
+	0xe9, 0x94, 0x19, 0x15, 0x99, 0x61, 0x8d, 0xa9, 0x2e, 0x4c, 0x69, 0xde, 0x53, 0x9f, 0xf7, 0xcd,
+	0xd4, 0x7d, 0x49, 0xbf, 0x27, 0x70, 0x20, 0xd9, 0x4f, 0xd2, 0xbe, 0xa6, 0x8f, 0x5e, 0xab, 0x76,
+	0xa6, 0xcf, 0x2a, 0xa4, 0x36, 0x14, 0xf5, 0x24, 0x3d, 0x91, 0x47, 0x9d, 0xa2, 0x95, 0xf4, 0x09,
+	0x81, 0x3d, 0x68, 0x2b, 0xa8, 0xd1, 0x6b, 0xca, 0xb4, 0x2d, 0xd1, 0xcc, 0x2d, 0x8f, 0x47, 0xb8,
+	0x93, 0x0a, 0x6e, 0x82, 0x56, 0xf2, 0xe0, 0xd0, 0xbe, 0xd0, 0x6f, 0x09, 0x14, 0x13, 0x36, 0x80,
+	0x56, 0x7b, 0xcd, 0x94, 0xb5, 0x13, 0xda, 0x4c, 0x5f, 0x35, 0x48, 0x78, 0x4a, 0x11, 0x9e, 0xa0,
+	0xc7, 0xf2, 0x08, 0x93, 0x2e, 0x84, 0xfe, 0x42, 0xa0, 0xd4, 0xc9, 0x49, 0xd0, 0x8b, 0xbd, 0xe6,
+	0xee, 0x62, 0x52, 0xb4, 0x4b, 0xdb, 0x2b, 0x46, 0x05, 0x6f, 0x2b, 0x05, 0xd3, 0xd4, 0xc8, 0x53,
+	0x90, 0xb6, 0x36, 0xd6, 0x22, 0x22, 0x7f, 0x43, 0x60, 0x97, 0xba, 0xee, 0xe9, 0xa9, 0x5e, 0xf3,
+	0x27, 0xed, 0x8a, 0x36, 0xb5, 0xc5, 0xd1, 0x88, 0x77, 0x4e, 0xe1, 0x55, 0xe9, 0x74, 0x1e, 0x5e,
+	0xdb, 0xb3, 0x48, 0xf3, 0xde, 0x66, 0x0b, 0x74, 0x9f, 0xfe, 0x4c, 0x60, 0x70, 0xb3, 0x49, 0xa0,
+	0x67, 0x7b, 0xcd, 0x9e, 0xe3, 0x53, 0xb4, 0x73, 0xfd, 0x17, 0xa2, 0x82, 0x8b, 0x4a, 0xc1, 0x19,
+	0x3a, 0x93, 0x51, 0x10, 0xdf, 0xbd, 0xd2, 0xbc, 0x97, 0xbe, 0x9d, 0xef, 0x9b, 0xb7, 0x55, 0x3b,
+	0xfa, 0x3b, 0x01, 0x9a, 0xb5, 0x00, 0xf4, 0xfc, 0x56, 0xf6, 0x6a, 0x47, 0x7f, 0xa3, 0x5d, 0xd8,
+	0x4e, 0x29, 0x4a, 0x79, 0x5f, 0x49, 0x99, 0xa7, 0xb3, 0x7d, 0x49, 0xe9, 0xe4, 0x7c, 0xe8, 0xaf,
+	0x04, 0x06, 0x37, 0xdf, 0xd5, 0xbd, 0x57, 0x27, 0xc7, 0xde, 0x68, 0xe7, 0xfa, 0x2f, 0x44, 0x49,
+	0xd7, 0x94, 0xa4, 0x77, 0xe9, 0xe5, 0xbe, 0x24, 0x65, 0x8c, 0x04, 0xfd, 0x89, 0xc0, 0xd0, 0xe6,
+	0x49, 0x24, 0xed, 0x9b, 0x2b, 0xfe, 0x99, 0x9c, 0xdf, 0x46, 0x65, 0xcf, 0x5f, 0x74, 0x42, 0x52,
+	0xd6, 0x0a, 0xd1, 0xaf, 0x08, 0xc0, 0x86, 0x27, 0xa1, 0xa7, 0x7b, 0x11, 0x64, 0xac, 0x8d, 0x56,
+	0xed, 0xa7, 0x04, 0x69, 0x8f, 0x29, 0xda, 0x32, 0x1d, 0xcf, 0xd0, 0x26, 0x9c, 0x10, 0xfd, 0x9a,
+	0x40, 0x31, 0xe1, 0x45, 0x7a, 0x1f, 0xf0, 0x59, 0x4f, 0xa3, 0xcd, 0xf4, 0x55, 0x83, 0x78, 0xc7,
+	0x15, 0x5e, 0x85, 0x1e, 0xc9, 0xe0, 0xc9, 0xf6, 0x68, 0x2b, 0xb4, 0x3c, 0xf4, 0x21, 0x81, 0xdd,
+	0xa1, 0x2b, 0xa1, 0x3d, 0x0f, 0xb8, 0x94, 0x1d, 0xd2, 0x8c, 0xad, 0x0e, 0x47, 0xa0, 0x8a, 0x02,
+	0x1a, 0xa3, 0x23, 0x19, 0xa0, 0xd0, 0x0d, 0xcd, 0x5d, 0x7d, 0xf6, 0xb2, 0x4c, 0x9e, 0xbf, 0x2c,
+	0x93, 0xbf, 0x5e, 0x96, 0xc9, 0xe7, 0xaf, 0xca, 0x85, 0xe7, 0xaf, 0xca, 0x85, 0x3f, 0x5e, 0x95,
+	0x0b, 0x1f, 0xbd, 0x99, 0x70, 0x89, 0x71, 0x71, 0xfc, 0xe5, 0x6e, 0xd4, 0x47, 0xd9, 0xc5, 0x85,
+	0xdd, 0xea, 0xaf, 0xa0, 0x99, 0x7f, 0x07, 0x00, 0xfd, 0x86, 0x9b, 0xa7, 0xa4, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1039,6 +1313,12 @@ type QueryClient interface {
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// VotePenaltyCounter returns the voting behavior by an specific validator
 	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
+	// AggregatePrevote returns the pending aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns the pending aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// RewardPool returns the balance of the oracle reward pool
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
 	// SlashWindow returns slash window informacion
 	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
 	// Params returns the Oracle module's params
@@ -1125,6 +1405,33 @@ func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenal
 	return out, nil
 }
 
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
 	out := new(QuerySlashWindowResponse)
 	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.oracle.Query/SlashWindow", in, out, opts...)
@@ -1162,6 +1469,12 @@ type QueryServer interface {
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// VotePenaltyCounter returns the voting behavior by an specific validator
 	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
+	// AggregatePrevote returns the pending aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns the pending aggregate prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// RewardPool returns the balance of the oracle reward pool
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
 	// SlashWindow returns slash window informacion
 	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
 	// Params returns the Oracle module's params
@@ -1196,6 +1509,15 @@ func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
 }
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
@@ -1351,6 +1673,60 @@ func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.oracle.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySlashWindowRequest)
 	if err := dec(in); err != nil {
@@ -1423,6 +1799,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotePenaltyCounter",
 			Handler:    _Query_VotePenaltyCounter_Handler,
 		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
 		{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
@@ -1963,7 +2351,7 @@ func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1973,20 +2361,27 @@ func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1996,25 +2391,30 @@ func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WindowProgress != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2024,12 +2424,12 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2037,7 +2437,7 @@ func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2047,24 +2447,195 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowProgress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0xa
@@ -2301,6 +2872,78 @@ func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QuerySlashWindowRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3653,6 +4296,439 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
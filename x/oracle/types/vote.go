@@ -29,6 +29,21 @@ func (a AggregateExchangeRateVote) String() string {
 	return string(out)
 }
 
+// NewAggregateExchangeRatePrevote creates a new AggregateExchangeRatePrevote instance
+func NewAggregateExchangeRatePrevote(hash AggregateVoteHash, voter sdk.ValAddress, submitBlock uint64) AggregateExchangeRatePrevote {
+	return AggregateExchangeRatePrevote{
+		Hash:        hash.String(),
+		Voter:       voter.String(),
+		SubmitBlock: submitBlock,
+	}
+}
+
+// String implements stringify (I set false on the proto file, so I have to do it manually)
+func (v AggregateExchangeRatePrevote) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
 // NewExchangeRateTuple creates a new ExchangeRateTuple instance
 func NewExchangeRateTuple(denom string, exchangeRage sdk.Dec) ExchangeRateTuple {
 	return ExchangeRateTuple{
@@ -0,0 +1,186 @@
+package packetforward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	ibchookstypes "github.com/kiichain/kiichain/x/ibchooks/types"
+	"github.com/kiichain/kiichain/x/packetforward/keeper"
+	"github.com/kiichain/kiichain/x/packetforward/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer application to forward the tokens of
+// incoming transfers with a forward memo to the next hop
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware returns a new IBCMiddleware wrapping app
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. A transfer with a forward
+// memo is credited to an intermediary account derived from the channel and the
+// original sender, which sends the tokens to the next hop. The packet is only
+// acknowledged once the forwarded packet is, so that failures further down the
+// route are refunded to the original sender.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	forward, err := types.ParseMemo(data.GetMemo())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if forward == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrInvalidPacket, "invalid amount %s", data.Amount))
+	}
+	next, err := forward.NextMemo()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// The memo is consumed here, the middlewares below only see a plain
+	// transfer to the intermediary
+	intermediary := keeper.DeriveIntermediary(packet.GetDestChannel(), data.Sender)
+	overrideData := data
+	overrideData.Receiver = intermediary.String()
+	overrideData.Memo = ""
+	overridePacket := packet
+	overridePacket.Data = overrideData.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, overridePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	inFlight := types.InFlightPacket{
+		Packet:           packet,
+		OriginalSender:   data.Sender,
+		Intermediary:     intermediary.String(),
+		Token:            sdk.NewCoin(ibchookstypes.ReceivedDenom(packet, data.Denom), amount),
+		Receiver:         forward.Receiver,
+		Port:             forward.Port,
+		Channel:          forward.Channel,
+		Timeout:          uint64(forward.GetTimeout().Nanoseconds()),
+		RetriesRemaining: forward.GetRetries(),
+		Next:             next,
+	}
+	if err := im.keeper.ForwardTransferPacket(ctx, inFlight); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written asynchronously once the forward completes
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Once the transfer
+// application processed the acknowledgement, the received packet the tokens
+// were forwarded for is acknowledged.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	return im.keeper.OnForwardAcknowledged(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Once the transfer
+// application refunded the intermediary, a forwarded packet is retried or its
+// received packet fails.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnForwardTimeout(ctx, packet)
+}
@@ -0,0 +1,239 @@
+package packetforward_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/kiichain/kiichain/x/packetforward"
+	"github.com/kiichain/kiichain/x/packetforward/keeper"
+	"github.com/kiichain/kiichain/x/packetforward/types"
+)
+
+// mockTransferApp records the packets it receives
+type mockTransferApp struct {
+	porttypes.IBCModule
+	received *transfertypes.FungibleTokenPacketData
+}
+
+func (m *mockTransferApp) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	m.received = &data
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (m *mockTransferApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockTransferApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+// mockTransferKeeper records the forwarded transfers
+type mockTransferKeeper struct {
+	sent []*transfertypes.MsgTransfer
+	err  error
+}
+
+func (m *mockTransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.sent = append(m.sent, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.sent))}, nil
+}
+
+// mockChannel records the written acknowledgements
+type mockChannel struct {
+	packet exported.PacketI
+	ack    exported.Acknowledgement
+}
+
+func (m *mockChannel) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	m.packet, m.ack = packet, ack
+	return nil
+}
+
+type mockScopedKeeper struct{}
+
+func (mockScopedKeeper) GetCapability(sdk.Context, string) (*capabilitytypes.Capability, bool) {
+	return &capabilitytypes.Capability{}, true
+}
+
+// mockBankKeeper records how received tokens are reverted
+type mockBankKeeper struct {
+	sentTo sdk.AccAddress
+	burnt  sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoins(_ sdk.Context, _ sdk.AccAddress, toAddr sdk.AccAddress, _ sdk.Coins) error {
+	m.sentTo = toAddr
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(sdk.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amounts sdk.Coins) error {
+	m.burnt = amounts
+	return nil
+}
+
+type testSetup struct {
+	ctx        sdk.Context
+	app        *mockTransferApp
+	transfer   *mockTransferKeeper
+	channel    *mockChannel
+	bank       *mockBankKeeper
+	keeper     keeper.Keeper
+	middleware packetforward.IBCMiddleware
+}
+
+func setupMiddleware(t *testing.T) testSetup {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	s := testSetup{
+		ctx:      sdk.NewContext(stateStore, tmproto.Header{Time: time.Unix(1000, 0)}, false, log.NewNopLogger()),
+		app:      &mockTransferApp{},
+		transfer: &mockTransferKeeper{},
+		channel:  &mockChannel{},
+		bank:     &mockBankKeeper{},
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	s.keeper = keeper.NewKeeper(cdc, storeKey, s.transfer, s.channel, mockScopedKeeper{}, s.bank)
+	s.middleware = packetforward.NewIBCMiddleware(s.app, s.keeper)
+	return s
+}
+
+func newTransferPacket(denom, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", "cosmos1sender", "kii1receiver")
+	data.Memo = memo
+	return channeltypes.Packet{
+		Sequence:           9,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-5",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+		Data:               data.GetBytes(),
+	}
+}
+
+// forwardedPacket returns the packet sent for the nth forwarded transfer
+func forwardedPacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{Sequence: sequence, SourcePort: transfertypes.PortID, SourceChannel: "channel-1"}
+}
+
+const forwardMemo = `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","timeout":"1m","retries":1,"next":{"wasm":{}}}}`
+
+func TestOnRecvPacketForward(t *testing.T) {
+	s := setupMiddleware(t)
+
+	// transfers without a forward memo are passed through
+	ack := s.middleware.OnRecvPacket(s.ctx, newTransferPacket("uatom", "hello"), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, "kii1receiver", s.app.received.Receiver)
+	require.Empty(t, s.transfer.sent)
+
+	// invalid forwards are rejected
+	ack = s.middleware.OnRecvPacket(s.ctx, newTransferPacket("uatom", `{"forward":{"receiver":"osmo1receiver"}}`), nil)
+	require.False(t, ack.Success())
+
+	// the tokens are received by the intermediary and forwarded asynchronously
+	packet := newTransferPacket("uatom", forwardMemo)
+	ack = s.middleware.OnRecvPacket(s.ctx, packet, nil)
+	require.Nil(t, ack)
+	intermediary := keeper.DeriveIntermediary("channel-0", "cosmos1sender")
+	require.Equal(t, intermediary.String(), s.app.received.Receiver)
+	require.Empty(t, s.app.received.Memo)
+
+	require.Len(t, s.transfer.sent, 1)
+	msg := s.transfer.sent[0]
+	denom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), msg.Token)
+	require.Equal(t, intermediary.String(), msg.Sender)
+	require.Equal(t, "osmo1receiver", msg.Receiver)
+	require.Equal(t, "channel-1", msg.SourceChannel)
+	require.Equal(t, `{"wasm":{}}`, msg.Memo)
+	require.Equal(t, uint64(s.ctx.BlockTime().Add(time.Minute).UnixNano()), msg.TimeoutTimestamp)
+
+	inFlight, found := s.keeper.GetInFlightPacket(s.ctx, "channel-1", transfertypes.PortID, 1)
+	require.True(t, found)
+	require.Equal(t, packet, inFlight.Packet)
+
+	// a forward that can't be sent fails the received packet
+	s.transfer.err = errors.New("channel closed")
+	ack = s.middleware.OnRecvPacket(s.ctx, newTransferPacket("uatom", forwardMemo), nil)
+	require.False(t, ack.Success())
+}
+
+func TestForwardAcknowledgement(t *testing.T) {
+	s := setupMiddleware(t)
+	packet := newTransferPacket("uatom", forwardMemo)
+	require.Nil(t, s.middleware.OnRecvPacket(s.ctx, packet, nil))
+
+	// a successful forward acknowledges the received packet
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	require.NoError(t, s.middleware.OnAcknowledgementPacket(s.ctx, forwardedPacket(1), successAck.Acknowledgement(), nil))
+	require.Equal(t, packet, s.channel.packet)
+	require.True(t, s.channel.ack.Success())
+	require.Empty(t, s.keeper.GetAllInFlightPackets(s.ctx))
+	require.Nil(t, s.bank.burnt)
+
+	// a failed forward burns the received vouchers and fails the received packet
+	require.Nil(t, s.middleware.OnRecvPacket(s.ctx, packet, nil))
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("invalid receiver"))
+	require.NoError(t, s.middleware.OnAcknowledgementPacket(s.ctx, forwardedPacket(2), errorAck.Acknowledgement(), nil))
+	require.False(t, s.channel.ack.Success())
+	denom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), s.bank.burnt)
+	require.Empty(t, s.keeper.GetAllInFlightPackets(s.ctx))
+}
+
+func TestForwardTimeout(t *testing.T) {
+	s := setupMiddleware(t)
+
+	// tokens returning to this chain are escrowed again when the forward fails
+	packet := newTransferPacket("transfer/channel-5/ukii", forwardMemo)
+	require.Nil(t, s.middleware.OnRecvPacket(s.ctx, packet, nil))
+	require.Equal(t, sdk.NewInt64Coin("ukii", 100), s.transfer.sent[0].Token)
+
+	// the first timeout is retried
+	require.NoError(t, s.middleware.OnTimeoutPacket(s.ctx, forwardedPacket(1), nil))
+	require.Len(t, s.transfer.sent, 2)
+	require.Nil(t, s.channel.ack)
+	inFlight, found := s.keeper.GetInFlightPacket(s.ctx, "channel-1", transfertypes.PortID, 2)
+	require.True(t, found)
+	require.Zero(t, inFlight.RetriesRemaining)
+
+	// once the retries are exhausted the received packet fails
+	require.NoError(t, s.middleware.OnTimeoutPacket(s.ctx, forwardedPacket(2), nil))
+	require.Len(t, s.transfer.sent, 2)
+	require.Equal(t, packet, s.channel.packet)
+	require.False(t, s.channel.ack.Success())
+	require.Equal(t, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0"), s.bank.sentTo)
+	require.Empty(t, s.keeper.GetAllInFlightPackets(s.ctx))
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/kiichain/kiichain/x/packetforward/types"
+)
+
+// Keeper is the packet forward keeper struct
+type Keeper struct {
+	cdc            codec.BinaryCodec
+	storeKey       sdk.StoreKey
+	transferKeeper types.TransferKeeper
+	ics4Wrapper    types.ICS4Wrapper
+	scopedKeeper   types.ScopedKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper returns a new packet forward keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	transferKeeper types.TransferKeeper,
+	ics4Wrapper types.ICS4Wrapper,
+	scopedKeeper types.ScopedKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		transferKeeper: transferKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		scopedKeeper:   scopedKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// SetInFlightPacket records a packet forwarded on channel and port with the
+// given sequence
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64, inFlight types.InFlightPacket) {
+	ctx.KVStore(k.storeKey).Set(types.InFlightPacketKey(channel, port, sequence), k.cdc.MustMarshal(&inFlight))
+}
+
+// GetInFlightPacket returns the packet forwarded on channel and port with the
+// given sequence
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) (types.InFlightPacket, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.InFlightPacketKey(channel, port, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+	var inFlight types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlight)
+	return inFlight, true
+}
+
+// DeleteInFlightPacket removes a forwarded packet
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.InFlightPacketKey(channel, port, sequence))
+}
+
+// GetAllInFlightPackets returns all the packets being forwarded
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.InFlightPacketKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	inFlights := []types.InFlightPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlight types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlight)
+		inFlights = append(inFlights, inFlight)
+	}
+	return inFlights
+}
+
+// DeriveIntermediary returns the account receiving the tokens of the packets
+// received on channel from sender before they are forwarded
+func DeriveIntermediary(channel, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(types.ModuleName, []byte(channel+"/"+sender))[:20])
+}
+
+// ForwardTransferPacket sends the tokens held by the intermediary to the next
+// hop and records the packet in flight until it is acknowledged
+func (k Keeper) ForwardTransferPacket(ctx sdk.Context, inFlight types.InFlightPacket) error {
+	msg := &transfertypes.MsgTransfer{
+		SourcePort:       inFlight.Port,
+		SourceChannel:    inFlight.Channel,
+		Token:            inFlight.Token,
+		Sender:           inFlight.Intermediary,
+		Receiver:         inFlight.Receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: uint64(ctx.BlockTime().UnixNano()) + inFlight.Timeout,
+		Memo:             inFlight.Next,
+	}
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+	k.SetInFlightPacket(ctx, inFlight.Channel, inFlight.Port, res.Sequence, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyOriginalSender, inFlight.OriginalSender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlight.Receiver),
+			sdk.NewAttribute(types.AttributeKeyChannel, inFlight.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(uint64(inFlight.RetriesRemaining), 10)),
+		),
+	)
+	return nil
+}
+
+// OnForwardAcknowledged acknowledges the received packet of a forwarded packet
+// with the outcome of the next hop. On failure, the received tokens are
+// reverted so that the previous hop refunds them to the original sender.
+func (k Keeper) OnForwardAcknowledged(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence())
+
+	if ack.Success() {
+		return k.completeForward(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	}
+	return k.failForward(ctx, inFlight, sdkerrors.Wrap(types.ErrForwardFailed, ack.GetError()))
+}
+
+// OnForwardTimeout retries a timed out forwarded packet while it has retries
+// remaining, and fails the received packet otherwise
+func (k Keeper) OnForwardTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence())
+
+	if inFlight.RetriesRemaining > 0 {
+		inFlight.RetriesRemaining--
+		err := k.ForwardTransferPacket(ctx, inFlight)
+		if err == nil {
+			return nil
+		}
+		return k.failForward(ctx, inFlight, err)
+	}
+	return k.failForward(ctx, inFlight, types.ErrForwardTimeout)
+}
+
+// failForward reverts the receive of the tokens then acknowledges the received
+// packet with an error, so that the previous hop refunds the sender
+func (k Keeper) failForward(ctx sdk.Context, inFlight types.InFlightPacket, err error) error {
+	if revertErr := k.revertReceive(ctx, inFlight); revertErr != nil {
+		return revertErr
+	}
+	return k.completeForward(ctx, inFlight, channeltypes.NewErrorAcknowledgement(err))
+}
+
+// revertReceive undoes what the transfer application did when it received the
+// packet. The tokens are back on the intermediary, either refunded by the
+// transfer application or never sent.
+func (k Keeper) revertReceive(ctx sdk.Context, inFlight types.InFlightPacket) error {
+	packet := inFlight.Packet
+	intermediary, err := sdk.AccAddressFromBech32(inFlight.Intermediary)
+	if err != nil {
+		return err
+	}
+	tokens := sdk.NewCoins(inFlight.Token)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPacket, err.Error())
+	}
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were released from escrow, so they are escrowed again
+		escrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		return k.bankKeeper.SendCoins(ctx, intermediary, escrow, tokens)
+	}
+	// the tokens were minted as vouchers, so they are burnt
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediary, transfertypes.ModuleName, tokens); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, tokens)
+}
+
+// completeForward writes the acknowledgement of the received packet
+func (k Keeper) completeForward(ctx sdk.Context, inFlight types.InFlightPacket, ack exported.Acknowledgement) error {
+	packet := inFlight.Packet
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyOriginalSender, inFlight.OriginalSender),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+	}
+	if channelAck, ok := ack.(channeltypes.Acknowledgement); ok && !ack.Success() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, channelAck.GetError()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeForwardComplete, attributes...))
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/kiichain/kiichain/x/packetforward/keeper"
+	"github.com/kiichain/kiichain/x/packetforward/types"
+)
+
+func TestInFlightPacketStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	k := keeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), storeKey, nil, nil, nil, nil)
+
+	inFlight := types.InFlightPacket{
+		Packet:           channeltypes.Packet{Sequence: 3, DestinationPort: "transfer", DestinationChannel: "channel-0", Data: []byte("data")},
+		OriginalSender:   "cosmos1sender",
+		Token:            sdk.NewInt64Coin("ukii", 100),
+		Receiver:         "osmo1receiver",
+		Port:             "transfer",
+		Channel:          "channel-1",
+		RetriesRemaining: 2,
+	}
+	_, found := k.GetInFlightPacket(ctx, "channel-1", "transfer", 1)
+	require.False(t, found)
+
+	k.SetInFlightPacket(ctx, "channel-1", "transfer", 1, inFlight)
+	k.SetInFlightPacket(ctx, "channel-1", "transfer", 2, inFlight)
+	got, found := k.GetInFlightPacket(ctx, "channel-1", "transfer", 1)
+	require.True(t, found)
+	require.Equal(t, inFlight, got)
+	require.Len(t, k.GetAllInFlightPackets(ctx), 2)
+
+	k.DeleteInFlightPacket(ctx, "channel-1", "transfer", 1)
+	_, found = k.GetInFlightPacket(ctx, "channel-1", "transfer", 1)
+	require.False(t, found)
+	require.Len(t, k.GetAllInFlightPackets(ctx), 1)
+}
+
+func TestDeriveIntermediary(t *testing.T) {
+	intermediary := keeper.DeriveIntermediary("channel-0", "cosmos1sender")
+	require.Len(t, intermediary, 20)
+	require.Equal(t, intermediary, keeper.DeriveIntermediary("channel-0", "cosmos1sender"))
+	require.NotEqual(t, intermediary, keeper.DeriveIntermediary("channel-1", "cosmos1sender"))
+	require.NotEqual(t, intermediary, keeper.DeriveIntermediary("channel-0", "cosmos1other"))
+}
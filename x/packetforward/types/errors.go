@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/packetforward module sentinel errors
+var (
+	ErrInvalidMemo    = sdkerrors.Register(ModuleName, 2, "invalid packet forward memo")
+	ErrInvalidPacket  = sdkerrors.Register(ModuleName, 3, "invalid ics20 packet")
+	ErrForwardFailed  = sdkerrors.Register(ModuleName, 4, "packet forward failed")
+	ErrForwardTimeout = sdkerrors.Register(ModuleName, 5, "forwarded packet timed out")
+)
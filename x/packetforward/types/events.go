@@ -0,0 +1,14 @@
+package types
+
+const (
+	EventTypeForward         = "packet_forward"
+	EventTypeForwardComplete = "packet_forward_complete"
+
+	AttributeKeyOriginalSender = "original_sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyChannel        = "channel"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyRetries        = "retries_remaining"
+	AttributeKeySuccess        = "success"
+	AttributeKeyError          = "error"
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "packetforward"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MaxForwardRetries caps the number of times a timed out forward is retried
+	MaxForwardRetries = 10
+)
+
+// DefaultForwardTimeout is the relative timeout of forwarded packets when the
+// memo doesn't set one
+var DefaultForwardTimeout = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	// InFlightPacketKeyPrefix is the prefix of the packets being forwarded,
+	// keyed by the channel, port and sequence of the forwarded packet
+	InFlightPacketKeyPrefix = "inflight/"
+)
+
+// InFlightPacketKey returns the store key of the packet forwarded on channel
+// and port with the given sequence
+func InFlightPacketKey(channel, port string, sequence uint64) []byte {
+	key := append(KeyPrefix(InFlightPacketKeyPrefix), []byte(channel)...)
+	key = append(key, '/')
+	key = append(key, []byte(port)...)
+	key = append(key, '/')
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
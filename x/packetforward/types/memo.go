@@ -0,0 +1,145 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// Memo is the part of an ICS-20 memo read by the packet forward middleware
+type Memo struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+}
+
+// ForwardMetadata describes the next hop of a transfer
+type ForwardMetadata struct {
+	Receiver string   `json:"receiver"`
+	Port     string   `json:"port"`
+	Channel  string   `json:"channel"`
+	Timeout  Duration `json:"timeout,omitempty"`
+	Retries  *uint8   `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet, either a JSON object, such as
+	// another forward, or a string
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration read from a JSON duration string, such as
+// "10m", or a number of nanoseconds
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(v)
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return fmt.Errorf("invalid duration %s", bz)
+	}
+	return nil
+}
+
+// ParseMemo extracts the forward metadata from an ICS-20 memo. It returns nil
+// without an error when the memo isn't meant for the middleware, i.e. it isn't
+// a JSON object or has no `forward` key.
+func ParseMemo(memo string) (*ForwardMetadata, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, nil
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &keys); err != nil {
+		return nil, nil
+	}
+	if _, ok := keys["forward"]; !ok {
+		return nil, nil
+	}
+
+	var m Memo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if m.Forward == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidMemo, "empty forward")
+	}
+	if err := m.Forward.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return m.Forward, nil
+}
+
+// ValidateBasic checks the forward metadata is well formed
+func (m ForwardMetadata) ValidateBasic() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidMemo, "missing forward receiver")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward channel: %s", err)
+	}
+	if m.Timeout < 0 {
+		return sdkerrors.Wrap(ErrInvalidMemo, "forward timeout must not be negative")
+	}
+	if m.GetRetries() > MaxForwardRetries {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "forward retries must not exceed %d", MaxForwardRetries)
+	}
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of times a timed out forward is retried
+func (m ForwardMetadata) GetRetries() uint32 {
+	if m.Retries == nil {
+		return 0
+	}
+	return uint32(*m.Retries)
+}
+
+// NextMemo returns the memo of the forwarded packet
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+	switch next[0] {
+	case '"':
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", sdkerrors.Wrap(ErrInvalidMemo, err.Error())
+		}
+		return memo, nil
+	case '{':
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, next); err != nil {
+			return "", sdkerrors.Wrap(ErrInvalidMemo, err.Error())
+		}
+		return compacted.String(), nil
+	default:
+		return "", sdkerrors.Wrap(ErrInvalidMemo, "next must be a JSON object or a string")
+	}
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/x/packetforward/types"
+)
+
+func TestParseMemo(t *testing.T) {
+	tests := []struct {
+		name        string
+		memo        string
+		wantNil     bool
+		wantErr     bool
+		wantTimeout time.Duration
+		wantRetries uint32
+		wantNext    string
+	}{
+		{name: "empty memo", memo: "", wantNil: true},
+		{name: "plain text memo", memo: "hello", wantNil: true},
+		{name: "unrelated json memo", memo: `{"wasm":{"contract":"kii1"}}`, wantNil: true},
+		{
+			name:        "defaults",
+			memo:        `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+			wantTimeout: types.DefaultForwardTimeout,
+		},
+		{
+			name:        "duration string timeout, retries and string next",
+			memo:        `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"1h","retries":2,"next":"hello"}}`,
+			wantTimeout: time.Hour,
+			wantRetries: 2,
+			wantNext:    "hello",
+		},
+		{
+			name:        "nanoseconds timeout and object next",
+			memo:        `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":60000000000,"next":{"forward": {"receiver":"osmo1receiver","port":"transfer","channel":"channel-2"}}}}`,
+			wantTimeout: time.Minute,
+			wantNext:    `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-2"}}`,
+		},
+		{name: "empty forward", memo: `{"forward":null}`, wantErr: true},
+		{name: "missing receiver", memo: `{"forward":{"port":"transfer","channel":"channel-1"}}`, wantErr: true},
+		{name: "invalid port", memo: `{"forward":{"receiver":"cosmos1receiver","port":"","channel":"channel-1"}}`, wantErr: true},
+		{name: "invalid channel", memo: `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"chan"}}`, wantErr: true},
+		{name: "invalid timeout", memo: `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"soon"}}`, wantErr: true},
+		{name: "negative timeout", memo: `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"-1m"}}`, wantErr: true},
+		{name: "too many retries", memo: `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","retries":11}}`, wantErr: true},
+		{name: "invalid next", memo: `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","next":1}}`, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			forward, err := types.ParseMemo(tc.memo)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidMemo)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantNil {
+				require.Nil(t, forward)
+				return
+			}
+			require.Equal(t, tc.wantTimeout, forward.GetTimeout())
+			require.Equal(t, tc.wantRetries, forward.GetRetries())
+			next, err := forward.NextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantNext, next)
+		})
+	}
+}
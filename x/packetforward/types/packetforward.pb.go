@@ -0,0 +1,777 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: packetforward/packetforward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket is a received packet whose tokens were forwarded to the next
+// hop. The received packet is acknowledged once the forwarded packet is.
+type InFlightPacket struct {
+	// packet is the packet received from the previous hop
+	Packet types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// original_sender is the sender of the received packet
+	OriginalSender string `protobuf:"bytes,2,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// intermediary is the account holding the tokens while they are forwarded
+	Intermediary string `protobuf:"bytes,3,opt,name=intermediary,proto3" json:"intermediary,omitempty"`
+	// token is the local denom and amount of the received tokens
+	Token types1.Coin `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	// receiver is the receiver on the next hop
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// port is the port the tokens are forwarded on
+	Port string `protobuf:"bytes,6,opt,name=port,proto3" json:"port,omitempty"`
+	// channel is the channel the tokens are forwarded on
+	Channel string `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// timeout is the relative timeout of the forwarded packet, in nanoseconds
+	Timeout uint64 `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retries_remaining is the number of times the forward is retried after a
+	// timeout
+	RetriesRemaining uint32 `protobuf:"varint,9,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// next is the memo of the forwarded packet
+	Next string `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ef9b8a582296e8f, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetIntermediary() string {
+	if m != nil {
+		return m.Intermediary
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types1.Coin{}
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "kiichain.kiichain3.packetforward.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("packetforward/packetforward.proto", fileDescriptor_5ef9b8a582296e8f) }
+
+var fileDescriptor_5ef9b8a582296e8f = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
+	0x14, 0x5c, 0x97, 0xed, 0xb6, 0x35, 0x50, 0xc0, 0xe2, 0x60, 0x16, 0x29, 0xa4, 0xbd, 0xb0, 0x12,
+	0x92, 0xad, 0x6d, 0xc5, 0x81, 0x6b, 0x91, 0x90, 0x90, 0x38, 0xa0, 0x70, 0xe3, 0x52, 0x39, 0xde,
+	0x47, 0xf2, 0xb4, 0x1b, 0x3b, 0x72, 0xdc, 0xd0, 0xfe, 0x05, 0x9f, 0xd5, 0xe3, 0x1e, 0x39, 0x21,
+	0xb4, 0xfb, 0x0b, 0x7c, 0x00, 0x8a, 0x13, 0x83, 0x72, 0x9b, 0x99, 0x37, 0xcf, 0xe3, 0x91, 0x4d,
+	0xcf, 0x6a, 0xa5, 0xd7, 0xe0, 0xbf, 0x59, 0xf7, 0x5d, 0xb9, 0x95, 0x1c, 0x31, 0x51, 0x3b, 0xeb,
+	0x2d, 0x4b, 0xd7, 0x88, 0xba, 0x54, 0x68, 0x44, 0x04, 0x97, 0x62, 0xe4, 0x9b, 0x3f, 0x2f, 0x6c,
+	0x61, 0x83, 0x59, 0x76, 0xa8, 0xdf, 0x9b, 0x27, 0xda, 0x36, 0x95, 0x6d, 0x64, 0xae, 0x1a, 0x90,
+	0xed, 0x32, 0x07, 0xaf, 0x96, 0x52, 0x5b, 0x34, 0xc3, 0xfc, 0x0c, 0x73, 0x2d, 0xb5, 0x75, 0x20,
+	0x75, 0xa9, 0x8c, 0x81, 0x8d, 0x6c, 0x97, 0x11, 0xf6, 0x96, 0xf3, 0x3f, 0x07, 0xf4, 0xf4, 0xa3,
+	0xf9, 0xb0, 0xc1, 0xa2, 0xf4, 0x9f, 0x43, 0x24, 0x7b, 0x47, 0x67, 0x7d, 0x38, 0x27, 0x29, 0x59,
+	0x3c, 0xbc, 0x78, 0x29, 0x30, 0xd7, 0xa2, 0x3b, 0x46, 0xc4, 0xdd, 0x76, 0x29, 0x7a, 0xf3, 0xd5,
+	0xf4, 0xfe, 0xd7, 0xab, 0x49, 0x36, 0x2c, 0xb0, 0xd7, 0xf4, 0x89, 0x75, 0x58, 0xa0, 0x51, 0x9b,
+	0xeb, 0x06, 0xcc, 0x0a, 0x1c, 0x3f, 0x48, 0xc9, 0xe2, 0x24, 0x3b, 0x8d, 0xf2, 0x97, 0xa0, 0xb2,
+	0x73, 0xfa, 0x08, 0x8d, 0x07, 0x57, 0xc1, 0x0a, 0x95, 0xbb, 0xe3, 0x0f, 0x82, 0x6b, 0xa4, 0xb1,
+	0xb7, 0xf4, 0xd0, 0xdb, 0x35, 0x18, 0x3e, 0x0d, 0xd7, 0x78, 0x21, 0xfa, 0xb6, 0xa2, 0x6b, 0x2b,
+	0x86, 0xb6, 0xe2, 0xbd, 0x45, 0x33, 0x5c, 0xa2, 0x77, 0xb3, 0x39, 0x3d, 0x76, 0xa0, 0x01, 0x5b,
+	0x70, 0xfc, 0x30, 0x1c, 0xfb, 0x8f, 0x33, 0x46, 0xa7, 0xb5, 0x75, 0x9e, 0xcf, 0x82, 0x1e, 0x30,
+	0xe3, 0xf4, 0x68, 0xa8, 0xc5, 0x8f, 0x82, 0x1c, 0x69, 0x37, 0xf1, 0x58, 0x81, 0xbd, 0xf1, 0xfc,
+	0x38, 0x25, 0x8b, 0x69, 0x16, 0x29, 0x7b, 0x43, 0x9f, 0x39, 0xf0, 0x0e, 0xa1, 0xb9, 0x76, 0x50,
+	0x29, 0x34, 0x68, 0x0a, 0x7e, 0x92, 0x92, 0xc5, 0xe3, 0xec, 0xe9, 0x30, 0xc8, 0xa2, 0xde, 0x85,
+	0x1a, 0xb8, 0xf5, 0x9c, 0xf6, 0xa1, 0x1d, 0xbe, 0xfa, 0x74, 0xbf, 0x4b, 0xc8, 0x76, 0x97, 0x90,
+	0xdf, 0xbb, 0x84, 0xfc, 0xd8, 0x27, 0x93, 0xed, 0x3e, 0x99, 0xfc, 0xdc, 0x27, 0x93, 0xaf, 0x17,
+	0x05, 0xfa, 0xf2, 0x26, 0x17, 0xda, 0x56, 0x32, 0xfe, 0x86, 0xff, 0xe0, 0x76, 0xfc, 0x7f, 0xa4,
+	0xbf, 0xab, 0xa1, 0xc9, 0x67, 0xe1, 0x2d, 0x2f, 0xff, 0x0e, 0x00, 0x05, 0xb6, 0x56, 0x11, 0x6b,
+	0x02, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Next) > 0 {
+		i -= len(m.Next)
+		copy(dAtA[i:], m.Next)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Next)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Intermediary) > 0 {
+		i -= len(m.Intermediary)
+		copy(dAtA[i:], m.Intermediary)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.Intermediary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketforward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketforward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.Intermediary)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovPacketforward(uint64(m.Timeout))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovPacketforward(uint64(m.RetriesRemaining))
+	}
+	l = len(m.Next)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	return n
+}
+
+func sovPacketforward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketforward(x uint64) (n int) {
+	return sovPacketforward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intermediary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intermediary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Next = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketforward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketforward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketforward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketforward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketforward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketforward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketforward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketforward = fmt.Errorf("proto: unexpected end of group")
+)
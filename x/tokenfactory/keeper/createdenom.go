@@ -17,7 +17,18 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string
 	}
 
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
-	return denom, err
+	if err != nil {
+		return "", err
+	}
+
+	// Give the denom an EVM face right away if enabled
+	denomMetadata, _ := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	err = k.registerERC20NativePointer(ctx, denomMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	return denom, nil
 }
 
 // Runs CreateDenom logic after the charge and all denom validation has been handled.
@@ -24,6 +24,7 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+		evmKeeper     types.EVMKeeper
 
 		config Config
 	}
@@ -37,6 +38,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	evmKeeper types.EVMKeeper,
 	config Config,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
@@ -52,6 +54,7 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+		evmKeeper:     evmKeeper,
 
 		config: config,
 	}
@@ -58,6 +58,14 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	// Set the params so the new auto register ERC20 pointer param is initialized
+	defaultParams := types.DefaultParams()
+	m.keeper.SetParams(ctx, defaultParams)
+	return nil
+}
+
 func (m Migrator) SetMetadata(denomMetadata *banktypes.Metadata) {
 	if len(denomMetadata.Base) == 0 {
 		panic(fmt.Errorf("no base exists for denom %v", denomMetadata))
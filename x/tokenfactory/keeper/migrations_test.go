@@ -59,7 +59,7 @@ func TestMigrate2to3(t *testing.T) {
 	store.Set(oldCreatorSpecificPrefix, []byte("garbage value whitelist creator"))
 	require.True(t, store.Has(oldCreateDenomFeeWhitelistPrefix))
 	require.True(t, store.Has(oldCreatorSpecificPrefix))
-	newKeeper := NewKeeper(storeKey, cdc, paramsSubspace, nil, bankkeeper.NewBaseKeeper(cdc, bankstorekey, nil, paramsSubspace, nil), nil, Config{DenomAllowListMaxSize: 100})
+	newKeeper := NewKeeper(storeKey, cdc, paramsSubspace, nil, bankkeeper.NewBaseKeeper(cdc, bankstorekey, nil, paramsSubspace, nil), nil, nil, Config{DenomAllowListMaxSize: 100})
 	m := NewMigrator(newKeeper)
 	err := m.Migrate2to3(ctx)
 	require.Nil(t, err)
@@ -80,8 +80,8 @@ func TestMigrate2to3(t *testing.T) {
 func TestMigrate3To4(t *testing.T) {
 	// Test migration with all metadata denom
 	metadata := banktypes.Metadata{Description: sdk.DefaultBondDenom, Base: sdk.DefaultBondDenom, Display: sdk.DefaultBondDenom, Name: sdk.DefaultBondDenom, Symbol: sdk.DefaultBondDenom}
-	keeper := NewKeeper(nil, nil, typesparams.Subspace{}, nil, nil, nil, Config{DenomAllowListMaxSize: 100})
-	m := NewMigrator(keeper)
+	// SetMetadata doesn't touch the store, an uninitialized param subspace can't take the key table
+	m := NewMigrator(Keeper{config: Config{DenomAllowListMaxSize: 100}})
 	m.SetMetadata(&metadata)
 	require.Equal(t, sdk.DefaultBondDenom, metadata.Display)
 	require.Equal(t, sdk.DefaultBondDenom, metadata.Name)
@@ -94,3 +94,33 @@ func TestMigrate3To4(t *testing.T) {
 	require.Equal(t, testDenom, metadata.Name)
 	require.Equal(t, testDenom, metadata.Symbol)
 }
+
+func TestMigrate4To5(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	paramsSubspace := typesparams.NewSubspace(cdc,
+		codec.NewLegacyAmino(),
+		storeKey,
+		memStoreKey,
+		"TokenfactoryParams",
+	)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	// Before the migration the new param doesn't exist
+	newKeeper := NewKeeper(storeKey, cdc, paramsSubspace, nil, nil, nil, nil, Config{DenomAllowListMaxSize: 100})
+	require.Panics(t, func() { newKeeper.GetParams(ctx) })
+
+	m := NewMigrator(newKeeper)
+	err := m.Migrate4to5(ctx)
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams(), newKeeper.GetParams(ctx))
+}
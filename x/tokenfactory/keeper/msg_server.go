@@ -205,6 +205,12 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
+	// Keep the ERC20 pointer name, symbol and decimals in sync with the new metadata
+	err = server.Keeper.syncERC20NativePointer(ctx, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgSetDenomMetadata,
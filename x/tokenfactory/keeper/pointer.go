@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/utils"
+)
+
+// registerERC20NativePointer deploys a new ERC20 native pointer for the denom on x/evm
+// when the auto register param is enabled
+func (k Keeper) registerERC20NativePointer(ctx sdk.Context, denomMetadata banktypes.Metadata) error {
+	if !k.GetParams(ctx).AutoRegisterErc20Pointer {
+		return nil
+	}
+
+	return k.upsertERC20NativePointer(ctx, denomMetadata)
+}
+
+// syncERC20NativePointer upgrades the ERC20 native pointer of the denom, if any,
+// so its name, symbol and decimals follow the denom metadata
+func (k Keeper) syncERC20NativePointer(ctx sdk.Context, denomMetadata banktypes.Metadata) error {
+	_, _, exists := k.evmKeeper.GetERC20NativePointer(ctx, denomMetadata.Base)
+	if !exists {
+		return nil
+	}
+
+	return k.upsertERC20NativePointer(ctx, denomMetadata)
+}
+
+// upsertERC20NativePointer deploys or upgrades the ERC20 native pointer of the denom, charging
+// the deployment to the gas of the message. Calls from the tokenfactory precompile are skipped,
+// the precompile handles the pointer itself with the EVM of its caller
+func (k Keeper) upsertERC20NativePointer(ctx sdk.Context, denomMetadata banktypes.Metadata) error {
+	if ctx.IsEVM() && !ctx.EVMEntryViaWasmdPrecompile() {
+		return nil
+	}
+
+	metadata := utils.ERCMetadataFromDenomMetadata(denomMetadata)
+	_, err := k.evmKeeper.UpsertERCNativePointerFromCosmos(ctx, denomMetadata.Base, metadata)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("ERC20 native pointer for denom %s could not be upserted due to (%s)", denomMetadata.Base, err))
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestAutoRegisterERC20Pointer() {
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	evmKeeper := suite.App.EvmKeeper
+	ctx := suite.Ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(suite.Ctx))
+
+	// Disabled by default, no pointer is deployed
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "nopointer"))
+	suite.Require().NoError(err)
+	noPointerGas := ctx.GasMeter().GasConsumed() - gasBefore
+	_, _, exists := evmKeeper.GetERC20NativePointer(ctx, res.GetNewTokenDenom())
+	suite.Require().False(exists)
+
+	// Enable the param, the pointer is deployed with the denom and charged to the message
+	tokenFactoryKeeper.SetParams(ctx, types.NewParams(true))
+	gasBefore = ctx.GasMeter().GasConsumed()
+	res, err = suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(suite.TestAccs[0].String(), "pointer"))
+	suite.Require().NoError(err)
+	suite.Require().Greater(ctx.GasMeter().GasConsumed()-gasBefore, noPointerGas)
+	denom := res.GetNewTokenDenom()
+	pointerAddr, _, exists := evmKeeper.GetERC20NativePointer(ctx, denom)
+	suite.Require().True(exists)
+
+	name, err := evmKeeper.QueryERCSingleOutput(ctx, "native", pointerAddr, "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal(denom, name.(string))
+	decimals, err := evmKeeper.QueryERCSingleOutput(ctx, "native", pointerAddr, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(0), decimals.(uint8))
+
+	// Updating the metadata keeps the pointer in sync
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomMetadata(suite.TestAccs[0].String(), banktypes.Metadata{
+		Description: "a pointer token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "ptr", Exponent: 6, Aliases: []string{"Pointer"}},
+		},
+		Base:    denom,
+		Display: "ptr",
+		Name:    "Pointer",
+		Symbol:  "PTR",
+	}))
+	suite.Require().NoError(err)
+
+	newPointerAddr, _, exists := evmKeeper.GetERC20NativePointer(ctx, denom)
+	suite.Require().True(exists)
+	suite.Require().Equal(pointerAddr, newPointerAddr)
+	name, err = evmKeeper.QueryERCSingleOutput(ctx, "native", pointerAddr, "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal("Pointer", name.(string))
+	symbol, err := evmKeeper.QueryERCSingleOutput(ctx, "native", pointerAddr, "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("ptr", symbol.(string))
+	decimals, err = evmKeeper.QueryERCSingleOutput(ctx, "native", pointerAddr, "decimals")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(6), decimals.(uint8))
+}
@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kiichain/kiichain/utils"
 )
 
 type BankKeeper interface {
@@ -38,3 +41,9 @@ type AccountKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// EVMKeeper defines the contract needed to deploy and upgrade ERC20 native pointers on x/evm.
+type EVMKeeper interface {
+	UpsertERCNativePointerFromCosmos(ctx sdk.Context, token string, metadata utils.ERCMetadata) (contractAddr common.Address, err error)
+	GetERC20NativePointer(ctx sdk.Context, token string) (addr common.Address, version uint16, exists bool)
+}
@@ -1,25 +1,53 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Parameter store keys.
+var (
+	KeyAutoRegisterERC20Pointer = []byte("AutoRegisterERC20Pointer")
+)
+
 // ParamTable for tokenfactory module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns a new set of tokenfactory module parameters.
+func NewParams(autoRegisterERC20Pointer bool) Params {
+	return Params{
+		AutoRegisterErc20Pointer: autoRegisterERC20Pointer,
+	}
+}
+
 // default tokenfactory module parameters.
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		AutoRegisterErc20Pointer: false,
+	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	return validateAutoRegisterERC20Pointer(p.AutoRegisterErc20Pointer)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAutoRegisterERC20Pointer, &p.AutoRegisterErc20Pointer, validateAutoRegisterERC20Pointer),
+	}
+}
+
+// validateAutoRegisterERC20Pointer validates the auto register ERC20 pointer param
+func validateAutoRegisterERC20Pointer(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
 }
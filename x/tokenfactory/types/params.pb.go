@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -24,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
+	// auto_register_erc20_pointer deploys and registers an ERC20 native pointer
+	// on x/evm for every newly created denom
+	AutoRegisterErc20Pointer bool `protobuf:"varint,1,opt,name=auto_register_erc20_pointer,json=autoRegisterErc20Pointer,proto3" json:"auto_register_erc20_pointer,omitempty" yaml:"auto_register_erc20_pointer"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -59,6 +63,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAutoRegisterErc20Pointer() bool {
+	if m != nil {
+		return m.AutoRegisterErc20Pointer
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.tokenfactory.Params")
 }
@@ -66,16 +77,21 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/params.proto", fileDescriptor_0f39a375875b281a) }
 
 var fileDescriptor_0f39a375875b281a = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
+	// 209 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xc9, 0xcf, 0x4e,
 	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcf, 0xce, 0xcc, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x83, 0x31, 0x8c, 0xf5, 0x90, 0x55, 0x2b, 0x71, 0x70, 0xb1, 0x05, 0x80, 0x35, 0x38, 0xf9, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x18, 0x38, 0xc3, 0x58, 0xbf, 0x42, 0x1f, 0xc5, 0xfe,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xfd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0xba, 0xd9, 0xcd, 0x9c, 0x00, 0x00, 0x00,
+	0x83, 0x31, 0x8c, 0xf5, 0x90, 0x55, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xea, 0x83,
+	0x58, 0x10, 0x6d, 0x4a, 0xf9, 0x5c, 0x6c, 0x01, 0x60, 0x63, 0x84, 0x52, 0xb9, 0xa4, 0x13, 0x4b,
+	0x4b, 0xf2, 0xe3, 0x8b, 0x52, 0xd3, 0x33, 0x8b, 0x4b, 0x52, 0x8b, 0xe2, 0x53, 0x8b, 0x92, 0x8d,
+	0x0c, 0xe2, 0x0b, 0xf2, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9c,
+	0xd4, 0x3e, 0xdd, 0x93, 0x57, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xa3, 0x58, 0x29, 0x48,
+	0x02, 0x24, 0x1b, 0x04, 0x95, 0x74, 0x05, 0xc9, 0x05, 0x40, 0xa4, 0x9c, 0xbc, 0x4f, 0x3c, 0x92,
+	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
+	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x30, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
+	0x39, 0x3f, 0x57, 0x1f, 0xe6, 0x07, 0x04, 0xa3, 0x42, 0x1f, 0xc5, 0xef, 0x25, 0x95, 0x05, 0xa9,
+	0xc5, 0x49, 0x6c, 0x60, 0x4f, 0x18, 0x03, 0x06, 0x00, 0x21, 0x90, 0x42, 0xbe, 0x18, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +114,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRegisterErc20Pointer {
+		i--
+		if m.AutoRegisterErc20Pointer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +144,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.AutoRegisterErc20Pointer {
+		n += 2
+	}
 	return n
 }
 
@@ -156,6 +185,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterErc20Pointer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterErc20Pointer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])